@@ -1,226 +1,207 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day01"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day02"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day03"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day04"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day05"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day06"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day07"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day08"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day09"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day10"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day11"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day12"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day13"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day14"
-	"github.com/amoilanen/advent-of-code-2024/internal/days/day15"
+	"github.com/amoilanen/advent-of-code-2024/internal/days"
+	"github.com/amoilanen/advent-of-code-2024/internal/gen"
+	"github.com/amoilanen/advent-of-code-2024/internal/render"
+	"github.com/amoilanen/advent-of-code-2024/internal/term"
 )
 
 func main() {
-	if len(os.Args) > 1 {
-		runSpecificDay(os.Args[1])
+	if len(os.Args) > 1 && os.Args[1] == "gen" {
+		runGen(os.Args[2:])
+		return
+	}
+
+	flags := flag.NewFlagSet("aoc2024", flag.ExitOnError)
+	animate := flags.String("animate", "", "write an animated GIF of the day's simulation to this file instead of solving it")
+	fps := flags.Int("fps", 10, "frames per second of the animation")
+	stride := flags.Int("stride", 1, "record every n-th simulation step")
+	maxFrames := flags.Int("max-frames", 1000, "stop the animation after this many frames, 0 for no limit")
+	scale := flags.Int("scale", 4, "pixels per grid cell in the animation")
+	play := flags.Bool("play", false, "play the day's simulation in the terminal instead of solving it")
+	part := flags.Int("part", 1, "puzzle part whose rules --play follows, e.g. 2 for the wide warehouse of day 15")
+	flags.Usage = usage(flags)
+
+	// Flags may come before or after the day
+	flags.Parse(os.Args[1:])
+	args := flags.Args()
+	if len(args) > 1 {
+		flags.Parse(args[1:])
+		args = append(args[:1], flags.Args()...)
+	}
+	if len(args) > 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	if *play {
+		if len(args) == 0 {
+			fmt.Fprintln(os.Stderr, "--play needs a day")
+			os.Exit(2)
+		}
+		if err := playDay(args[0], *part, *fps); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *animate != "" {
+		if len(args) == 0 {
+			fmt.Fprintln(os.Stderr, "--animate needs a day")
+			os.Exit(2)
+		}
+		options := render.GIFOptions{FPS: *fps, Stride: *stride, MaxFrames: *maxFrames}
+		if err := animateDay(args[0], *animate, *scale, options); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(args) > 0 {
+		runSpecificDay(args[0])
 	} else {
 		runAllDays()
 	}
 }
 
+func usage(flags *flag.FlagSet) func() {
+	return func() {
+		fmt.Fprintln(os.Stderr, "Usage: aoc2024 [day] [flags]")
+		fmt.Fprintln(os.Stderr, "       aoc2024 gen <day> [--seed n] [--size n]")
+		fmt.Fprintln(os.Stderr, "Example: aoc2024 1")
+		fmt.Fprintln(os.Stderr, "Example: aoc2024 15 --animate warehouse.gif --stride 10")
+		fmt.Fprintln(os.Stderr, "Example: aoc2024 15 --play --part 2")
+		flags.PrintDefaults()
+	}
+}
+
 func runAllDays() {
 	fmt.Println("Advent of Code 2024 - Solutions")
 	fmt.Println("================================")
 	fmt.Println()
 
-	runDay01()
-	runDay02()
-	runDay03()
-	runDay04()
-	runDay05()
-	runDay06()
-	runDay07()
-	runDay08()
-	runDay09()
-	runDay10()
-	runDay11()
-	runDay12()
-	runDay13()
-	runDay14()
-	runDay15()
+	for _, day := range days.All() {
+		runDay(day)
+	}
 }
 
-func runSpecificDay(day string) {
-	switch day {
-	case "1", "day01", "day1":
-		runDay01()
-	case "2", "day02", "day2":
-		runDay02()
-	case "3", "day03", "day3":
-		runDay03()
-	case "4", "day04", "day4":
-		runDay04()
-	case "5", "day05", "day5":
-		runDay05()
-	case "6", "day06", "day6":
-		runDay06()
-	case "7", "day07", "day7":
-		runDay07()
-	case "8", "day08", "day8":
-		runDay08()
-	case "9", "day09", "day9":
-		runDay09()
-	case "10", "day10":
-		runDay10()
-	case "11", "day11":
-		runDay11()
-	case "12", "day12":
-		runDay12()
-	case "13", "day13":
-		runDay13()
-	case "14", "day14":
-		runDay14()
-	case "15", "day15":
-		runDay15()
-	default:
-		fmt.Fprintf(os.Stderr, "Unknown day: %s\n", day)
+func runSpecificDay(name string) {
+	day, ok := days.Lookup(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown day: %s\n", name)
 		fmt.Fprintln(os.Stderr, "Usage: aoc2024 [day]")
 		fmt.Fprintln(os.Stderr, "Example: aoc2024 1")
 		os.Exit(1)
 	}
+	runDay(day)
 }
 
-func runDay01() {
-	fmt.Println("Day 1:")
-	input := day01.DayInput
-	parsed := day01.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day01.Part1(parsed))
-	fmt.Printf("  Part 2: %d\n", day01.Part2(parsed))
-	fmt.Println()
-}
-
-func runDay02() {
-	fmt.Println("Day 2:")
-	input := day02.DayInput
-	parsed := day02.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day02.Part1(parsed))
-	fmt.Printf("  Part 2: %d\n", day02.Part2(parsed))
-	fmt.Println()
-}
-
-func runDay03() {
-	fmt.Println("Day 3:")
-	input := day03.DayInput
-	instructions := day03.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day03.Part1(instructions))
-	fmt.Printf("  Part 2: %d\n", day03.Part2(instructions))
-	fmt.Println()
-}
-
-func runDay04() {
-	fmt.Println("Day 4:")
-	input := day04.DayInput
-	grid := day04.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day04.Part1(grid))
-	fmt.Printf("  Part 2: %d\n", day04.Part2(grid))
-	fmt.Println()
-}
-
-func runDay05() {
-	fmt.Println("Day 5:")
-	input := day05.DayInput
-	parsed := day05.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day05.Part1(parsed))
-	fmt.Printf("  Part 2: %d\n", day05.Part2(parsed))
-	fmt.Println()
-}
-
-func runDay06() {
-	fmt.Println("Day 6:")
-	input := day06.DayInput
-	grid, guard := day06.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day06.Part1(grid, guard))
-	fmt.Printf("  Part 2: %d\n", day06.Part2(grid, guard))
-	fmt.Println()
-}
-
-func runDay07() {
-	fmt.Println("Day 7:")
-	input := day07.DayInput
-	equations := day07.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day07.Part1(equations))
-	fmt.Printf("  Part 2: %d\n", day07.Part2(equations))
+func runDay(day days.Day) {
+	fmt.Printf("Day %d:\n", day.Number)
+	part1, part2 := day.Solve()
+	fmt.Printf("  Part 1: %s\n", part1)
+	fmt.Printf("  Part 2: %s\n", part2)
 	fmt.Println()
 }
 
-func runDay08() {
-	fmt.Println("Day 8:")
-	input := day08.DayInput
-	grid := day08.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day08.Part1(grid))
-	fmt.Printf("  Part 2: %d\n", day08.Part2(grid))
-	fmt.Println()
-}
+// animateDay records the simulation of a day on its real input and saves it as a GIF
+func animateDay(name, path string, scale int, options render.GIFOptions) error {
+	day, ok := days.Lookup(name)
+	if !ok {
+		return fmt.Errorf("unknown day: %s", name)
+	}
+	if day.Animate == nil {
+		return fmt.Errorf("day %d has no simulation to animate", day.Number)
+	}
 
-func runDay09() {
-	fmt.Println("Day 9:")
-	input := day09.DayInput
-	diskMap := day09.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day09.Part1(diskMap))
-	fmt.Printf("  Part 2: %d\n", day09.Part2(diskMap))
-	fmt.Println()
-}
+	recorder := render.NewGIFRecorder(options)
+	finished := day.Animate(day.Input, scale, recorder)
 
-func runDay10() {
-	fmt.Println("Day 10:")
-	input := day10.DayInput
-	topoMap := day10.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day10.Part1(topoMap))
-	fmt.Printf("  Part 2: %d\n", day10.Part2(topoMap))
-	fmt.Println()
-}
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := recorder.Encode(out); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
 
-func runDay11() {
-	fmt.Println("Day 11:")
-	input := day11.DayInput
-	stones := day11.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day11.Part1(stones))
-	fmt.Printf("  Part 2: %d\n", day11.Part2(stones))
-	fmt.Println()
+	fmt.Printf("Day %d: wrote %d frames to %s\n", day.Number, recorder.Frames(), path)
+	if !finished {
+		fmt.Fprintf(os.Stderr, "warning: the animation stopped at --max-frames %d; raise it or --stride to see more of the simulation\n",
+			options.MaxFrames)
+	}
+	return nil
 }
 
-func runDay12() {
-	fmt.Println("Day 12:")
-	input := day12.DayInput
-	grid := day12.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day12.Part1(grid))
-	fmt.Printf("  Part 2: %d\n", day12.Part2(grid))
-	fmt.Println()
-}
+// playDay shows the simulation of a day on its real input in the terminal
+func playDay(name string, part int, fps int) error {
+	day, ok := days.Lookup(name)
+	if !ok {
+		return fmt.Errorf("unknown day: %s", name)
+	}
+	if day.Play == nil {
+		return fmt.Errorf("day %d has no simulation to play", day.Number)
+	}
 
-func runDay13() {
-	fmt.Println("Day 13:")
-	input := day13.DayInput
-	machines := day13.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day13.Part1(machines))
-	fmt.Printf("  Part 2: %d\n", day13.Part2(machines))
-	fmt.Println()
-}
+	sim, palette := day.Play(day.Input, part)
+	restore, err := term.MakeRaw()
+	if err != nil {
+		return err
+	}
+	defer restore()
+
+	player := term.NewPlayer(sim, term.NewScreen(os.Stdout, palette), fps)
+	return player.Run(os.Stdin)
+}
+
+// runGen prints a random input for a day, e.g. to feed a solver something larger than the real input
+func runGen(args []string) {
+	flags := flag.NewFlagSet("aoc2024 gen", flag.ExitOnError)
+	seed := flags.Int64("seed", 1, "random seed; the same seed and size always give the same input")
+	size := flags.Int("size", 0, "size of the input, 0 for about the size of the real input")
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: aoc2024 gen <day> [--seed n] [--size n]")
+		fmt.Fprintln(os.Stderr, "Example: aoc2024 gen 6 --seed 42 --size 500 > lab.txt")
+		flags.PrintDefaults()
+		fmt.Fprintln(os.Stderr, "What size counts depends on the day:")
+		for _, day := range gen.Days() {
+			description, _ := gen.Describe(day)
+			fmt.Fprintf(os.Stderr, "  day %2d: %s\n", day, description)
+		}
+	}
 
-func runDay14() {
-	fmt.Println("Day 14:")
-	input := day14.DayInput
-	robots := day14.Parse(input)
-	fmt.Printf("  Part 1: %d\n", day14.Part1(robots, 101, 103))
-	fmt.Printf("  Part 2: %d\n", day14.Part2(robots, 101, 103))
-	fmt.Println()
-}
+	// Flags may come before or after the day
+	flags.Parse(args)
+	args = flags.Args()
+	if len(args) > 1 {
+		flags.Parse(args[1:])
+		args = append(args[:1], flags.Args()...)
+	}
+	if len(args) != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
 
-func runDay15() {
-	fmt.Println("Day 15:")
-	input := day15.Input
-	fmt.Printf("  Part 1: %d\n", day15.Part1(input))
-	fmt.Printf("  Part 2: %d\n", day15.Part2(input))
-	fmt.Println()
+	day, ok := days.Lookup(args[0])
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown day: %s\n", args[0])
+		os.Exit(1)
+	}
+	input, err := gen.Generate(day.Number, gen.Options{Seed: *seed, Size: *size})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(input)
 }
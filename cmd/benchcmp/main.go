@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/benchcmp"
+)
+
+// config holds the flags shared by save and compare
+type config struct {
+	baseline  string
+	bench     string
+	count     int
+	benchtime string
+	packages  []string
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "save":
+		runSave(os.Args[2:])
+	case "compare":
+		runCompare(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: benchcmp save [flags] [packages]")
+	fmt.Fprintln(os.Stderr, "       benchcmp compare [flags] [packages]")
+	fmt.Fprintln(os.Stderr, "save runs the benchmarks and keeps the output as the baseline; compare runs them again,")
+	fmt.Fprintln(os.Stderr, "prints a benchstat table against the baseline and fails if time/op regressed past --threshold")
+	fmt.Fprintln(os.Stderr, "Example: go run ./cmd/benchcmp save --count 10")
+	fmt.Fprintln(os.Stderr, "Example: go run ./cmd/benchcmp compare --count 10 --threshold 5 ./internal/days/day06")
+}
+
+// newFlags declares the flags shared by save and compare
+func newFlags(name string, cfg *config) *flag.FlagSet {
+	flags := flag.NewFlagSet("benchcmp "+name, flag.ExitOnError)
+	flags.StringVar(&cfg.baseline, "baseline", filepath.Join("bench", "baseline.txt"), "file the baseline is saved to and compared with")
+	flags.StringVar(&cfg.bench, "bench", "^BenchmarkPuzzle$", "regular expression of the benchmarks to run")
+	flags.IntVar(&cfg.count, "count", 5, "runs of each benchmark; more runs tell noise from real changes better")
+	flags.StringVar(&cfg.benchtime, "benchtime", "", "go test -benchtime, such as 2s or 100x")
+	flags.Usage = func() {
+		usage()
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+func runSave(args []string) {
+	var cfg config
+	flags := newFlags("save", &cfg)
+	flags.Parse(args)
+	cfg.packages = flags.Args()
+
+	output, err := runBenchmarks(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := writeFile(cfg.baseline, output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Fprintf(os.Stderr, "Saved the baseline to %s\n", cfg.baseline)
+}
+
+func runCompare(args []string) {
+	var cfg config
+	flags := newFlags("compare", &cfg)
+	threshold := flags.Float64("threshold", 10, "fail when a benchmark is this many percent slower than the baseline")
+	newFile := flags.String("new", "", "compare this saved go test -bench output instead of running the benchmarks")
+	out := flags.String("o", "", "also save the new run to this file, e.g. to make it the next baseline")
+	flags.Parse(args)
+	cfg.packages = flags.Args()
+
+	baseline, err := readResults(cfg.baseline)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\nSave a baseline first with: benchcmp save\n", err)
+		os.Exit(1)
+	}
+
+	var output []byte
+	if *newFile != "" {
+		output, err = os.ReadFile(*newFile)
+	} else {
+		output, err = runBenchmarks(cfg)
+	}
+	if err == nil && *out != "" {
+		err = writeFile(*out, output)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	current, err := benchcmp.Parse(bytes.NewReader(output))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	rows := benchcmp.Compare(baseline, current)
+	benchcmp.Write(os.Stdout, rows)
+
+	regressed := benchcmp.Regressions(rows, "ns/op", *threshold)
+	if len(regressed) > 0 {
+		fmt.Fprintf(os.Stderr, "%d benchmarks are more than %g%% slower than the baseline:\n", len(regressed), *threshold)
+		for _, row := range regressed {
+			fmt.Fprintf(os.Stderr, "  %s %s: %+.2f%%\n", row.Pkg, row.Name, row.Delta())
+		}
+		os.Exit(1)
+	}
+}
+
+// runBenchmarks runs go test -bench and returns its output
+// The output is echoed to stderr as it comes, as a full run takes a while
+func runBenchmarks(cfg config) ([]byte, error) {
+	packages := cfg.packages
+	if len(packages) == 0 {
+		packages = []string{"./internal/days/..."}
+	}
+
+	args := []string{"test", "-run", "^$", "-bench", cfg.bench, "-benchmem", "-count", fmt.Sprint(cfg.count)}
+	if cfg.benchtime != "" {
+		args = append(args, "-benchtime", cfg.benchtime)
+	}
+	args = append(args, packages...)
+
+	var output bytes.Buffer
+	cmd := exec.Command("go", args...)
+	cmd.Stdout = io.MultiWriter(&output, os.Stderr)
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("go %v: %w", args, err)
+	}
+	return output.Bytes(), nil
+}
+
+// readResults parses a saved go test -bench output
+func readResults(path string) (*benchcmp.Results, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return benchcmp.Parse(f)
+}
+
+// writeFile writes data to path, creating its directory if needed
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
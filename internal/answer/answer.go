@@ -0,0 +1,189 @@
+package answer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// Kind tells which representation an Answer holds
+type Kind int
+
+const (
+	None Kind = iota
+	Int
+	Big
+	Text
+)
+
+// String returns the name of the kind
+func (k Kind) String() string {
+	switch k {
+	case Int:
+		return "int"
+	case Big:
+		return "big"
+	case Text:
+		return "text"
+	default:
+		return "none"
+	}
+}
+
+// Answer is the result of one puzzle part: an integer of any size or a piece of text
+// Integers that fit into int64 are always stored as Int, so equal numbers compare equal
+// no matter how they were constructed. The zero value is an Answer of kind None.
+type Answer struct {
+	kind Kind
+	n    int64
+	big  *big.Int
+	text string
+}
+
+// FromInt creates a numeric answer
+func FromInt(n int) Answer {
+	return Answer{kind: Int, n: int64(n)}
+}
+
+// FromInt64 creates a numeric answer
+func FromInt64(n int64) Answer {
+	return Answer{kind: Int, n: n}
+}
+
+// FromBig creates a numeric answer from a big integer, which is copied
+// Values that fit into int64 are stored as Int
+func FromBig(n *big.Int) Answer {
+	if n.IsInt64() {
+		return FromInt64(n.Int64())
+	}
+	return Answer{kind: Big, big: new(big.Int).Set(n)}
+}
+
+// FromString creates a text answer, such as a comma-joined list or a password
+func FromString(s string) Answer {
+	return Answer{kind: Text, text: s}
+}
+
+// Parse reads an answer back from its String form
+// Decimal integers become numeric answers, anything else is text
+func Parse(s string) Answer {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return FromInt64(n)
+	}
+	if n, ok := new(big.Int).SetString(s, 10); ok {
+		return FromBig(n)
+	}
+	return FromString(s)
+}
+
+// Kind returns the representation held by the answer
+func (a Answer) Kind() Kind {
+	return a.kind
+}
+
+// IsZero reports whether the answer is unset
+func (a Answer) IsZero() bool {
+	return a.kind == None
+}
+
+// Int64 returns the numeric value if it fits into int64
+func (a Answer) Int64() (int64, bool) {
+	return a.n, a.kind == Int
+}
+
+// BigInt returns a copy of the numeric value as a big integer
+// Returns false for text and unset answers
+func (a Answer) BigInt() (*big.Int, bool) {
+	switch a.kind {
+	case Int:
+		return big.NewInt(a.n), true
+	case Big:
+		return new(big.Int).Set(a.big), true
+	default:
+		return nil, false
+	}
+}
+
+// String formats numbers in decimal and returns text unchanged
+// Unset answers are formatted as an empty string
+func (a Answer) String() string {
+	switch a.kind {
+	case Int:
+		return strconv.FormatInt(a.n, 10)
+	case Big:
+		return a.big.String()
+	case Text:
+		return a.text
+	default:
+		return ""
+	}
+}
+
+// GoString makes %#v print the answer in a readable form
+func (a Answer) GoString() string {
+	switch a.kind {
+	case Text:
+		return fmt.Sprintf("answer.FromString(%q)", a.text)
+	case None:
+		return "answer.Answer{}"
+	default:
+		return fmt.Sprintf("answer.Parse(%q)", a.String())
+	}
+}
+
+// Equal reports whether two answers have the same kind and value
+// A numeric answer never equals a text answer, even if they print the same
+func (a Answer) Equal(b Answer) bool {
+	if a.kind != b.kind {
+		return false
+	}
+	switch a.kind {
+	case Int:
+		return a.n == b.n
+	case Big:
+		return a.big.Cmp(b.big) == 0
+	default:
+		return a.text == b.text
+	}
+}
+
+// MarshalJSON encodes numbers as JSON numbers of any size, text as a JSON string and None as null
+func (a Answer) MarshalJSON() ([]byte, error) {
+	switch a.kind {
+	case Int, Big:
+		return []byte(a.String()), nil
+	case Text:
+		return json.Marshal(a.text)
+	default:
+		return []byte("null"), nil
+	}
+}
+
+// UnmarshalJSON decodes the output of MarshalJSON without losing precision on large numbers
+func (a *Answer) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case nil:
+		*a = Answer{}
+	case string:
+		*a = FromString(v)
+	case json.Number:
+		n, ok := new(big.Int).SetString(v.String(), 10)
+		if !ok {
+			return fmt.Errorf("answer: %s is not an integer", v)
+		}
+		*a = FromBig(n)
+	default:
+		return fmt.Errorf("answer: cannot decode %s", data)
+	}
+	return nil
+}
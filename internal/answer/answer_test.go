@@ -0,0 +1,145 @@
+package answer
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func hugeNumber() *big.Int {
+	n, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	return n
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		name   string
+		answer Answer
+		want   string
+		kind   Kind
+	}{
+		{"zero value", Answer{}, "", None},
+		{"int", FromInt(42), "42", Int},
+		{"negative int64", FromInt64(-7), "-7", Int},
+		{"small big", FromBig(big.NewInt(5)), "5", Int},
+		{"huge big", FromBig(hugeNumber()), "123456789012345678901234567890", Big},
+		{"text", FromString("4,6,3,5,6,3,5,2,1,0"), "4,6,3,5,6,3,5,2,1,0", Text},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.answer.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			if got := fmt.Sprint(tt.answer); got != tt.want {
+				t.Errorf("Sprint() = %q, want %q", got, tt.want)
+			}
+			if got := tt.answer.Kind(); got != tt.kind {
+				t.Errorf("Kind() = %v, want %v", got, tt.kind)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Answer
+		want bool
+	}{
+		{"same int", FromInt(3), FromInt64(3), true},
+		{"different int", FromInt(3), FromInt(4), false},
+		{"int and small big", FromInt(99), FromBig(big.NewInt(99)), true},
+		{"huge bigs", FromBig(hugeNumber()), Parse("123456789012345678901234567890"), true},
+		{"int and text", FromInt(12), FromString("12"), false},
+		{"same text", FromString("a,b"), FromString("a,b"), true},
+		{"unset", Answer{}, Answer{}, true},
+		{"unset and empty text", Answer{}, FromString(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Equal(tt.b); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+			if got := tt.b.Equal(tt.a); got != tt.want {
+				t.Errorf("Equal() is not symmetric")
+			}
+		})
+	}
+}
+
+func TestBigIntIsCopied(t *testing.T) {
+	n := big.NewInt(1)
+	n.Lsh(n, 100)
+	a := FromBig(n)
+	n.SetInt64(0)
+
+	got, ok := a.BigInt()
+	if !ok || got.BitLen() != 101 {
+		t.Fatalf("BigInt() = %v, %v, want 2^100", got, ok)
+	}
+	got.SetInt64(0)
+	if a.String() != "1267650600228229401496703205376" {
+		t.Errorf("answer changed through BigInt() to %s", a)
+	}
+
+	if _, ok := FromString("x").BigInt(); ok {
+		t.Errorf("BigInt() of text should fail")
+	}
+	if n, ok := FromInt(8).Int64(); !ok || n != 8 {
+		t.Errorf("Int64() = %d, %v, want 8, true", n, ok)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	tests := []struct {
+		answer Answer
+		json   string
+	}{
+		{FromInt(1928), `1928`},
+		{FromBig(hugeNumber()), `123456789012345678901234567890`},
+		{FromString("6,3"), `"6,3"`},
+		{FromString("42"), `"42"`},
+		{Answer{}, `null`},
+	}
+
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.answer)
+		if err != nil || string(data) != tt.json {
+			t.Errorf("Marshal(%v) = %s, %v, want %s", tt.answer, data, err, tt.json)
+		}
+
+		var decoded Answer
+		if err := json.Unmarshal([]byte(tt.json), &decoded); err != nil || !decoded.Equal(tt.answer) {
+			t.Errorf("Unmarshal(%s) = %#v, %v, want %#v", tt.json, decoded, err, tt.answer)
+		}
+	}
+
+	var a Answer
+	for _, bad := range []string{`1.5`, `true`, `[1]`} {
+		if err := json.Unmarshal([]byte(bad), &a); err == nil {
+			t.Errorf("Unmarshal(%s) should fail", bad)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Answer
+	}{
+		{"0", FromInt(0)},
+		{"-15", FromInt(-15)},
+		{"123456789012345678901234567890", FromBig(hugeNumber())},
+		{"1,2,3", FromString("1,2,3")},
+		{"", FromString("")},
+	}
+
+	for _, tt := range tests {
+		if got := Parse(tt.input); !got.Equal(tt.want) {
+			t.Errorf("Parse(%q) = %#v, want %#v", tt.input, got, tt.want)
+		}
+	}
+}
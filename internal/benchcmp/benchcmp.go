@@ -0,0 +1,247 @@
+package benchcmp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Key identifies one metric of one benchmark
+type Key struct {
+	Pkg  string
+	Name string // Without the -GOMAXPROCS suffix, so runs on machines with different core counts still match
+	Unit string // Such as ns/op, B/op, allocs/op or a custom metric like peak-heap-B
+}
+
+// Results holds every value measured in one run of go test -bench, which is also the format benchstat reads
+type Results struct {
+	Keys   []Key // In order of first appearance
+	Values map[Key][]float64
+}
+
+var procsSuffix = regexp.MustCompile(`-\d+$`)
+
+// Parse reads the output of go test -bench, possibly with -count above one
+// Lines other than "pkg:" headers and benchmark results, such as test logs, are skipped
+func Parse(r io.Reader) (*Results, error) {
+	results := &Results{Values: make(map[Key][]float64)}
+	pkg := ""
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if name, ok := strings.CutPrefix(line, "pkg: "); ok {
+			pkg = strings.TrimSpace(name)
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 4 || len(fields)%2 != 0 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+		if _, err := strconv.Atoi(fields[1]); err != nil {
+			continue
+		}
+
+		name := procsSuffix.ReplaceAllString(strings.TrimPrefix(fields[0], "Benchmark"), "")
+		for i := 2; i < len(fields); i += 2 {
+			value, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("benchmark %s: %w", fields[0], err)
+			}
+			results.add(Key{Pkg: pkg, Name: name, Unit: fields[i+1]}, value)
+		}
+	}
+	return results, scanner.Err()
+}
+
+// add records one measured value
+func (r *Results) add(key Key, value float64) {
+	if _, ok := r.Values[key]; !ok {
+		r.Keys = append(r.Keys, key)
+	}
+	r.Values[key] = append(r.Values[key], value)
+}
+
+// Summary describes the values measured for one key
+type Summary struct {
+	Median float64
+	Min    float64
+	Max    float64
+	N      int
+}
+
+// Summarize returns the median and range of values, which must not be empty
+func Summarize(values []float64) Summary {
+	sorted := slices.Sorted(slices.Values(values))
+	n := len(sorted)
+	median := sorted[n/2]
+	if n%2 == 0 {
+		median = (sorted[n/2-1] + sorted[n/2]) / 2
+	}
+	return Summary{Median: median, Min: sorted[0], Max: sorted[n-1], N: n}
+}
+
+// Spread is the largest distance of a value from the median in percent of the median
+func (s Summary) Spread() float64 {
+	if s.Median == 0 {
+		return 0
+	}
+	return max(s.Max-s.Median, s.Median-s.Min) / s.Median * 100
+}
+
+// Row compares one metric of one benchmark between the old and the new run
+type Row struct {
+	Key
+	Old Summary
+	New Summary
+}
+
+// Delta is the change of the median in percent; positive means the value grew
+func (r Row) Delta() float64 {
+	if r.Old.Median == 0 {
+		if r.New.Median == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return (r.New.Median - r.Old.Median) / r.Old.Median * 100
+}
+
+// Significant reports whether the ranges of the two runs do not overlap
+// This is cruder than the Mann-Whitney test of benchstat but needs no tables, and with a few runs
+// it keeps noise from showing up as a change in the same way.
+func (r Row) Significant() bool {
+	return r.New.Min > r.Old.Max || r.New.Max < r.Old.Min
+}
+
+// Compare pairs the metrics present in both runs, in the order of the new run
+func Compare(old, new *Results) []Row {
+	var rows []Row
+	for _, key := range new.Keys {
+		oldValues, ok := old.Values[key]
+		if !ok {
+			continue
+		}
+		rows = append(rows, Row{Key: key, Old: Summarize(oldValues), New: Summarize(new.Values[key])})
+	}
+	return rows
+}
+
+// Regressions returns the rows of unit that grew significantly by more than threshold percent
+func Regressions(rows []Row, unit string, threshold float64) []Row {
+	var regressed []Row
+	for _, row := range rows {
+		if row.Unit == unit && row.Significant() && row.Delta() > threshold {
+			regressed = append(regressed, row)
+		}
+	}
+	return regressed
+}
+
+// Write prints rows as benchstat tables, one per package and unit
+// Each value is the median with the spread around it; the delta is "~" when the runs overlap.
+func Write(w io.Writer, rows []Row) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for start := 0; start < len(rows); {
+		pkg := rows[start].Pkg
+		end := start
+		for end < len(rows) && rows[end].Pkg == pkg {
+			end++
+		}
+		if pkg != "" {
+			fmt.Fprintf(tw, "pkg: %s\n", pkg)
+		}
+
+		group := rows[start:end]
+		for _, unit := range units(group) {
+			name := metricName(unit)
+			fmt.Fprintf(tw, "name\told %s\tnew %s\tdelta\n", name, name)
+			for _, row := range group {
+				if row.Unit == unit {
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", row.Name, format(row.Old, unit), format(row.New, unit), delta(row))
+				}
+			}
+			fmt.Fprintln(tw)
+		}
+		start = end
+	}
+	return tw.Flush()
+}
+
+// units lists the units of rows in order of first appearance
+func units(rows []Row) []string {
+	var seen []string
+	for _, row := range rows {
+		if !slices.Contains(seen, row.Unit) {
+			seen = append(seen, row.Unit)
+		}
+	}
+	return seen
+}
+
+// metricName is the column title benchstat uses for a unit
+func metricName(unit string) string {
+	switch unit {
+	case "ns/op":
+		return "time/op"
+	case "B/op":
+		return "alloc/op"
+	default:
+		return unit
+	}
+}
+
+// format writes a summary as "314µs ± 2%"
+func format(s Summary, unit string) string {
+	return fmt.Sprintf("%s ± %.0f%%", scale(s.Median, unit), s.Spread())
+}
+
+// scale writes a value with three significant digits and a unit prefix
+// Times get s, ms, µs or ns; bytes get B, kB, MB or GB; other metrics a bare k, M or G
+func scale(value float64, unit string) string {
+	prefixes := []string{"", "k", "M", "G"}
+	switch unit {
+	case "ns/op":
+		prefixes = []string{"ns", "µs", "ms", "s"}
+	case "B/op":
+		prefixes = []string{"B", "kB", "MB", "GB"}
+	default:
+		if strings.HasSuffix(unit, "-B") {
+			prefixes = []string{"B", "kB", "MB", "GB"}
+		}
+	}
+
+	i := 0
+	for math.Abs(value) >= 1000 && i < len(prefixes)-1 {
+		value /= 1000
+		i++
+	}
+	return strconv.FormatFloat(value, 'f', decimals(value), 64) + prefixes[i]
+}
+
+// decimals keeps three significant digits for values below 1000
+func decimals(value float64) int {
+	switch value = math.Abs(value); {
+	case value == 0 || value >= 100:
+		return 0
+	case value >= 10:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// delta writes the change of a row like benchstat, or "~" when it is within the noise
+func delta(row Row) string {
+	if !row.Significant() {
+		return "~"
+	}
+	return fmt.Sprintf("%+.2f%%", row.Delta())
+}
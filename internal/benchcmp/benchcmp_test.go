@@ -0,0 +1,177 @@
+package benchcmp
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const oldRun = `goos: linux
+goarch: amd64
+pkg: example.com/day01
+cpu: Some CPU
+BenchmarkParse-8   	    3000	    300000 ns/op	  100000 B/op	    2000 allocs/op
+BenchmarkParse-8   	    3000	    310000 ns/op	  100000 B/op	    2000 allocs/op
+BenchmarkParse-8   	    3000	    290000 ns/op	  100000 B/op	    2000 allocs/op
+BenchmarkPart1-8   	   10000	    150000 ns/op
+BenchmarkPart1-8   	   10000	    160000 ns/op
+BenchmarkPart1-8   	   10000	    155000 ns/op
+PASS
+ok  	example.com/day01	6.012s
+pkg: example.com/day02
+BenchmarkPart2/string-8   	       1	   2000000 ns/op	  8400000 peak-heap-B
+--- some log line
+PASS
+`
+
+const newRun = `pkg: example.com/day01
+BenchmarkParse-4   	    3000	    450000 ns/op	  100000 B/op	    2000 allocs/op
+BenchmarkParse-4   	    3000	    460000 ns/op	  100000 B/op	    2000 allocs/op
+BenchmarkParse-4   	    3000	    440000 ns/op	  100000 B/op	    2000 allocs/op
+BenchmarkPart1-4   	   10000	    152000 ns/op
+BenchmarkPart1-4   	   10000	    148000 ns/op
+BenchmarkPart1-4   	   10000	    158000 ns/op
+BenchmarkNew-4   	   10000	      1000 ns/op
+`
+
+func mustParse(t *testing.T, output string) *Results {
+	t.Helper()
+	results, err := Parse(strings.NewReader(output))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return results
+}
+
+func TestParse(t *testing.T) {
+	results := mustParse(t, oldRun)
+
+	wantKeys := []Key{
+		{"example.com/day01", "Parse", "ns/op"},
+		{"example.com/day01", "Parse", "B/op"},
+		{"example.com/day01", "Parse", "allocs/op"},
+		{"example.com/day01", "Part1", "ns/op"},
+		{"example.com/day02", "Part2/string", "ns/op"},
+		{"example.com/day02", "Part2/string", "peak-heap-B"},
+	}
+	if !reflect.DeepEqual(results.Keys, wantKeys) {
+		t.Errorf("Parse() keys = %v, want %v", results.Keys, wantKeys)
+	}
+
+	got := results.Values[Key{"example.com/day01", "Parse", "ns/op"}]
+	if want := []float64{300000, 310000, 290000}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() values = %v, want %v", got, want)
+	}
+}
+
+func TestParseInvalidValue(t *testing.T) {
+	if _, err := Parse(strings.NewReader("BenchmarkX-8 10 fast ns/op\n")); err == nil {
+		t.Error("Parse() error = nil, want an error")
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   Summary
+	}{
+		{"single value", []float64{5}, Summary{Median: 5, Min: 5, Max: 5, N: 1}},
+		{"odd count", []float64{3, 1, 2}, Summary{Median: 2, Min: 1, Max: 3, N: 3}},
+		{"even count", []float64{4, 1, 3, 2}, Summary{Median: 2.5, Min: 1, Max: 4, N: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Summarize(tt.values); got != tt.want {
+				t.Errorf("Summarize(%v) = %+v, want %+v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	rows := Compare(mustParse(t, oldRun), mustParse(t, newRun))
+
+	// Benchmarks missing from either run are left out
+	if len(rows) != 4 {
+		t.Fatalf("Compare() returned %d rows, want 4", len(rows))
+	}
+
+	parse, part1 := rows[0], rows[3]
+	if !parse.Significant() || math.Abs(parse.Delta()-50) > 1e-9 {
+		t.Errorf("Parse delta = %v (significant %v), want 50%% and significant", parse.Delta(), parse.Significant())
+	}
+	if part1.Significant() {
+		t.Errorf("Part1 delta %v is significant, want the overlapping runs to count as noise", part1.Delta())
+	}
+}
+
+func TestRegressions(t *testing.T) {
+	rows := Compare(mustParse(t, oldRun), mustParse(t, newRun))
+
+	tests := []struct {
+		name      string
+		threshold float64
+		want      []string
+	}{
+		{"below the slowdown", 10, []string{"Parse"}},
+		{"above the slowdown", 60, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, row := range Regressions(rows, "ns/op", tt.threshold) {
+				got = append(got, row.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Regressions(%v) = %v, want %v", tt.threshold, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var sb strings.Builder
+	if err := Write(&sb, Compare(mustParse(t, oldRun), mustParse(t, newRun))); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	want := `pkg: example.com/day01
+name   old time/op  new time/op  delta
+Parse  300µs ± 3%   450µs ± 2%   +50.00%
+Part1  155µs ± 3%   152µs ± 4%   ~
+
+name   old alloc/op  new alloc/op  delta
+Parse  100kB ± 0%    100kB ± 0%    ~
+
+name   old allocs/op  new allocs/op  delta
+Parse  2.00k ± 0%     2.00k ± 0%     ~
+
+`
+	if sb.String() != want {
+		t.Errorf("Write() =\n%s\nwant\n%s", sb.String(), want)
+	}
+}
+
+func TestScale(t *testing.T) {
+	tests := []struct {
+		value float64
+		unit  string
+		want  string
+	}{
+		{12.5, "ns/op", "12.5ns"},
+		{1234567, "ns/op", "1.23ms"},
+		{3e9, "ns/op", "3.00s"},
+		{8400000, "peak-heap-B", "8.40MB"},
+		{0, "allocs/op", "0"},
+	}
+
+	for _, tt := range tests {
+		if got := scale(tt.value, tt.unit); got != tt.want {
+			t.Errorf("scale(%v, %q) = %q, want %q", tt.value, tt.unit, got, tt.want)
+		}
+	}
+}
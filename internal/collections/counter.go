@@ -0,0 +1,58 @@
+package collections
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Counter tallies how many times each value occurs
+// Missing values have a count of zero
+type Counter[T comparable] map[T]int
+
+// Count is a value together with how often it occurs
+type Count[T comparable] struct {
+	Value T
+	Count int
+}
+
+// NewCounter creates a counter with one occurrence per given item
+func NewCounter[T comparable](items ...T) Counter[T] {
+	c := make(Counter[T], len(items))
+	for _, item := range items {
+		c[item]++
+	}
+	return c
+}
+
+// Add increases the count of the item by n
+func (c Counter[T]) Add(item T, n int) {
+	c[item] += n
+}
+
+// Total returns the sum of all counts
+func (c Counter[T]) Total() int {
+	total := 0
+	for _, count := range c {
+		total += count
+	}
+	return total
+}
+
+// MostCommon returns up to n values with the highest counts, highest first
+// Pass n < 0 to get every value
+// Values with equal counts are returned in unspecified order
+func (c Counter[T]) MostCommon(n int) []Count[T] {
+	counts := make([]Count[T], 0, len(c))
+	for value, count := range c {
+		counts = append(counts, Count[T]{Value: value, Count: count})
+	}
+
+	slices.SortFunc(counts, func(a, b Count[T]) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+
+	if n >= 0 && n < len(counts) {
+		counts = counts[:n]
+	}
+	return counts
+}
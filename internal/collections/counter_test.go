@@ -0,0 +1,47 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	c := NewCounter("a", "b", "a", "c", "a", "b")
+
+	if c["a"] != 3 || c["b"] != 2 || c["c"] != 1 || c["missing"] != 0 {
+		t.Errorf("NewCounter() = %v, want a=3 b=2 c=1", c)
+	}
+
+	c.Add("c", 5)
+	if c["c"] != 6 {
+		t.Errorf("after Add(c, 5) count = %d, want 6", c["c"])
+	}
+
+	if c.Total() != 11 {
+		t.Errorf("Total() = %d, want 11", c.Total())
+	}
+}
+
+func TestCounterMostCommon(t *testing.T) {
+	c := NewCounter(5, 1, 5, 2, 5, 2)
+
+	tests := []struct {
+		name string
+		n    int
+		want []Count[int]
+	}{
+		{"top one", 1, []Count[int]{{Value: 5, Count: 3}}},
+		{"top two", 2, []Count[int]{{Value: 5, Count: 3}, {Value: 2, Count: 2}}},
+		{"all", -1, []Count[int]{{Value: 5, Count: 3}, {Value: 2, Count: 2}, {Value: 1, Count: 1}}},
+		{"more than available", 10, []Count[int]{{Value: 5, Count: 3}, {Value: 2, Count: 2}, {Value: 1, Count: 1}}},
+		{"none", 0, []Count[int]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.MostCommon(tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MostCommon(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
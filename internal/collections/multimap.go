@@ -0,0 +1,32 @@
+package collections
+
+// MultiMap associates each key with a list of values, kept in insertion order
+type MultiMap[K comparable, V any] map[K][]V
+
+// Put appends the value to the values stored under the key
+func (m MultiMap[K, V]) Put(key K, value V) {
+	m[key] = append(m[key], value)
+}
+
+// Get returns the values stored under the key, or nil if there are none
+func (m MultiMap[K, V]) Get(key K) []V {
+	return m[key]
+}
+
+// Keys returns the keys of the map in unspecified order
+func (m MultiMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Len returns the total number of values across all keys
+func (m MultiMap[K, V]) Len() int {
+	total := 0
+	for _, values := range m {
+		total += len(values)
+	}
+	return total
+}
@@ -0,0 +1,30 @@
+package collections
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMultiMap(t *testing.T) {
+	m := make(MultiMap[rune, int])
+	m.Put('a', 1)
+	m.Put('b', 2)
+	m.Put('a', 3)
+
+	if got, want := m.Get('a'), []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Get(a) = %v, want %v", got, want)
+	}
+	if got := m.Get('z'); got != nil {
+		t.Errorf("Get(z) = %v, want nil", got)
+	}
+	if m.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", m.Len())
+	}
+
+	keys := m.Keys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	if want := []rune{'a', 'b'}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Keys() = %v, want %v", keys, want)
+	}
+}
@@ -0,0 +1,107 @@
+package collections
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Set is an unordered collection of distinct values
+// The zero value is not usable, create sets with NewSet or make
+type Set[T comparable] map[T]struct{}
+
+// NewSet creates a set containing the given items
+func NewSet[T comparable](items ...T) Set[T] {
+	s := make(Set[T], len(items))
+	s.Add(items...)
+	return s
+}
+
+// Add inserts the items into the set
+func (s Set[T]) Add(items ...T) {
+	for _, item := range items {
+		s[item] = struct{}{}
+	}
+}
+
+// Remove deletes the item from the set if present
+func (s Set[T]) Remove(item T) {
+	delete(s, item)
+}
+
+// Contains reports whether the item is in the set
+func (s Set[T]) Contains(item T) bool {
+	_, ok := s[item]
+	return ok
+}
+
+// Len returns the number of items in the set
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Clone returns a shallow copy of the set
+func (s Set[T]) Clone() Set[T] {
+	clone := make(Set[T], len(s))
+	for item := range s {
+		clone[item] = struct{}{}
+	}
+	return clone
+}
+
+// Union returns a new set with the items that are in either set
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	result := s.Clone()
+	for item := range other {
+		result[item] = struct{}{}
+	}
+	return result
+}
+
+// Intersection returns a new set with the items that are in both sets
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	// Iterate over the smaller set
+	small, large := s, other
+	if len(large) < len(small) {
+		small, large = large, small
+	}
+
+	result := make(Set[T])
+	for item := range small {
+		if large.Contains(item) {
+			result[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set with the items of s that are not in other
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := make(Set[T])
+	for item := range s {
+		if !other.Contains(item) {
+			result[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Items returns the items of the set in unspecified order
+func (s Set[T]) Items() []T {
+	items := make([]T, 0, len(s))
+	for item := range s {
+		items = append(items, item)
+	}
+	return items
+}
+
+// SortedFunc returns the items of the set ordered by the comparison function
+func (s Set[T]) SortedFunc(compare func(a, b T) int) []T {
+	items := s.Items()
+	slices.SortFunc(items, compare)
+	return items
+}
+
+// Sorted returns the items of a set of ordered values in ascending order
+func Sorted[T cmp.Ordered](s Set[T]) []T {
+	return s.SortedFunc(cmp.Compare[T])
+}
@@ -0,0 +1,79 @@
+package collections
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSetBasics(t *testing.T) {
+	s := NewSet(3, 1, 2, 3)
+
+	if s.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", s.Len())
+	}
+	if !s.Contains(1) || s.Contains(4) {
+		t.Errorf("Contains() gave wrong membership for %v", s)
+	}
+
+	s.Add(4)
+	s.Remove(1)
+	if got, want := Sorted(s), []int{2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sorted() = %v, want %v", got, want)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := NewSet(1, 2, 3, 4)
+	b := NewSet(3, 4, 5)
+
+	tests := []struct {
+		name string
+		got  Set[int]
+		want []int
+	}{
+		{"union", a.Union(b), []int{1, 2, 3, 4, 5}},
+		{"intersection", a.Intersection(b), []int{3, 4}},
+		{"intersection is symmetric", b.Intersection(a), []int{3, 4}},
+		{"difference", a.Difference(b), []int{1, 2}},
+		{"reverse difference", b.Difference(a), []int{5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sorted(tt.got); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Operands must be left untouched
+	if a.Len() != 4 || b.Len() != 3 {
+		t.Errorf("set operations modified their operands: a=%v b=%v", a, b)
+	}
+}
+
+func TestSetSortedFunc(t *testing.T) {
+	s := NewSet("bb", "a", "ccc")
+	got := s.SortedFunc(func(x, y string) int { return len(y) - len(x) })
+	if want := []string{"ccc", "bb", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedFunc() = %v, want %v", got, want)
+	}
+
+	got = NewSet("b", "A", "c").SortedFunc(func(x, y string) int {
+		return strings.Compare(strings.ToLower(x), strings.ToLower(y))
+	})
+	if want := []string{"A", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedFunc() = %v, want %v", got, want)
+	}
+}
+
+func TestSetClone(t *testing.T) {
+	original := NewSet(1, 2)
+	clone := original.Clone()
+	clone.Add(3)
+
+	if original.Contains(3) {
+		t.Error("Clone() shares storage with the original")
+	}
+}
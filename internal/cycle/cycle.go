@@ -0,0 +1,129 @@
+package cycle
+
+// Cycle describes the eventually periodic sequence x0, step(x0), step(step(x0)), ...
+// The first Start states form the tail, after which the sequence repeats every Length steps
+// Entry is the first state on the cycle, i.e. the state after Start steps
+type Cycle[S any] struct {
+	Start  int
+	Length int
+	Entry  S
+}
+
+// Index maps step n to the earliest step with the same state
+// Steps inside the tail map to themselves, later steps are folded into the first pass of the cycle
+func (c Cycle[S]) Index(n int) int {
+	if n < c.Start || c.Length == 0 {
+		return n
+	}
+	return c.Start + (n-c.Start)%c.Length
+}
+
+// Jump returns the state after n steps from start without simulating all of them
+// Time complexity: O(Start + Length) step calls regardless of n
+func (c Cycle[S]) Jump(start S, step func(S) S, n int) S {
+	return advance(start, step, c.Index(n))
+}
+
+// Brent finds the cycle using Brent's algorithm
+// The hare searches for the cycle length in windows of doubling size while the tortoise
+// waits at the start of each window, then two pointers Length apart locate the tail.
+// Uses O(1) memory and fewer step calls than Floyd's algorithm in practice.
+//
+// Time complexity: O(Start + Length) step calls
+// Space complexity: O(1)
+func Brent[S comparable](start S, step func(S) S) Cycle[S] {
+	return BrentFunc(start, step, func(a, b S) bool { return a == b })
+}
+
+// BrentFunc is Brent for states that are not comparable with ==, such as slices
+func BrentFunc[S any](start S, step func(S) S, equal func(a, b S) bool) Cycle[S] {
+	// Phase 1: find the cycle length
+	power, length := 1, 1
+	tortoise, hare := start, step(start)
+	for !equal(tortoise, hare) {
+		if power == length {
+			tortoise = hare
+			power *= 2
+			length = 0
+		}
+		hare = step(hare)
+		length++
+	}
+
+	return findStart(start, step, equal, length)
+}
+
+// Floyd finds the cycle using Floyd's tortoise and hare algorithm
+// The hare moves two steps for every step of the tortoise until they meet inside the cycle,
+// after which the tail length and the cycle length are measured separately.
+//
+// Time complexity: O(Start + Length) step calls
+// Space complexity: O(1)
+func Floyd[S comparable](start S, step func(S) S) Cycle[S] {
+	return FloydFunc(start, step, func(a, b S) bool { return a == b })
+}
+
+// FloydFunc is Floyd for states that are not comparable with ==, such as slices
+func FloydFunc[S any](start S, step func(S) S, equal func(a, b S) bool) Cycle[S] {
+	// Phase 1: the hare catches up with the tortoise somewhere on the cycle
+	tortoise, hare := step(start), step(step(start))
+	for !equal(tortoise, hare) {
+		tortoise = step(tortoise)
+		hare = step(step(hare))
+	}
+
+	// Phase 2: measure the cycle by walking once around it
+	length := 1
+	for hare = step(tortoise); !equal(tortoise, hare); hare = step(hare) {
+		length++
+	}
+
+	return findStart(start, step, equal, length)
+}
+
+// Hash finds the cycle by remembering the step at which every state was first seen
+// It needs memory for every visited state but calls step exactly Start + Length times,
+// which pays off when step is expensive.
+//
+// Time complexity: O(Start + Length) step calls
+// Space complexity: O(Start + Length)
+func Hash[S comparable](start S, step func(S) S) Cycle[S] {
+	return HashBy(start, step, func(s S) S { return s })
+}
+
+// HashBy is Hash for states that are not comparable, identified by a comparable key
+// Two states with the same key must evolve identically
+func HashBy[S any, K comparable](start S, step func(S) S, key func(S) K) Cycle[S] {
+	seen := map[K]int{}
+	state := start
+	for n := 0; ; n++ {
+		k := key(state)
+		if first, ok := seen[k]; ok {
+			// state repeats the one seen at step first, so it is the cycle entry
+			return Cycle[S]{Start: first, Length: n - first, Entry: state}
+		}
+		seen[k] = n
+		state = step(state)
+	}
+}
+
+// findStart locates the tail length once the cycle length is known
+// A pointer that is length steps ahead meets the other one exactly at the cycle entry
+func findStart[S any](start S, step func(S) S, equal func(a, b S) bool, length int) Cycle[S] {
+	tortoise, hare := start, advance(start, step, length)
+	mu := 0
+	for !equal(tortoise, hare) {
+		tortoise = step(tortoise)
+		hare = step(hare)
+		mu++
+	}
+	return Cycle[S]{Start: mu, Length: length, Entry: tortoise}
+}
+
+// advance applies step n times
+func advance[S any](state S, step func(S) S, n int) S {
+	for i := 0; i < n; i++ {
+		state = step(state)
+	}
+	return state
+}
@@ -0,0 +1,121 @@
+package cycle
+
+import (
+	"slices"
+	"testing"
+)
+
+// rho builds a step function over 0..tail+length-1 with the given tail and cycle lengths
+func rho(tail, length int) func(int) int {
+	return func(x int) int {
+		if x+1 < tail+length {
+			return x + 1
+		}
+		return tail
+	}
+}
+
+func TestDetectors(t *testing.T) {
+	detectors := []struct {
+		name   string
+		detect func(start int, step func(int) int) Cycle[int]
+	}{
+		{"Brent", Brent[int]},
+		{"Floyd", Floyd[int]},
+		{"Hash", Hash[int]},
+	}
+
+	tests := []struct {
+		name   string
+		tail   int
+		length int
+	}{
+		{"fixed point", 0, 1},
+		{"pure cycle", 0, 7},
+		{"tail and self loop", 5, 1},
+		{"tail and cycle", 3, 4},
+		{"long tail", 100, 3},
+		{"long cycle", 2, 1000},
+	}
+
+	for _, d := range detectors {
+		for _, tt := range tests {
+			t.Run(d.name+"/"+tt.name, func(t *testing.T) {
+				got := d.detect(0, rho(tt.tail, tt.length))
+				want := Cycle[int]{Start: tt.tail, Length: tt.length, Entry: tt.tail}
+				if got != want {
+					t.Errorf("got %+v, want %+v", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestFuncVariants(t *testing.T) {
+	// A slice state is not comparable: rotate a ring of five values after one tail step
+	step := func(s []int) []int {
+		if s[0] == 9 {
+			return []int{2, 3, 4, 0, 1}
+		}
+		return append(slices.Clone(s[1:]), s[0])
+	}
+	start := []int{9, 9, 9, 9, 9}
+
+	for name, c := range map[string]Cycle[[]int]{
+		"BrentFunc": BrentFunc(start, step, slices.Equal[[]int]),
+		"FloydFunc": FloydFunc(start, step, slices.Equal[[]int]),
+		"HashBy":    HashBy(start, step, func(s []int) [5]int { return [5]int(s) }),
+	} {
+		if c.Start != 1 || c.Length != 5 || !slices.Equal(c.Entry, []int{2, 3, 4, 0, 1}) {
+			t.Errorf("%s() = %+v, want start 1, length 5, entry [2 3 4 0 1]", name, c)
+		}
+	}
+}
+
+func TestJump(t *testing.T) {
+	step := rho(3, 4)
+	c := Brent(0, step)
+
+	tests := []struct {
+		n         int
+		wantIndex int
+		wantState int
+	}{
+		{0, 0, 0},
+		{2, 2, 2},
+		{3, 3, 3},
+		{7, 3, 3},
+		{10, 6, 6},
+		{1_000_000_000_000, 4, 4},
+	}
+
+	for _, tt := range tests {
+		if got := c.Index(tt.n); got != tt.wantIndex {
+			t.Errorf("Index(%d) = %d, want %d", tt.n, got, tt.wantIndex)
+		}
+		if got := c.Jump(0, step, tt.n); got != tt.wantState {
+			t.Errorf("Jump(%d) = %d, want %d", tt.n, got, tt.wantState)
+		}
+	}
+}
+
+func BenchmarkDetectors(b *testing.B) {
+	// Pollard-style x*x+1 map modulo a prime, which is eventually periodic
+	step := func(x int) int { return (x*x + 1) % 1_000_003 }
+
+	b.Run("Brent", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Brent(2, step)
+		}
+	})
+	b.Run("Floyd", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Floyd(2, step)
+		}
+	})
+	b.Run("Hash", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Hash(2, step)
+		}
+	})
+}
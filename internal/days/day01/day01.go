@@ -1,9 +1,12 @@
 package day01
 
 import (
+	"fmt"
+	"io"
 	"sort"
 	"strings"
 
+	"github.com/amoilanen/advent-of-code-2024/internal/collections"
 	"github.com/amoilanen/advent-of-code-2024/internal/utils"
 )
 
@@ -21,27 +24,47 @@ type LocationLists struct {
 }
 
 // Parse parses the input into two lists of location IDs
-func Parse(input string) LocationLists {
-	lines := utils.AsLines(input)
-	lists := LocationLists{
-		Left:  make([]int, 0, len(lines)),
-		Right: make([]int, 0, len(lines)),
+func Parse(input string) (LocationLists, error) {
+	return ParseReader(strings.NewReader(input))
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) LocationLists {
+	lists, err := Parse(input)
+	if err != nil {
+		panic(err)
 	}
+	return lists
+}
 
-	for _, line := range lines {
-		if line == "" {
-			continue
-		}
-		// Split by whitespace and parse two numbers
+// ParseReader parses the two lists of location IDs line by line from r
+// Both lists have to be kept for sorting, but the input text is never held in memory as a whole
+func ParseReader(r io.Reader) (LocationLists, error) {
+	var lists LocationLists
+	number := 0
+	err := utils.ScanLines(r, func(line string) error {
+		number++
+		// Split by whitespace and parse two numbers; blank lines hold no pair
 		parts := strings.Fields(line)
+		if len(parts) == 0 {
+			return nil
+		}
 		if len(parts) != 2 {
-			continue
+			return fmt.Errorf("line %d: want two location IDs, got %q", number, line)
 		}
-		lists.Left = append(lists.Left, utils.MustParseInt(parts[0]))
-		lists.Right = append(lists.Right, utils.MustParseInt(parts[1]))
-	}
-
-	return lists
+		left, err := utils.ParseInt(parts[0])
+		if err != nil {
+			return fmt.Errorf("line %d: %w", number, err)
+		}
+		right, err := utils.ParseInt(parts[1])
+		if err != nil {
+			return fmt.Errorf("line %d: %w", number, err)
+		}
+		lists.Left = append(lists.Left, left)
+		lists.Right = append(lists.Right, right)
+		return nil
+	})
+	return lists, err
 }
 
 // Part1 calculates the total distance between the two lists
@@ -72,10 +95,7 @@ func Part1(lists LocationLists) int {
 // it appears in the right list, then sum all products
 func Part2(lists LocationLists) int {
 	// Build frequency map of right list
-	rightFreq := make(map[int]int)
-	for _, num := range lists.Right {
-		rightFreq[num]++
-	}
+	rightFreq := collections.NewCounter(lists.Right...)
 
 	// Calculate similarity score
 	similarityScore := 0
@@ -1,8 +1,13 @@
 package day01
 
 import (
+	"io"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/heapstat"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
 )
 
 func TestParse(t *testing.T) {
@@ -18,17 +23,17 @@ func TestParse(t *testing.T) {
 		Right: []int{4, 3, 5, 3, 9, 3},
 	}
 
-	got := Parse(input)
+	got := MustParse(input)
 	if !reflect.DeepEqual(got, want) {
-		t.Errorf("Parse() = %v, want %v", got, want)
+		t.Errorf("MustParse() = %v, want %v", got, want)
 	}
 }
 
 func TestParseEmptyInput(t *testing.T) {
 	input := ""
-	got := Parse(input)
+	got := MustParse(input)
 	if len(got.Left) != 0 || len(got.Right) != 0 {
-		t.Errorf("Parse() = %v, want empty lists", got)
+		t.Errorf("MustParse() = %v, want empty lists", got)
 	}
 }
 
@@ -38,9 +43,9 @@ func TestParseSingleLine(t *testing.T) {
 		Left:  []int{10},
 		Right: []int{20},
 	}
-	got := Parse(input)
+	got := MustParse(input)
 	if !reflect.DeepEqual(got, want) {
-		t.Errorf("Parse() = %v, want %v", got, want)
+		t.Errorf("MustParse() = %v, want %v", got, want)
 	}
 }
 
@@ -52,91 +57,54 @@ func TestParseWithVariableSpacing(t *testing.T) {
 		Left:  []int{1, 3, 5},
 		Right: []int{2, 4, 6},
 	}
-	got := Parse(input)
+	got := MustParse(input)
 	if !reflect.DeepEqual(got, want) {
-		t.Errorf("Parse() = %v, want %v", got, want)
+		t.Errorf("MustParse() = %v, want %v", got, want)
 	}
 }
 
-func TestPart1(t *testing.T) {
-	tests := []struct {
-		name  string
-		lists LocationLists
-		want  int
-	}{
-		{
-			name: "example from problem description",
-			lists: LocationLists{
-				Left:  []int{3, 4, 2, 1, 3, 3},
-				Right: []int{4, 3, 5, 3, 9, 3},
-			},
-			want: 11, // 2 + 1 + 0 + 1 + 2 + 5 = 11
-		},
-		{
-			name: "identical lists",
-			lists: LocationLists{
-				Left:  []int{1, 2, 3},
-				Right: []int{1, 2, 3},
-			},
-			want: 0,
-		},
-		{
-			name: "single pair",
-			lists: LocationLists{
-				Left:  []int{5},
-				Right: []int{10},
-			},
-			want: 5,
-		},
-		{
-			name: "all same distance",
-			lists: LocationLists{
-				Left:  []int{1, 2, 3},
-				Right: []int{2, 3, 4},
-			},
-			want: 3, // 1 + 1 + 1
-		},
-	}
+var puzzle = testkit.Puzzle[LocationLists]{
+	Day:   1,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "example from problem description", Input: ExampleInput, Part: 1, Want: "11"}, // 2 + 1 + 0 + 1 + 2 + 5 = 11
+		{Name: "identical lists", Input: "1   1\n2   2\n3   3", Part: 1, Want: "0"},
+		{Name: "single pair", Input: "5   10", Part: 1, Want: "5"},
+		{Name: "all same distance", Input: "1   2\n2   3\n3   4", Part: 1, Want: "3"}, // 1 + 1 + 1
+
+		{Name: "example from problem description", Input: ExampleInput, Part: 2, Want: "31"}, // 3*3 + 4*1 + 2*0 + 1*0 + 3*3 + 3*3 = 31
+		{Name: "no matches", Input: "1   4\n2   5\n3   6", Part: 2, Want: "0"},
+		{Name: "all matches once", Input: "1   1\n2   2\n3   3", Part: 2, Want: "6"}, // 1*1 + 2*1 + 3*1 = 6
+		{Name: "repeated number", Input: "5   5\n5   5", Part: 2, Want: "20"},        // 5*2 + 5*2 = 20
+		{Name: "empty lists", Input: "", Part: 2, Want: "0"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "not a number", Input: "3   x"},
+		{Name: "too large", Input: "3   99999999999999999999"},
+		{Name: "three numbers", Input: "3   4\n3   4   5"},
+		{Name: "one number", Input: "3   4\n3"},
+	},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 2500, Bytes: 160 << 10, PeakBytes: 256 << 10},
+		Part1: testkit.Budget{Allocs: 4, Bytes: 32 << 10, PeakBytes: 64 << 10},
+		Part2: testkit.Budget{Allocs: 8, Bytes: 64 << 10, PeakBytes: 128 << 10},
+	},
+}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := Part1(tt.lists); got != tt.want {
-				t.Errorf("Part1() = %v, want %v", got, tt.want)
-			}
-		})
-	}
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
 }
 
-func TestPart2(t *testing.T) {
+// TestPart2UnevenLists covers lists of different lengths, which the input format cannot express
+func TestPart2UnevenLists(t *testing.T) {
 	tests := []struct {
 		name  string
 		lists LocationLists
 		want  int
 	}{
-		{
-			name: "example from problem description",
-			lists: LocationLists{
-				Left:  []int{3, 4, 2, 1, 3, 3},
-				Right: []int{4, 3, 5, 3, 9, 3},
-			},
-			want: 31, // 3*3 + 4*1 + 2*0 + 1*0 + 3*3 + 3*3 = 9 + 4 + 0 + 0 + 9 + 9 = 31
-		},
-		{
-			name: "no matches",
-			lists: LocationLists{
-				Left:  []int{1, 2, 3},
-				Right: []int{4, 5, 6},
-			},
-			want: 0,
-		},
-		{
-			name: "all matches once",
-			lists: LocationLists{
-				Left:  []int{1, 2, 3},
-				Right: []int{1, 2, 3},
-			},
-			want: 6, // 1*1 + 2*1 + 3*1 = 6
-		},
 		{
 			name: "single number multiple times",
 			lists: LocationLists{
@@ -145,14 +113,6 @@ func TestPart2(t *testing.T) {
 			},
 			want: 30, // 5*2 + 5*2 + 5*2 = 10 + 10 + 10 = 30
 		},
-		{
-			name: "empty lists",
-			lists: LocationLists{
-				Left:  []int{},
-				Right: []int{},
-			},
-			want: 0,
-		},
 		{
 			name: "left empty, right has values",
 			lists: LocationLists{
@@ -180,38 +140,57 @@ func TestPart2(t *testing.T) {
 	}
 }
 
-func TestWithExampleInput(t *testing.T) {
-	parsed := Parse(ExampleInput)
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
+
+func TestParseReader(t *testing.T) {
+	got, err := ParseReader(strings.NewReader(ExampleInput))
+	if err != nil {
+		t.Fatalf("ParseReader() error = %v", err)
+	}
+	if want := MustParse(ExampleInput); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseReader() = %v, want %v", got, want)
+	}
 
-	t.Run("Part1 with example input", func(t *testing.T) {
-		want := 11
-		if got := Part1(parsed); got != want {
-			t.Errorf("Part1() = %v, want %v", got, want)
+	if _, err := ParseReader(strings.NewReader("3   4\n4   x")); err == nil {
+		t.Error("ParseReader() error = nil for a line that is not a number")
+	}
+}
+
+// BenchmarkParseReader compares the peak heap of parsing a large input from a string and streaming it from a reader
+// Run with -bench BenchmarkParseReader -benchtime 1x to see the peak-heap-B metric; both keep the two lists in full,
+// which dwarf the input text, so unlike the days that stream records the peaks are of the same order
+func BenchmarkParseReader(b *testing.B) {
+	const copies = 500_000 // About 18 MB of input
+	block := ExampleInput + "\n"
+
+	b.Run("string", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			data, _ := io.ReadAll(peak.Reader(heapstat.Repeat(block, copies)))
+			lists := MustParse(string(data))
+			peak.Force()
+			Part1(lists)
+			peak.Report(b)
 		}
 	})
 
-	t.Run("Part2 with example input", func(t *testing.T) {
-		want := 31
-		if got := Part2(parsed); got != want {
-			t.Errorf("Part2() = %v, want %v", got, want)
+	b.Run("reader", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			lists, err := ParseReader(peak.Reader(heapstat.Repeat(block, copies)))
+			if err != nil {
+				b.Fatal(err)
+			}
+			Part1(lists)
+			peak.Report(b)
 		}
 	})
 }
 
-// BenchmarkPart1 benchmarks the Part1 solution
-func BenchmarkPart1(b *testing.B) {
-	parsed := Parse(ExampleInput)
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		Part1(parsed)
-	}
-}
-
-// BenchmarkPart2 benchmarks the Part2 solution
-func BenchmarkPart2(b *testing.B) {
-	parsed := Parse(ExampleInput)
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		Part2(parsed)
-	}
+// FuzzParse checks that malformed input makes Parse return an error rather than panic
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, nil)
 }
@@ -1,6 +1,9 @@
 package day02
 
 import (
+	"io"
+	"strings"
+
 	"github.com/amoilanen/advent-of-code-2024/internal/utils"
 )
 
@@ -16,21 +19,41 @@ type Report []int
 
 // Parse parses the input into a slice of reports
 // Each line represents one report with space-separated levels
-func Parse(input string) []Report {
-	lines := utils.AsLines(input)
-	reports := make([]Report, 0, len(lines))
+func Parse(input string) ([]Report, error) {
+	return ParseReader(strings.NewReader(input))
+}
 
-	for _, line := range lines {
-		if line == "" {
-			continue
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) []Report {
+	reports, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return reports
+}
+
+// ParseReader parses all reports from r
+func ParseReader(r io.Reader) ([]Report, error) {
+	reports := []Report{}
+	err := EachReport(r, func(report Report) {
+		reports = append(reports, report)
+	})
+	return reports, err
+}
+
+// EachReport calls fn with the reports of r one at a time
+// Only the current report is held in memory, whatever the size of the input
+func EachReport(r io.Reader, fn func(Report)) error {
+	return utils.ScanLines(r, func(line string) error {
+		levels, err := utils.ParseInts(line)
+		if err != nil {
+			return err
 		}
-		levels := utils.MustParseInts(line)
 		if len(levels) > 0 {
-			reports = append(reports, Report(levels))
+			fn(Report(levels))
 		}
-	}
-
-	return reports
+		return nil
+	})
 }
 
 // isSafe checks if a report is safe according to the rules:
@@ -147,3 +170,17 @@ func Part2(reports []Report) int {
 	}
 	return safeCount
 }
+
+// SolveReader counts the safe reports of both parts while streaming the reports from r
+// Memory use is bounded by the longest report rather than the size of the input
+func SolveReader(r io.Reader) (part1 int, part2 int, err error) {
+	err = EachReport(r, func(report Report) {
+		if isSafe(report, nil) {
+			part1++
+		}
+		if isSafeWithDampener(report) {
+			part2++
+		}
+	})
+	return part1, part2, err
+}
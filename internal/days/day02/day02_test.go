@@ -1,8 +1,13 @@
 package day02
 
 import (
+	"io"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/heapstat"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
 )
 
 func TestParse(t *testing.T) {
@@ -16,26 +21,26 @@ func TestParse(t *testing.T) {
 		{9, 7, 6, 2, 1},
 	}
 
-	got := Parse(input)
+	got := MustParse(input)
 	if !reflect.DeepEqual(got, want) {
-		t.Errorf("Parse() = %v, want %v", got, want)
+		t.Errorf("MustParse() = %v, want %v", got, want)
 	}
 }
 
 func TestParseEmptyInput(t *testing.T) {
 	input := ""
-	got := Parse(input)
+	got := MustParse(input)
 	if len(got) != 0 {
-		t.Errorf("Parse() = %v, want empty slice", got)
+		t.Errorf("MustParse() = %v, want empty slice", got)
 	}
 }
 
 func TestParseSingleLine(t *testing.T) {
 	input := `1 2 3 4 5`
 	want := []Report{{1, 2, 3, 4, 5}}
-	got := Parse(input)
+	got := MustParse(input)
 	if !reflect.DeepEqual(got, want) {
-		t.Errorf("Parse() = %v, want %v", got, want)
+		t.Errorf("MustParse() = %v, want %v", got, want)
 	}
 }
 
@@ -209,40 +214,90 @@ func TestIsSafeWithDampener(t *testing.T) {
 	}
 }
 
-func TestWithExampleInput(t *testing.T) {
-	parsed := Parse(ExampleInput)
+var puzzle = testkit.Puzzle[[]Report]{
+	Day:   2,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "2"},
+		{Name: "example", Input: ExampleInput, Part: 2, Want: "4"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "not a number", Input: "7 6 x 2 1"},
+		{Name: "too large", Input: "1 99999999999999999999"},
+	},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 3800, Bytes: 384 << 10, PeakBytes: 512 << 10},
+		Part1: testkit.Budget{Allocs: 1250, Bytes: 96 << 10, PeakBytes: 128 << 10},
+		Part2: testkit.Budget{Allocs: 4100, Bytes: 272 << 10, PeakBytes: 384 << 10},
+	},
+}
 
-	t.Run("Part1 with example input", func(t *testing.T) {
-		want := 2
-		got := Part1(parsed)
-		if got != want {
-			t.Errorf("Part1() = %v, want %v", got, want)
-		}
-	})
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
+}
 
-	t.Run("Part2 with example input", func(t *testing.T) {
-		want := 4
-		got := Part2(parsed)
-		if got != want {
-			t.Errorf("Part2() = %v, want %v", got, want)
-		}
-	})
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
 }
 
-// BenchmarkPart1 benchmarks the Part1 solution
-func BenchmarkPart1(b *testing.B) {
-	parsed := Parse(ExampleInput)
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		Part1(parsed)
+func TestParseReader(t *testing.T) {
+	got, err := ParseReader(strings.NewReader(ExampleInput))
+	if err != nil {
+		t.Fatalf("ParseReader() error = %v", err)
+	}
+	if want := MustParse(ExampleInput); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseReader() = %v, want %v", got, want)
+	}
+
+	if _, err := ParseReader(strings.NewReader("7 6 4\n1 x 7")); err == nil {
+		t.Error("ParseReader() error = nil for a level that is not a number")
 	}
 }
 
-// BenchmarkPart2 benchmarks the Part2 solution
-func BenchmarkPart2(b *testing.B) {
-	parsed := Parse(ExampleInput)
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		Part2(parsed)
+func TestSolveReader(t *testing.T) {
+	part1, part2, err := SolveReader(strings.NewReader(ExampleInput))
+	if err != nil {
+		t.Fatalf("SolveReader() error = %v", err)
+	}
+	if part1 != 2 || part2 != 4 {
+		t.Errorf("SolveReader() = %d, %d, want 2, 4", part1, part2)
 	}
 }
+
+// BenchmarkSolveReader compares the peak heap of parsing a large input from a string and streaming it from a reader
+// Run with -bench BenchmarkSolveReader -benchtime 1x to see the peak-heap-B metric; streaming keeps one report at a time
+func BenchmarkSolveReader(b *testing.B) {
+	const copies = 100_000 // About 6 MB of input
+	block := ExampleInput + "\n"
+
+	b.Run("string", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			data, _ := io.ReadAll(peak.Reader(heapstat.Repeat(block, copies)))
+			reports := MustParse(string(data))
+			peak.Force()
+			Part1(reports)
+			Part2(reports)
+			peak.Report(b)
+		}
+	})
+
+	b.Run("reader", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			if _, _, err := SolveReader(peak.Reader(heapstat.Repeat(block, copies))); err != nil {
+				b.Fatal(err)
+			}
+			peak.Report(b)
+		}
+	})
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, nil)
+}
@@ -1,8 +1,11 @@
 package day03
 
 import (
+	"bufio"
+	"io"
 	"regexp"
 	"strconv"
+	"strings"
 )
 
 const ExampleInput = `xmul(2,4)%&mul[3,7]!@^do_not_mul(5,5)+mul(32,64]then(mul(11,8)mul(8,5))`
@@ -45,30 +48,65 @@ func (d DontInstruction) Position() int { return d.Pos }
 var instructionPattern = regexp.MustCompile(`mul\((\d{1,3}),(\d{1,3})\)|do\(\)|don't\(\)`)
 
 // Parse extracts all instructions (mul, do, don't) from the corrupted memory
-func Parse(input string) []Instruction {
-	matches := instructionPattern.FindAllStringSubmatch(input, -1)
-	indices := instructionPattern.FindAllStringSubmatchIndex(input, -1)
-	instructions := make([]Instruction, 0, len(matches))
-
-	for i, match := range matches {
-		position := indices[i][0]
-
-		// Determine instruction type based on the matched string
-		if match[0] == "do()" {
-			instructions = append(instructions, DoInstruction{Pos: position})
-		} else if match[0] == "don't()" {
-			instructions = append(instructions, DontInstruction{Pos: position})
-		} else {
-			x, _ := strconv.Atoi(match[1])
-			y, _ := strconv.Atoi(match[2])
-			instructions = append(instructions, MulInstruction{
-				Pos: position,
-				Mul: Mul{X: x, Y: y},
-			})
+// Anything that is not an instruction is corrupted memory, so Parse only fails if reading does
+func Parse(input string) ([]Instruction, error) {
+	return ParseReader(strings.NewReader(input))
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) []Instruction {
+	instructions, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return instructions
+}
+
+// ParseReader extracts all instructions from the corrupted memory in r
+func ParseReader(r io.Reader) ([]Instruction, error) {
+	instructions := []Instruction{}
+	err := EachInstruction(r, func(instruction Instruction) {
+		instructions = append(instructions, instruction)
+	})
+	return instructions, err
+}
+
+// EachInstruction calls fn with the instructions of r in order
+// No instruction contains a line break, so r is searched one line at a time; Pos is still
+// the offset of the instruction in the whole input
+func EachInstruction(r io.Reader, fn func(Instruction)) error {
+	reader := bufio.NewReader(r)
+	offset := 0
+	for {
+		line, err := reader.ReadString('\n')
+		for _, match := range instructionPattern.FindAllStringSubmatchIndex(line, -1) {
+			fn(instructionAt(line, match, offset))
+		}
+		offset += len(line)
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
 		}
 	}
+}
 
-	return instructions
+// instructionAt builds the instruction for one match of instructionPattern in line
+func instructionAt(line string, match []int, offset int) Instruction {
+	position := offset + match[0]
+
+	// Determine instruction type based on the matched string
+	switch line[match[0]:match[1]] {
+	case "do()":
+		return DoInstruction{Pos: position}
+	case "don't()":
+		return DontInstruction{Pos: position}
+	}
+	x, _ := strconv.Atoi(line[match[2]:match[3]])
+	y, _ := strconv.Atoi(line[match[4]:match[5]])
+	return MulInstruction{Pos: position, Mul: Mul{X: x, Y: y}}
 }
 
 // Part1 calculates the sum of all multiplication results
@@ -104,3 +142,23 @@ func Part2(instructions []Instruction) int {
 
 	return sum
 }
+
+// SolveReader sums the multiplications of both parts while streaming the instructions from r
+// Memory use is bounded by the longest line rather than the size of the input
+func SolveReader(r io.Reader) (part1 int, part2 int, err error) {
+	enabled := true
+	err = EachInstruction(r, func(instruction Instruction) {
+		switch inst := instruction.(type) {
+		case DoInstruction:
+			enabled = true
+		case DontInstruction:
+			enabled = false
+		case MulInstruction:
+			part1 += inst.Mul.X * inst.Mul.Y
+			if enabled {
+				part2 += inst.Mul.X * inst.Mul.Y
+			}
+		}
+	})
+	return part1, part2, err
+}
@@ -1,32 +1,38 @@
 package day03
 
 import (
+	"io"
+	"reflect"
+	"strings"
 	"testing"
-)
 
-func TestPart1WithExample(t *testing.T) {
-	instructions := Parse(ExampleInput)
-	result := Part1(instructions)
-	expected := 161 // 2*4 + 5*5 + 11*8 + 8*5 = 8 + 25 + 88 + 40 = 161
+	"github.com/amoilanen/advent-of-code-2024/internal/heapstat"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
+)
 
-	if result != expected {
-		t.Errorf("Part1(ExampleInput) = %d, want %d", result, expected)
-	}
+var puzzle = testkit.Puzzle[[]Instruction]{
+	Day:   3,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "161"},                       // 2*4 + 5*5 + 11*8 + 8*5 = 8 + 25 + 88 + 40 = 161
+		{Name: "example with conditionals", Input: ExampleInputPart2, Part: 2, Want: "48"}, // mul(5,5) and mul(11,8) are disabled by don't()
+	},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 2100, Bytes: 256 << 10, PeakBytes: 384 << 10},
+		Part1: testkit.Budget{PeakBytes: 16 << 10}, // Does not allocate
+		Part2: testkit.Budget{PeakBytes: 16 << 10}, // Does not allocate
+	},
 }
 
-func TestPart2WithExample(t *testing.T) {
-	instructions := Parse(ExampleInputPart2)
-	result := Part2(instructions)
-	expected := 48 // 2*4 + 8*5 = 8 + 40 = 48
-	// mul(5,5) and mul(11,8) are disabled by don't()
-
-	if result != expected {
-		t.Errorf("Part2(ExampleInputPart2) = %d, want %d", result, expected)
-	}
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
 }
 
 func TestParseInstructions(t *testing.T) {
-	instructions := Parse(ExampleInputPart2)
+	instructions := MustParse(ExampleInputPart2)
 
 	// Expected sequence: mul(2,4), don't(), mul(5,5), mul(11,8), do(), mul(8,5)
 	if len(instructions) != 6 {
@@ -59,3 +65,81 @@ func TestParseInstructions(t *testing.T) {
 		t.Errorf("Instruction 5: got Mul{X: %d, Y: %d}, want Mul{X: 8, Y: 5}", mul5.Mul.X, mul5.Mul.Y)
 	}
 }
+
+func TestParseReader(t *testing.T) {
+	// A line break between don't() and mul() must not lose the disabled state or shift positions
+	input := ExampleInputPart2 + "\nmul(1,2)\r\ndon't()\n\nmul(3,4)do()"
+
+	got, err := ParseReader(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseReader() error = %v", err)
+	}
+	want := MustParse(input)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseReader() = %v, want %v", got, want)
+	}
+}
+
+func TestSolveReader(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		part1 int
+		part2 int
+	}{
+		{"example", ExampleInputPart2, 161, 48},
+		{"disabled across lines", "don't()\nmul(2,3)\ndo()mul(1,1)", 7, 1},
+		{"empty", "", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			part1, part2, err := SolveReader(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("SolveReader() error = %v", err)
+			}
+			if part1 != tt.part1 || part2 != tt.part2 {
+				t.Errorf("SolveReader() = %d, %d, want %d, %d", part1, part2, tt.part1, tt.part2)
+			}
+		})
+	}
+}
+
+// BenchmarkSolveReader compares the peak heap of parsing a large input from a string and streaming it from a reader
+// Run with -bench BenchmarkSolveReader -benchtime 1x to see the peak-heap-B metric; streaming keeps one line at a time
+func BenchmarkSolveReader(b *testing.B) {
+	const copies = 100_000 // About 7 MB of input
+	block := ExampleInput + "\n"
+
+	b.Run("string", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			data, _ := io.ReadAll(peak.Reader(heapstat.Repeat(block, copies)))
+			instructions := MustParse(string(data))
+			peak.Force()
+			Part1(instructions)
+			Part2(instructions)
+			peak.Report(b)
+		}
+	})
+
+	b.Run("reader", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			if _, _, err := SolveReader(peak.Reader(heapstat.Repeat(block, copies))); err != nil {
+				b.Fatal(err)
+			}
+			peak.Report(b)
+		}
+	})
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, nil)
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
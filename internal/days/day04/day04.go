@@ -1,6 +1,10 @@
 package day04
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/utils"
+)
 
 const ExampleInput = `MMMSXXMASM
 MSAMXMSMSA
@@ -17,15 +21,37 @@ MXMXAXMASX`
 type Grid [][]rune
 
 // Parse converts the input string into a 2D grid
-func Parse(input string) Grid {
-	lines := strings.Split(strings.TrimSpace(input), "\n")
+// The lines have to form a rectangle, as Part1 and Part2 take the width from the first row
+func Parse(input string) (Grid, error) {
+	lines, err := utils.AsGrid(input)
+	if err != nil {
+		return nil, err
+	}
 	grid := make(Grid, len(lines))
 	for i, line := range lines {
 		grid[i] = []rune(line)
 	}
+	return grid, nil
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) Grid {
+	grid, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
 	return grid
 }
 
+// String returns the grid in the format of the puzzle input
+func (g Grid) String() string {
+	lines := make([]string, len(g))
+	for i, row := range g {
+		lines[i] = string(row)
+	}
+	return strings.Join(lines, "\n")
+}
+
 // dimensions returns the number of rows and columns in the grid
 func (g Grid) dimensions() (rows, cols int) {
 	rows = len(g)
@@ -1,27 +1,38 @@
 package day04
 
-import "testing"
+import (
+	"testing"
 
-func TestPart1Example(t *testing.T) {
-	grid := Parse(ExampleInput)
-	result := Part1(grid)
-	expected := 18
-	if result != expected {
-		t.Errorf("Part1(ExampleInput) = %d; want %d", result, expected)
-	}
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
+)
+
+var puzzle = testkit.Puzzle[Grid]{
+	Day:   4,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "18"},
+		{Name: "example", Input: ExampleInput, Part: 2, Want: "9"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "ragged lines", Input: "XMAS\nXM"},
+		{Name: "non-ASCII letter", Input: "XMAS\nXMAÅ"},
+	},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 180, Bytes: 136 << 10, PeakBytes: 192 << 10},
+		Part1: testkit.Budget{PeakBytes: 16 << 10}, // Does not allocate
+		Part2: testkit.Budget{PeakBytes: 16 << 10}, // Does not allocate
+	},
 }
 
-func TestPart2Example(t *testing.T) {
-	grid := Parse(ExampleInput)
-	result := Part2(grid)
-	expected := 9
-	if result != expected {
-		t.Errorf("Part2(ExampleInput) = %d; want %d", result, expected)
-	}
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
 }
 
 func TestParse(t *testing.T) {
-	grid := Parse(ExampleInput)
+	grid := MustParse(ExampleInput)
 	if len(grid) != 10 {
 		t.Errorf("Expected 10 rows, got %d", len(grid))
 	}
@@ -31,7 +42,7 @@ func TestParse(t *testing.T) {
 }
 
 func TestSearchWord(t *testing.T) {
-	grid := Parse(ExampleInput)
+	grid := MustParse(ExampleInput)
 
 	tests := []struct {
 		name     string
@@ -69,3 +80,14 @@ func TestSearchWord(t *testing.T) {
 		})
 	}
 }
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic,
+// and that parsing what String writes gives back the same grid
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, puzzle.RoundTrip(Grid.String))
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
@@ -1,8 +1,12 @@
 package day05
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/collections"
+	"github.com/amoilanen/advent-of-code-2024/internal/graph"
 )
 
 const ExampleInput = `47|53
@@ -40,26 +44,23 @@ type OrderingRule struct {
 	After  int
 }
 
-// IntSet represents a set of integers (Go's idiomatic set using map with empty struct)
-type IntSet map[int]struct{}
-
 // RuleSet is an efficient structure for checking ordering rules
 // It maps each page to the set of pages that must come after it
 type RuleSet struct {
-	mustComeAfter map[int]IntSet
+	mustComeAfter map[int]collections.Set[int]
 }
 
 // newRuleSet builds an efficient rule lookup structure from ordering rules
 func newRuleSet(rules []OrderingRule) *RuleSet {
 	rs := &RuleSet{
-		mustComeAfter: make(map[int]IntSet),
+		mustComeAfter: make(map[int]collections.Set[int]),
 	}
 
 	for _, rule := range rules {
 		if rs.mustComeAfter[rule.Before] == nil {
-			rs.mustComeAfter[rule.Before] = make(IntSet)
+			rs.mustComeAfter[rule.Before] = collections.NewSet[int]()
 		}
-		rs.mustComeAfter[rule.Before][rule.After] = struct{}{}
+		rs.mustComeAfter[rule.Before].Add(rule.After)
 	}
 
 	return rs
@@ -76,25 +77,31 @@ type Input struct {
 }
 
 // Parse parses the input into rules and updates
-func Parse(input string) Input {
+// The rules and the updates are separated by an empty line; every rule is "before|after"
+// and every update a comma-separated list of page numbers
+func Parse(input string) (Input, error) {
 	sections := strings.Split(strings.TrimSpace(input), "\n\n")
 	if len(sections) != 2 {
-		return Input{}
+		return Input{}, fmt.Errorf("want rules and updates separated by an empty line, got %d sections", len(sections))
 	}
 
 	// Parse rules
 	ruleLines := strings.Split(strings.TrimSpace(sections[0]), "\n")
 	rules := make([]OrderingRule, 0, len(ruleLines))
 	for _, line := range ruleLines {
-		parts := strings.Split(line, "|")
-		if len(parts) != 2 {
-			continue
+		before, after, ok := strings.Cut(line, "|")
+		if !ok {
+			return Input{}, fmt.Errorf("rule %q is not before|after", line)
+		}
+		beforePage, err := strconv.Atoi(strings.TrimSpace(before))
+		if err != nil {
+			return Input{}, fmt.Errorf("rule %q: %w", line, err)
 		}
-		before, err1 := strconv.Atoi(parts[0])
-		after, err2 := strconv.Atoi(parts[1])
-		if err1 == nil && err2 == nil {
-			rules = append(rules, OrderingRule{Before: before, After: after})
+		afterPage, err := strconv.Atoi(strings.TrimSpace(after))
+		if err != nil {
+			return Input{}, fmt.Errorf("rule %q: %w", line, err)
 		}
+		rules = append(rules, OrderingRule{Before: beforePage, After: afterPage})
 	}
 
 	// Parse updates
@@ -105,19 +112,27 @@ func Parse(input string) Input {
 		update := make(Update, 0, len(parts))
 		for _, part := range parts {
 			num, err := strconv.Atoi(strings.TrimSpace(part))
-			if err == nil {
-				update = append(update, num)
+			if err != nil {
+				return Input{}, fmt.Errorf("update %q: %w", line, err)
 			}
+			update = append(update, num)
 		}
-		if len(update) > 0 {
-			updates = append(updates, update)
-		}
+		updates = append(updates, update)
 	}
 
 	// Build efficient rule set
 	ruleSet := newRuleSet(rules)
 
-	return Input{Rules: rules, RuleSet: ruleSet, Updates: updates}
+	return Input{Rules: rules, RuleSet: ruleSet, Updates: updates}, nil
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) Input {
+	parsed, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
 }
 
 // isValid checks if an update follows all applicable ordering rules using a RuleSet
@@ -131,7 +146,7 @@ func (u Update) isValid(ruleSet *RuleSet) bool {
 			for j := 0; j < i; j++ {
 				precedingPage := u[j]
 				// If a preceding page should actually come after current page, it's a violation
-				if _, shouldFollow := mustFollowCurrent[precedingPage]; shouldFollow {
+				if mustFollowCurrent.Contains(precedingPage) {
 					return false
 				}
 			}
@@ -150,34 +165,30 @@ func (u Update) middlePage() int {
 }
 
 // reorder sorts the update pages according to the ordering rules
-// Uses a comparison function based on the RuleSet
+// The rules restricted to the pages of the update form a DAG, so a topological
+// sort of that subgraph yields an order satisfying every applicable rule
+// Falls back to the original order if the rules contain a cycle
 func (u Update) reorder(ruleSet *RuleSet) Update {
-	// Create a copy to avoid modifying the original
-	reordered := make(Update, len(u))
-	copy(reordered, u)
-
-	// Sort based on ordering rules
-	// We use a simple bubble sort to ensure stability and correctness
-	// For each pair of adjacent elements, check if they violate any rule
-	changed := true
-	for changed {
-		changed = false
-		for i := 0; i < len(reordered)-1; i++ {
-			pageI := reordered[i]
-			pageJ := reordered[i+1]
-
-			// Check if pageI must come after pageJ (wrong order)
-			if mustFollowJ, exists := ruleSet.mustComeAfter[pageJ]; exists {
-				if _, shouldFollow := mustFollowJ[pageI]; shouldFollow {
-					// Swap them
-					reordered[i], reordered[i+1] = reordered[i+1], reordered[i]
-					changed = true
-				}
+	// Successors of a page are the pages of this update that must come after it
+	successors := func(page int) []int {
+		mustFollow := ruleSet.mustComeAfter[page]
+		var after []int
+		for _, other := range u {
+			if mustFollow.Contains(other) {
+				after = append(after, other)
 			}
 		}
+		return after
+	}
+
+	sorted, err := graph.TopologicalSort(u, successors)
+	if err != nil {
+		reordered := make(Update, len(u))
+		copy(reordered, u)
+		return reordered
 	}
 
-	return reordered
+	return Update(sorted)
 }
 
 // Part1 finds the sum of middle page numbers from correctly-ordered updates
@@ -1,27 +1,46 @@
 package day05
 
-import "testing"
-
-func TestPart1Example(t *testing.T) {
-	input := Parse(ExampleInput)
-	result := Part1(input)
-	expected := 143
-	if result != expected {
-		t.Errorf("Part1(ExampleInput) = %d; want %d", result, expected)
-	}
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/prop"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
+)
+
+var puzzle = testkit.Puzzle[Input]{
+	Day:   5,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "143"},
+		{Name: "example", Input: ExampleInput, Part: 2, Want: "123"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "no updates", Input: "47|53"},
+		{Name: "rule without separator", Input: "47-53\n\n75,47"},
+		{Name: "rule with a word", Input: "47|x\n\n75,47"},
+		{Name: "update with a word", Input: "47|53\n\n75,x"},
+	},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 950, Bytes: 272 << 10, PeakBytes: 384 << 10},
+		Part1: testkit.Budget{PeakBytes: 16 << 10}, // Does not allocate
+		Part2: testkit.Budget{Allocs: 5500, Bytes: 616 << 10, PeakBytes: 1 << 20},
+	},
 }
 
-func TestPart2Example(t *testing.T) {
-	input := Parse(ExampleInput)
-	result := Part2(input)
-	expected := 123
-	if result != expected {
-		t.Errorf("Part2(ExampleInput) = %d; want %d", result, expected)
-	}
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
 }
 
 func TestParse(t *testing.T) {
-	input := Parse(ExampleInput)
+	input := MustParse(ExampleInput)
 
 	if len(input.Rules) != 21 {
 		t.Errorf("Expected 21 rules, got %d", len(input.Rules))
@@ -43,7 +62,7 @@ func TestParse(t *testing.T) {
 }
 
 func TestUpdateIsValid(t *testing.T) {
-	input := Parse(ExampleInput)
+	input := MustParse(ExampleInput)
 
 	tests := []struct {
 		name      string
@@ -91,7 +110,7 @@ func TestMiddlePage(t *testing.T) {
 }
 
 func TestReorder(t *testing.T) {
-	input := Parse(ExampleInput)
+	input := MustParse(ExampleInput)
 
 	tests := []struct {
 		name     string
@@ -131,3 +150,90 @@ func TestReorder(t *testing.T) {
 		})
 	}
 }
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, nil)
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
+
+// printJob is a set of ordering rules and one update to print with them
+type printJob struct {
+	rules  []OrderingRule
+	update Update
+}
+
+// String returns the job in the format of the puzzle input
+func (j printJob) String() string {
+	var sb strings.Builder
+	for _, rule := range j.rules {
+		fmt.Fprintf(&sb, "%d|%d\n", rule.Before, rule.After)
+	}
+	pages := make([]string, len(j.update))
+	for i, page := range j.update {
+		pages[i] = strconv.Itoa(page)
+	}
+	sb.WriteString("\n" + strings.Join(pages, ","))
+	return sb.String()
+}
+
+// printJobs generates rules that all agree with one hidden order of the pages, as in the puzzle,
+// and an update of distinct pages in random order; they shrink by dropping rules and all but one page
+func printJobs() prop.Gen[printJob] {
+	return prop.Gen[printJob]{
+		Generate: func(rng *rand.Rand, size int) printJob {
+			order := rng.Perm(90)[:2+rng.IntN(min(size, 20))]
+			for i := range order {
+				order[i] += 10
+			}
+
+			var rules []OrderingRule
+			for i := range order {
+				for j := i + 1; j < len(order); j++ {
+					if rng.IntN(2) == 0 {
+						rules = append(rules, OrderingRule{Before: order[i], After: order[j]})
+					}
+				}
+			}
+			rng.Shuffle(len(rules), func(i, j int) { rules[i], rules[j] = rules[j], rules[i] })
+
+			update := slices.Clone(order[:1+rng.IntN(len(order))])
+			rng.Shuffle(len(update), func(i, j int) { update[i], update[j] = update[j], update[i] })
+			return printJob{rules: rules, update: update}
+		},
+		Shrink: func(j printJob) []printJob {
+			var smaller []printJob
+			for _, rules := range prop.ShrinkSlice(j.rules, nil) {
+				smaller = append(smaller, printJob{rules: rules, update: j.update})
+			}
+			for _, update := range prop.ShrinkSlice(j.update, nil) {
+				if len(update) > 0 {
+					smaller = append(smaller, printJob{rules: j.rules, update: update})
+				}
+			}
+			return smaller
+		},
+		Format: printJob.String,
+	}
+}
+
+func TestReorderIsPermutationThatFollowsRules(t *testing.T) {
+	prop.Check(t, printJobs(), func(j printJob) error {
+		got := j.update.reorder(newRuleSet(j.rules))
+
+		if !slices.Equal(slices.Sorted(slices.Values(got)), slices.Sorted(slices.Values(j.update))) {
+			return fmt.Errorf("reorder(%v) = %v, which is not a permutation", j.update, got)
+		}
+		for _, rule := range j.rules {
+			before, after := slices.Index(got, rule.Before), slices.Index(got, rule.After)
+			if before >= 0 && after >= 0 && before > after {
+				return fmt.Errorf("reorder(%v) = %v breaks %d|%d", j.update, got, rule.Before, rule.After)
+			}
+		}
+		return nil
+	})
+}
@@ -1,6 +1,18 @@
 package day06
 
-import "strings"
+import (
+	"fmt"
+	"image/color"
+	"io"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/collections"
+	"github.com/amoilanen/advent-of-code-2024/internal/cycle"
+	"github.com/amoilanen/advent-of-code-2024/internal/render"
+	"github.com/amoilanen/advent-of-code-2024/internal/svg"
+	"github.com/amoilanen/advent-of-code-2024/internal/term"
+	"github.com/amoilanen/advent-of-code-2024/internal/utils"
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
+)
 
 const ExampleInput = `
 ....#.....
@@ -24,14 +36,20 @@ const (
 	Left
 )
 
-// Position represents a coordinate on the grid
-type Position struct {
-	Row, Col int
+// directionSteps maps each Direction to its unit step on the grid
+var directionSteps = [...]vector.Vec2{
+	Up:    vector.Up,
+	Right: vector.Right,
+	Down:  vector.Down,
+	Left:  vector.Left,
 }
 
+// Position represents a coordinate on the grid (X is the column, Y is the row)
+type Position = vector.Vec2
+
 // Grid represents the lab map
 type Grid struct {
-	obstacles map[Position]bool
+	obstacles collections.Set[Position]
 	rows      int
 	cols      int
 }
@@ -43,8 +61,12 @@ type Guard struct {
 }
 
 // Parse parses the input into a grid and guard
-func Parse(input string) (*Grid, *Guard) {
-	lines := strings.Split(strings.TrimSpace(input), "\n")
+// The map may only hold floor, obstructions and at most one guard; without a guard the returned guard is nil
+func Parse(input string) (*Grid, *Guard, error) {
+	lines, err := utils.AsGrid(input)
+	if err != nil {
+		return nil, nil, err
+	}
 	rows := len(lines)
 	cols := 0
 	if rows > 0 {
@@ -52,7 +74,7 @@ func Parse(input string) (*Grid, *Guard) {
 	}
 
 	grid := &Grid{
-		obstacles: make(map[Position]bool),
+		obstacles: collections.NewSet[Position](),
 		rows:      rows,
 		cols:      cols,
 	}
@@ -61,22 +83,42 @@ func Parse(input string) (*Grid, *Guard) {
 
 	for r, line := range lines {
 		for c, char := range line {
-			pos := Position{Row: r, Col: c}
+			pos := Position{X: c, Y: r}
+			dir := Direction(-1)
 			switch char {
+			case '.':
 			case '#':
-				grid.obstacles[pos] = true
+				grid.obstacles.Add(pos)
 			case '^':
-				guard = &Guard{pos: pos, dir: Up}
+				dir = Up
 			case '>':
-				guard = &Guard{pos: pos, dir: Right}
+				dir = Right
 			case 'v':
-				guard = &Guard{pos: pos, dir: Down}
+				dir = Down
 			case '<':
-				guard = &Guard{pos: pos, dir: Left}
+				dir = Left
+			default:
+				return nil, nil, fmt.Errorf("line %d, column %d: unexpected %q", r+1, c+1, char)
+			}
+			if dir < 0 {
+				continue
+			}
+			if guard != nil {
+				return nil, nil, fmt.Errorf("line %d, column %d: second guard", r+1, c+1)
 			}
+			guard = &Guard{pos: pos, dir: dir}
 		}
 	}
 
+	return grid, guard, nil
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) (*Grid, *Guard) {
+	grid, guard, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
 	return grid, guard
 }
 
@@ -87,18 +129,7 @@ func (g *Guard) turnRight() {
 
 // nextPosition returns the position directly in front of the guard
 func (g *Guard) nextPosition() Position {
-	pos := g.pos
-	switch g.dir {
-	case Up:
-		pos.Row--
-	case Right:
-		pos.Col++
-	case Down:
-		pos.Row++
-	case Left:
-		pos.Col--
-	}
-	return pos
+	return g.pos.Add(directionSteps[g.dir])
 }
 
 func (g *Guard) move() *Guard {
@@ -126,19 +157,19 @@ func (g *Guard) moveOnGrid(grid *Grid) (*Guard, bool) {
 
 // isInBounds checks if a position is within the grid
 func (grid *Grid) isInBounds(pos Position) bool {
-	return pos.Row >= 0 && pos.Row < grid.rows && pos.Col >= 0 && pos.Col < grid.cols
+	return pos.InBounds(grid.cols, grid.rows)
 }
 
 // hasObstacle checks if there's an obstacle at the given position
 func (grid *Grid) hasObstacle(pos Position) bool {
-	return grid.obstacles[pos]
+	return grid.obstacles.Contains(pos)
 }
 
 // simulatePatrol simulates the guard's patrol and returns visited positions
 // Creates a copy of the guard to avoid modifying the original
-func simulatePatrol(grid *Grid, guard *Guard) map[Position]bool {
+func simulatePatrol(grid *Grid, guard *Guard) collections.Set[Position] {
 	if guard == nil {
-		return make(map[Position]bool)
+		return collections.NewSet[Position]()
 	}
 
 	// Create a copy of the guard to avoid modifying the original
@@ -147,13 +178,12 @@ func simulatePatrol(grid *Grid, guard *Guard) map[Position]bool {
 		dir: guard.dir,
 	}
 
-	visited := make(map[Position]bool)
-	visited[simulatedGuard.pos] = true
+	visited := collections.NewSet(simulatedGuard.pos)
 
 	for {
 		_, movedOffGrid := simulatedGuard.moveOnGrid(grid)
 		if !movedOffGrid {
-			visited[simulatedGuard.pos] = true
+			visited.Add(simulatedGuard.pos)
 		}
 		if movedOffGrid {
 			break
@@ -169,37 +199,33 @@ type State struct {
 	dir Direction
 }
 
+// exited is the absorbing state of a guard that has left the mapped area
+var exited = State{pos: Position{X: -1, Y: -1}, dir: -1}
+
+// step advances a patrol state by one move; leaving the area leads to exited, which maps to itself
+func (grid *Grid) step(state State) State {
+	if state == exited {
+		return exited
+	}
+	guard := &Guard{pos: state.pos, dir: state.dir}
+	if _, movedOffGrid := guard.moveOnGrid(grid); movedOffGrid {
+		return exited
+	}
+	return State{pos: guard.pos, dir: guard.dir}
+}
+
 // simulateWithLoopDetection simulates the guard's patrol and detects if a loop occurs
 // Returns true if a loop is detected, false if guard leaves the area
+//
+// Every patrol is eventually periodic: either the guard walks in circles or it ends up in
+// the exited state forever. Brent's cycle detection tells the two apart in O(1) memory.
 func simulateWithLoopDetection(grid *Grid, guard *Guard) bool {
 	if guard == nil {
 		return false
 	}
 
-	// Create a copy of the guard
-	simulatedGuard := &Guard{
-		pos: guard.pos,
-		dir: guard.dir,
-	}
-
-	// Track states (position + direction)
-	states := make(map[State]bool)
-	currentState := State{pos: simulatedGuard.pos, dir: simulatedGuard.dir}
-	states[currentState] = true
-
-	for {
-		_, movedOffGrid := simulatedGuard.moveOnGrid(grid)
-		if movedOffGrid {
-			return false
-		}
-
-		// Check for loop: if we've seen this (position, direction) state before
-		currentState = State{pos: simulatedGuard.pos, dir: simulatedGuard.dir}
-		if states[currentState] {
-			return true // Loop detected
-		}
-		states[currentState] = true
-	}
+	patrol := cycle.Brent(State{pos: guard.pos, dir: guard.dir}, grid.step)
+	return patrol.Entry != exited
 }
 
 // Part1 counts the number of distinct positions visited by the guard
@@ -228,7 +254,7 @@ func Part2(grid *Grid, guard *Guard) int {
 		}
 
 		// Temporarily add an obstruction
-		grid.obstacles[pos] = true
+		grid.obstacles.Add(pos)
 
 		// Check if this creates a loop
 		if simulateWithLoopDetection(grid, guard) {
@@ -236,8 +262,179 @@ func Part2(grid *Grid, guard *Guard) int {
 		}
 
 		// Remove the obstruction
-		delete(grid.obstacles, pos)
+		grid.obstacles.Remove(pos)
 	}
 
 	return count
 }
+
+// Render draws the lab: obstacles in gray, the guard's patrol in translucent red and the guard in yellow
+// Each cell becomes a scale x scale square of pixels
+func Render(grid *Grid, guard *Guard, scale int) *render.Canvas {
+	canvas := render.Grid(grid.cols, grid.rows, scale, func(x, y int) bool {
+		return grid.hasObstacle(Position{X: x, Y: y})
+	}, render.Palette[bool]{Colors: map[bool]color.Color{
+		true:  color.RGBA{R: 128, G: 128, B: 128, A: 255},
+		false: color.RGBA{R: 24, G: 24, B: 32, A: 255},
+	}})
+
+	if guard != nil {
+		canvas.Highlight(simulatePatrol(grid, guard).Items(), color.NRGBA{R: 220, G: 40, B: 40, A: 160})
+		canvas.Fill(guard.pos, color.RGBA{R: 250, G: 210, B: 40, A: 255})
+	}
+	return canvas
+}
+
+// Animate records the guard's patrol one move at a time, with the cells visited so far highlighted
+// A looping patrol is recorded until the guard is back in a position and direction it had before.
+// It reports whether the recording got to the end of the patrol rather than stopping because rec was done.
+func Animate(grid *Grid, guard *Guard, scale int, rec render.Recorder) bool {
+	if guard == nil {
+		return true
+	}
+
+	state := State{pos: guard.pos, dir: guard.dir}
+	visited := collections.NewSet(state.pos)
+	path := []Position{state.pos}
+
+	draw := func() *render.Canvas {
+		canvas := Render(grid, nil, scale)
+		canvas.Highlight(path, color.NRGBA{R: 220, G: 40, B: 40, A: 160})
+		canvas.Fill(state.pos, color.RGBA{R: 250, G: 210, B: 40, A: 255})
+		return canvas
+	}
+
+	rec.Record(draw)
+	for range grid.patrolMoves(state) {
+		if rec.Done() {
+			return false
+		}
+		if state = grid.step(state); state == exited {
+			return true
+		}
+		if !visited.Contains(state.pos) {
+			visited.Add(state.pos)
+			path = append(path, state.pos)
+		}
+		rec.Record(draw)
+	}
+	return !rec.Done()
+}
+
+// patrolMoves returns how many moves, turns included, a patrol from state makes until the guard leaves
+// the area or is back in a state it had before
+// Every state before the cycle's second pass is distinct, so that is Start + Length moves.
+func (grid *Grid) patrolMoves(state State) int {
+	patrol := cycle.Brent(state, grid.step)
+	return patrol.Start + patrol.Length
+}
+
+// patrolRoute returns the positions of the guard in order, from the start until it leaves the area
+// A guard that walks in circles stops at its first repeated position and direction, which closes the loop.
+// Turning in place does not repeat the position
+func patrolRoute(grid *Grid, guard *Guard) []Position {
+	state := State{pos: guard.pos, dir: guard.dir}
+	route := []Position{state.pos}
+	for range grid.patrolMoves(state) {
+		if state = grid.step(state); state == exited {
+			break
+		}
+		if state.pos != route[len(route)-1] {
+			route = append(route, state.pos)
+		}
+	}
+	return route
+}
+
+// ExportSVG draws the lab with its obstacles, the guard's route as a line and the guard's starting point
+func ExportSVG(w io.Writer, grid *Grid, guard *Guard, cellSize int) error {
+	doc := svg.New(grid.cols, grid.rows, cellSize)
+	doc.Background = "#f4f4f0"
+
+	obstacle := svg.Style{Fill: "#505058"}
+	route := svg.Style{Stroke: "#d23c3c", StrokeWidth: 0.2 * float64(cellSize), Opacity: 0.85}
+	start := svg.Style{Fill: "#1f2a44"}
+
+	doc.Cells(grid.obstacles.SortedFunc(vector.Compare), obstacle)
+	doc.Legend("obstruction", obstacle)
+	if guard != nil {
+		doc.Polyline(patrolRoute(grid, guard), route)
+		doc.Label(guard.pos, string("^>v<"[guard.dir]), start)
+		doc.Legend("guard route", route)
+		doc.Legend("guard start", start)
+	}
+
+	_, err := doc.WriteTo(w)
+	return err
+}
+
+// Palette colors the text frames of Patrol for the terminal player
+var Palette = term.Palette{
+	'#': term.Gray,
+	'X': term.Red,
+	'^': term.Bold + ";" + term.Yellow,
+	'>': term.Bold + ";" + term.Yellow,
+	'v': term.Bold + ";" + term.Yellow,
+	'<': term.Bold + ";" + term.Yellow,
+}
+
+// Patrol is the guard's patrol as a step-by-step simulation for the terminal player
+type Patrol struct {
+	grid    *Grid
+	guard   Guard
+	visited collections.Set[Position]
+	steps   int
+	left    bool
+}
+
+// NewPatrol starts a patrol with the guard at its initial position
+func NewPatrol(grid *Grid, guard *Guard) *Patrol {
+	p := &Patrol{grid: grid, visited: collections.NewSet[Position](), left: guard == nil}
+	if guard != nil {
+		p.guard = *guard
+		p.visited.Add(guard.pos)
+	}
+	return p
+}
+
+// Step moves or turns the guard once and reports false after the guard has left the area
+func (p *Patrol) Step() bool {
+	if p.left {
+		return false
+	}
+	if _, movedOffGrid := p.guard.moveOnGrid(p.grid); movedOffGrid {
+		p.left = true
+		return false
+	}
+	p.steps++
+	p.visited.Add(p.guard.pos)
+	return true
+}
+
+// Frame draws the lab with obstructions as #, visited cells as X and the guard as an arrow
+func (p *Patrol) Frame() []string {
+	lines := make([]string, p.grid.rows)
+	row := make([]byte, p.grid.cols)
+	for y := 0; y < p.grid.rows; y++ {
+		for x := 0; x < p.grid.cols; x++ {
+			pos := Position{X: x, Y: y}
+			switch {
+			case !p.left && pos == p.guard.pos:
+				row[x] = "^>v<"[p.guard.dir]
+			case p.grid.hasObstacle(pos):
+				row[x] = '#'
+			case p.visited.Contains(pos):
+				row[x] = 'X'
+			default:
+				row[x] = '.'
+			}
+		}
+		lines[y] = string(row)
+	}
+	return lines
+}
+
+// Status reports the number of steps taken and cells visited
+func (p *Patrol) Status() string {
+	return fmt.Sprintf("step %d, %d cells visited", p.steps, len(p.visited))
+}
@@ -1,27 +1,61 @@
 package day06
 
-import "testing"
+import (
+	"bytes"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
 
-func TestPart1Example(t *testing.T) {
-	grid, guard := Parse(ExampleInput)
-	result := Part1(grid, guard)
-	expected := 41
-	if result != expected {
-		t.Errorf("Part1(ExampleInput) = %d; want %d", result, expected)
-	}
+	"github.com/amoilanen/advent-of-code-2024/internal/golden"
+	"github.com/amoilanen/advent-of-code-2024/internal/render"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
+)
+
+// loopingLab traps the guard: it walks around the four obstructions forever
+const loopingLab = `.#...
+....#
+.....
+#^...
+...#.`
+
+// lab is the parsed input, as Parse returns the map and the guard separately
+type lab struct {
+	grid  *Grid
+	guard *Guard
 }
 
-func TestPart2Example(t *testing.T) {
-	grid, guard := Parse(ExampleInput)
-	result := Part2(grid, guard)
-	expected := 6
-	if result != expected {
-		t.Errorf("Part2(ExampleInput) = %d; want %d", result, expected)
-	}
+var puzzle = testkit.Puzzle[lab]{
+	Day:   6,
+	Input: DayInput,
+	Parse: func(input string) (lab, error) {
+		grid, guard, err := Parse(input)
+		return lab{grid, guard}, err
+	},
+	Part1: testkit.Int(func(l lab) int { return Part1(l.grid, l.guard) }),
+	Part2: testkit.Int(func(l lab) int { return Part2(l.grid, l.guard) }),
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "41"},
+		{Name: "example", Input: ExampleInput, Part: 2, Want: "6"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "ragged lines", Input: "..^.\n.."},
+		{Name: "unexpected cell", Input: "..X.\n..^."},
+		{Name: "two guards", Input: "^.\n.v"},
+	},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 30, Bytes: 96 << 10, PeakBytes: 128 << 10},
+		Part1: testkit.Budget{Allocs: 60, Bytes: 656 << 10, PeakBytes: 1 << 20},
+		Part2: testkit.Budget{Allocs: 60, Bytes: 656 << 10, PeakBytes: 1 << 20},
+	},
+}
+
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
 }
 
 func TestParse(t *testing.T) {
-	grid, guard := Parse(ExampleInput)
+	grid, guard := MustParse(ExampleInput)
 
 	if grid.rows != 10 {
 		t.Errorf("Expected 10 rows, got %d", grid.rows)
@@ -35,8 +69,8 @@ func TestParse(t *testing.T) {
 		t.Fatal("Expected guard to be found")
 	}
 
-	if guard.pos.Row != 6 || guard.pos.Col != 4 {
-		t.Errorf("Expected guard at (6, 4), got (%d, %d)", guard.pos.Row, guard.pos.Col)
+	if guard.pos.Y != 6 || guard.pos.X != 4 {
+		t.Errorf("Expected guard at (6, 4), got (%d, %d)", guard.pos.Y, guard.pos.X)
 	}
 
 	if guard.dir != Up {
@@ -44,13 +78,13 @@ func TestParse(t *testing.T) {
 	}
 
 	// Check some obstacles
-	if !grid.hasObstacle(Position{Row: 0, Col: 4}) {
+	if !grid.hasObstacle(Position{X: 4, Y: 0}) {
 		t.Error("Expected obstacle at (0, 4)")
 	}
 }
 
 func TestTurnRight(t *testing.T) {
-	guard := &Guard{pos: Position{Row: 0, Col: 0}, dir: Up}
+	guard := &Guard{pos: Position{X: 0, Y: 0}, dir: Up}
 
 	guard.turnRight()
 	if guard.dir != Right {
@@ -80,10 +114,10 @@ func TestNextPosition(t *testing.T) {
 		dir      Direction
 		expected Position
 	}{
-		{"Up", Position{5, 5}, Up, Position{4, 5}},
-		{"Right", Position{5, 5}, Right, Position{5, 6}},
-		{"Down", Position{5, 5}, Down, Position{6, 5}},
-		{"Left", Position{5, 5}, Left, Position{5, 4}},
+		{"Up", Position{X: 5, Y: 5}, Up, Position{X: 5, Y: 4}},
+		{"Right", Position{X: 5, Y: 5}, Right, Position{X: 6, Y: 5}},
+		{"Down", Position{X: 5, Y: 5}, Down, Position{X: 5, Y: 6}},
+		{"Left", Position{X: 5, Y: 5}, Left, Position{X: 4, Y: 5}},
 	}
 
 	for _, tt := range tests {
@@ -96,3 +130,202 @@ func TestNextPosition(t *testing.T) {
 		})
 	}
 }
+
+func TestRender(t *testing.T) {
+	grid, guard := MustParse(ExampleInput)
+	img := Render(grid, guard, 2).Image()
+
+	if size := img.Bounds().Size(); size.X != 20 || size.Y != 20 {
+		t.Fatalf("Render() size = %v; want 20x20", size)
+	}
+
+	obstacle := img.RGBAAt(4*2, 0)  // '#' at column 4, row 0
+	patrol := img.RGBAAt(4*2, 1*2)  // Visited cell straight above the guard
+	empty := img.RGBAAt(0, 0)       // Never visited
+	start := img.RGBAAt(4*2, 6*2+1) // The guard itself
+	if obstacle == empty || patrol == empty || patrol == obstacle || start == patrol {
+		t.Errorf("Render() cells are not told apart: obstacle %v, patrol %v, empty %v, guard %v",
+			obstacle, patrol, empty, start)
+	}
+}
+
+func TestAnimate(t *testing.T) {
+	grid, guard := MustParse(ExampleInput)
+
+	// One frame for the start and one per move until the guard leaves: 44 moves and 10 turns
+	all := render.NewGIFRecorder(render.GIFOptions{})
+	if finished := Animate(grid, guard, 1, all); all.Frames() != 55 || !finished {
+		t.Errorf("Animate() recorded %d frames, finished = %v; want 55 frames and finished", all.Frames(), finished)
+	}
+
+	exact := render.NewGIFRecorder(render.GIFOptions{MaxFrames: 55})
+	if !Animate(grid, guard, 1, exact) {
+		t.Error("Animate() with MaxFrames 55 = false, want true as the guard left on the last frame")
+	}
+
+	limited := render.NewGIFRecorder(render.GIFOptions{Stride: 10, MaxFrames: 3})
+	if finished := Animate(grid, guard, 1, limited); limited.Frames() != 3 || finished {
+		t.Errorf("Animate() with MaxFrames 3 recorded %d frames, finished = %v; want 3 frames, cut short",
+			limited.Frames(), finished)
+	}
+
+	// The looping guard stops once it is back at the start facing up: the start and 12 moves
+	grid, guard = MustParse(loopingLab)
+	looping := render.NewGIFRecorder(render.GIFOptions{})
+	if finished := Animate(grid, guard, 1, looping); looping.Frames() != 13 || !finished {
+		t.Errorf("Animate() of a looping patrol recorded %d frames, finished = %v; want 13 frames and finished",
+			looping.Frames(), finished)
+	}
+}
+
+func TestExportSVG(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		golden string
+	}{
+		{"example", ExampleInput, "testdata/example.svg"},
+		// The guard walks in circles, so the route ends where the loop closes
+		{"looping patrol", loopingLab, "testdata/looping.svg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grid, guard := MustParse(tt.input)
+			var buf bytes.Buffer
+			if err := ExportSVG(&buf, grid, guard, 20); err != nil {
+				t.Fatalf("ExportSVG() error = %v", err)
+			}
+			golden.Compare(t, tt.golden, buf.Bytes())
+		})
+	}
+}
+
+func TestPatrolRouteLoop(t *testing.T) {
+	grid, guard := MustParse(loopingLab)
+	want := []Position{{X: 1, Y: 3}, {X: 1, Y: 2}, {X: 1, Y: 1}, {X: 2, Y: 1}, {X: 3, Y: 1}, {X: 3, Y: 2}, {X: 3, Y: 3},
+		{X: 2, Y: 3}, {X: 1, Y: 3}}
+	if got := patrolRoute(grid, guard); !slices.Equal(got, want) {
+		t.Errorf("patrolRoute() = %v, want %v", got, want)
+	}
+}
+
+func TestPatrol(t *testing.T) {
+	grid, guard := MustParse(ExampleInput)
+	patrol := NewPatrol(grid, guard)
+
+	if got := patrol.Frame()[6]; got != ".#..^....." {
+		t.Errorf("Frame()[6] = %q, want %q", got, ".#..^.....")
+	}
+
+	// 44 moves and 10 turns until the guard leaves
+	steps := 0
+	for patrol.Step() {
+		steps++
+	}
+	if steps != 54 {
+		t.Errorf("Step() succeeded %d times, want 54", steps)
+	}
+	if patrol.Step() {
+		t.Error("Step() = true after the guard has left")
+	}
+
+	visited := 0
+	for _, line := range patrol.Frame() {
+		visited += strings.Count(line, "X")
+	}
+	if visited != 41 {
+		t.Errorf("Frame() shows %d visited cells, want 41", visited)
+	}
+	if got, want := patrol.Status(), "step 54, 41 cells visited"; got != want {
+		t.Errorf("Status() = %q, want %q", got, want)
+	}
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, nil)
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
+
+// referenceLoops puts an obstruction on every free cell in turn, not just on the guard's route,
+// and counts the ones that trap the guard in a loop
+func referenceLoops(rows [][]byte) int {
+	count := 0
+	for _, row := range rows {
+		for x := range row {
+			if row[x] != '.' {
+				continue
+			}
+			row[x] = '#'
+			if walksInLoop(rows) {
+				count++
+			}
+			row[x] = '.'
+		}
+	}
+	return count
+}
+
+// walksInLoop moves the guard cell by cell on the text of the map, remembering every position and
+// direction, until it either leaves the map or comes back to a state it has been in
+func walksInLoop(rows [][]byte) bool {
+	steps := [4][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+	x, y, dir := 0, 0, 0
+	for r, row := range rows {
+		for c, cell := range row {
+			if d := strings.IndexByte("^>v<", cell); d >= 0 {
+				x, y, dir = c, r, d
+			}
+		}
+	}
+
+	seen := make(map[[3]int]bool)
+	for !seen[[3]int{x, y, dir}] {
+		seen[[3]int{x, y, dir}] = true
+		nx, ny := x+steps[dir][0], y+steps[dir][1]
+		if ny < 0 || ny >= len(rows) || nx < 0 || nx >= len(rows[ny]) {
+			return false
+		}
+		if rows[ny][nx] == '#' {
+			dir = (dir + 1) % 4
+		} else {
+			x, y = nx, ny
+		}
+	}
+	return true
+}
+
+// TestPart2MatchesReference compares trying obstructions on the patrol route with trying every cell
+func TestPart2MatchesReference(t *testing.T) {
+	rng := rand.New(rand.NewPCG(6, 2024))
+	for tested := 0; tested < 2000; {
+		rows := make([][]byte, 1+rng.IntN(8))
+		width := 1 + rng.IntN(8)
+		for y := range rows {
+			rows[y] = bytes.Repeat([]byte{'.'}, width)
+			for x := range rows[y] {
+				if rng.IntN(4) == 0 {
+					rows[y][x] = '#'
+				}
+			}
+		}
+		rows[rng.IntN(len(rows))][rng.IntN(width)] = "^>v<"[rng.IntN(4)]
+
+		// Part 2 assumes that the guard leaves the lab when nothing is added
+		if walksInLoop(rows) {
+			continue
+		}
+		tested++
+
+		input := string(bytes.Join(rows, []byte("\n")))
+		grid, guard := MustParse(input)
+		if got, want := Part2(grid, guard), referenceLoops(rows); got != want {
+			t.Fatalf("Part2(%q) = %d, want %d", input, got, want)
+		}
+	}
+}
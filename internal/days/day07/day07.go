@@ -1,8 +1,12 @@
 package day07
 
 import (
+	"fmt"
+	"io"
 	"strconv"
 	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/utils"
 )
 
 const ExampleInput = `190: 10 19
@@ -31,42 +35,84 @@ type Equation struct {
 }
 
 // Parse parses the input into a slice of equations
-func Parse(input string) []Equation {
-	lines := strings.Split(strings.TrimSpace(input), "\n")
-	equations := make([]Equation, 0, len(lines))
+func Parse(input string) ([]Equation, error) {
+	return ParseReader(strings.NewReader(input))
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) []Equation {
+	equations, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return equations
+}
+
+// ParseReader parses all equations from r
+func ParseReader(r io.Reader) ([]Equation, error) {
+	equations := []Equation{}
+	err := EachEquation(r, func(eq Equation) {
+		equations = append(equations, eq)
+	})
+	return equations, err
+}
 
-	for _, line := range lines {
+// EachEquation calls fn with the equations of r one at a time
+// Blank lines are skipped; any other line that is not an equation is an error
+func EachEquation(r io.Reader, fn func(Equation)) error {
+	number := 0
+	return utils.ScanLines(r, func(line string) error {
+		number++
 		if line == "" {
-			continue
+			return nil
 		}
-
-		parts := strings.Split(line, ":")
-		if len(parts) != 2 {
-			continue
+		eq, err := parseEquation(line)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", number, err)
 		}
+		fn(eq)
+		return nil
+	})
+}
 
-		testValue, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+// parseEquation parses a line such as "190: 10 19"
+func parseEquation(line string) (Equation, error) {
+	value, numbers, found := strings.Cut(line, ":")
+	if !found {
+		return Equation{}, fmt.Errorf("want a test value, a colon and numbers, got %q", line)
+	}
+
+	testValue, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return Equation{}, err
+	}
+
+	numStrs := strings.Fields(numbers)
+	if len(numStrs) == 0 {
+		return Equation{}, fmt.Errorf("no numbers in %q", line)
+	}
+	nums := make([]int, 0, len(numStrs))
+	for _, numStr := range numStrs {
+		num, err := strconv.Atoi(numStr)
 		if err != nil {
-			continue
+			return Equation{}, err
 		}
+		nums = append(nums, num)
+	}
 
-		numStrs := strings.Fields(parts[1])
-		numbers := make([]int, 0, len(numStrs))
-		for _, numStr := range numStrs {
-			num, err := strconv.Atoi(numStr)
-			if err != nil {
-				continue
-			}
-			numbers = append(numbers, num)
-		}
+	return Equation{TestValue: testValue, Numbers: nums}, nil
+}
 
-		equations = append(equations, Equation{
-			TestValue: testValue,
-			Numbers:   numbers,
-		})
+// String returns the equation in the format of the puzzle input
+func (eq Equation) String() string {
+	var sb strings.Builder
+	sb.WriteString(strconv.Itoa(eq.TestValue))
+	sb.WriteByte(':')
+	for _, num := range eq.Numbers {
+		sb.WriteByte(' ')
+		sb.WriteString(strconv.Itoa(num))
 	}
-
-	return equations
+	return sb.String()
 }
 
 // evaluate evaluates the numbers with the given operators (left-to-right)
@@ -160,3 +206,17 @@ func Part2(equations []Equation) int {
 	}
 	return sum
 }
+
+// SolveReader sums the test values of both parts while streaming the equations from r
+// Memory use is bounded by the longest equation rather than the size of the input
+func SolveReader(r io.Reader) (part1 int, part2 int, err error) {
+	err = EachEquation(r, func(eq Equation) {
+		if canBeMadeTrue(eq) {
+			part1 += eq.TestValue
+		}
+		if canBeMadeTrueWithConcat(eq) {
+			part2 += eq.TestValue
+		}
+	})
+	return part1, part2, err
+}
@@ -1,18 +1,46 @@
 package day07
 
-import "testing"
-
-func TestPart1Example(t *testing.T) {
-	equations := Parse(ExampleInput)
-	result := Part1(equations)
-	expected := 3749
-	if result != expected {
-		t.Errorf("Part1(ExampleInput) = %d; want %d", result, expected)
-	}
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/heapstat"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
+)
+
+var puzzle = testkit.Puzzle[[]Equation]{
+	Day:   7,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "3749"},
+		{Name: "example", Input: ExampleInput, Part: 2, Want: "11387"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "no colon", Input: "190: 10 19\n190 10 19"},
+		{Name: "two colons", Input: "190: 10: 19"},
+		{Name: "no numbers", Input: "190:"},
+		{Name: "not a number", Input: "190: 10 x"},
+		{Name: "bad test value", Input: "x: 10 19"},
+	},
+	// Both parts allocate an operator slice per combination they try
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 4300, Bytes: 424 << 10, PeakBytes: 576 << 10},
+		Part1: testkit.Budget{Allocs: 225_000, Bytes: 23 << 20, PeakBytes: 8 << 20},
+		Part2: testkit.Budget{Allocs: 40_000_000, Bytes: 1350 << 20, PeakBytes: 8 << 20},
+	},
+}
+
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
 }
 
 func TestParse(t *testing.T) {
-	equations := Parse(ExampleInput)
+	equations := MustParse(ExampleInput)
 
 	expected := []Equation{
 		{TestValue: 190, Numbers: []int{10, 19}},
@@ -144,15 +172,6 @@ func TestConcatenation(t *testing.T) {
 	}
 }
 
-func TestPart2Example(t *testing.T) {
-	equations := Parse(ExampleInput)
-	result := Part2(equations)
-	expected := 11387
-	if result != expected {
-		t.Errorf("Part2(ExampleInput) = %d; want %d", result, expected)
-	}
-}
-
 func TestCanBeMadeTrueWithConcat(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -190,3 +209,63 @@ func TestCanBeMadeTrueWithConcat(t *testing.T) {
 		})
 	}
 }
+
+func TestParseReader(t *testing.T) {
+	got, err := ParseReader(strings.NewReader(ExampleInput))
+	if err != nil {
+		t.Fatalf("ParseReader() error = %v", err)
+	}
+	if want := MustParse(ExampleInput); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseReader() = %v, want %v", got, want)
+	}
+}
+
+func TestSolveReader(t *testing.T) {
+	part1, part2, err := SolveReader(strings.NewReader(ExampleInput))
+	if err != nil {
+		t.Fatalf("SolveReader() error = %v", err)
+	}
+	if part1 != 3749 || part2 != 11387 {
+		t.Errorf("SolveReader() = %d, %d, want 3749, 11387", part1, part2)
+	}
+}
+
+// BenchmarkSolveReader compares the peak heap of parsing a large input from a string and streaming it from a reader
+// Run with -bench BenchmarkSolveReader -benchtime 1x to see the peak-heap-B metric; streaming keeps one equation at a time
+func BenchmarkSolveReader(b *testing.B) {
+	const copies = 20_000 // About 2.5 MB of input
+	block := ExampleInput + "\n"
+
+	b.Run("string", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			data, _ := io.ReadAll(peak.Reader(heapstat.Repeat(block, copies)))
+			equations := MustParse(string(data))
+			peak.Force()
+			Part1(equations)
+			Part2(equations)
+			peak.Report(b)
+		}
+	})
+
+	b.Run("reader", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			if _, _, err := SolveReader(peak.Reader(heapstat.Repeat(block, copies))); err != nil {
+				b.Fatal(err)
+			}
+			peak.Report(b)
+		}
+	})
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic,
+// and that parsing what String writes gives back the same equations
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, puzzle.RoundTrip(testkit.Joined[Equation]("\n")))
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
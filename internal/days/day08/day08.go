@@ -1,9 +1,13 @@
 package day08
 
 import (
-	"strings"
+	"io"
+	"slices"
 
+	"github.com/amoilanen/advent-of-code-2024/internal/collections"
+	"github.com/amoilanen/advent-of-code-2024/internal/svg"
 	"github.com/amoilanen/advent-of-code-2024/internal/utils"
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
 )
 
 const ExampleInput = `............
@@ -19,25 +23,26 @@ const ExampleInput = `............
 ............
 ............`
 
-// Point represents a coordinate on the grid
-type Point struct {
-	Row int
-	Col int
-}
+// Point represents a coordinate on the grid (X is the column, Y is the row)
+type Point = vector.Vec2
 
 // Grid represents the antenna map
 type Grid struct {
 	Width    int
 	Height   int
-	Antennas map[rune][]Point // Map from frequency to antenna positions
+	Antennas collections.MultiMap[rune, Point] // Map from frequency to antenna positions
 }
 
 // Parse parses the input into a Grid
-func Parse(input string) Grid {
-	lines := strings.Split(strings.TrimSpace(input), "\n")
+// The lines have to form a rectangle, which is what Width and Height describe
+func Parse(input string) (Grid, error) {
+	lines, err := utils.AsGrid(input)
+	if err != nil {
+		return Grid{}, err
+	}
 	grid := Grid{
 		Height:   len(lines),
-		Antennas: make(map[rune][]Point),
+		Antennas: make(collections.MultiMap[rune, Point]),
 	}
 
 	if len(lines) > 0 {
@@ -47,17 +52,26 @@ func Parse(input string) Grid {
 	for row, line := range lines {
 		for col, ch := range line {
 			if ch != '.' {
-				grid.Antennas[ch] = append(grid.Antennas[ch], Point{Row: row, Col: col})
+				grid.Antennas.Put(ch, Point{X: col, Y: row})
 			}
 		}
 	}
 
+	return grid, nil
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) Grid {
+	grid, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
 	return grid
 }
 
 // isInBounds checks if a point is within the grid bounds
 func (g Grid) isInBounds(p Point) bool {
-	return p.Row >= 0 && p.Row < g.Height && p.Col >= 0 && p.Col < g.Width
+	return p.InBounds(g.Width, g.Height)
 }
 
 // findAntinodes finds all antinodes for a given pair of antennas
@@ -68,17 +82,17 @@ func findAntinodes(a1, a2 Point) []Point {
 	// 1. Beyond a2: a2 + (a2 - a1) = 2*a2 - a1
 	// 2. Beyond a1: a1 - (a2 - a1) = 2*a1 - a2
 	return []Point{
-		{Row: 2*a2.Row - a1.Row, Col: 2*a2.Col - a1.Col},
-		{Row: 2*a1.Row - a2.Row, Col: 2*a1.Col - a2.Col},
+		a2.Scale(2).Sub(a1),
+		a1.Scale(2).Sub(a2),
 	}
 }
 
-// Part1 calculates the number of unique antinode locations
-func Part1(grid Grid) int {
-	antinodes := make(map[Point]bool)
+// antinodes collects the unique antinode locations of part 1 inside the grid
+func (g Grid) antinodes() collections.Set[Point] {
+	antinodes := collections.NewSet[Point]()
 
 	// For each frequency
-	for _, positions := range grid.Antennas {
+	for _, positions := range g.Antennas {
 		// Check all pairs of antennas with the same frequency
 		for i := 0; i < len(positions); i++ {
 			for j := i + 1; j < len(positions); j++ {
@@ -86,27 +100,30 @@ func Part1(grid Grid) int {
 				nodes := findAntinodes(positions[i], positions[j])
 				for _, node := range nodes {
 					// Only count antinodes within the grid bounds
-					if grid.isInBounds(node) {
-						antinodes[node] = true
+					if g.isInBounds(node) {
+						antinodes.Add(node)
 					}
 				}
 			}
 		}
 	}
 
-	return len(antinodes)
+	return antinodes
+}
+
+// Part1 calculates the number of unique antinode locations
+func Part1(grid Grid) int {
+	return len(grid.antinodes())
 }
 
 // findAllAntinodesOnLine finds all antinodes on the line through two antennas
 // In part 2, any point in line with at least two antennas is an antinode
 func (g Grid) findAllAntinodesOnLine(a1, a2 Point) []Point {
-	dr := a2.Row - a1.Row
-	dc := a2.Col - a1.Col
+	delta := a2.Sub(a1)
 
 	// Find GCD to get the smallest step
-	gcdVal := utils.GCD(utils.Abs(dr), utils.Abs(dc))
-	stepRow := dr / gcdVal
-	stepCol := dc / gcdVal
+	gcdVal := utils.GCD(utils.Abs(delta.X), utils.Abs(delta.Y))
+	step := Point{X: delta.X / gcdVal, Y: delta.Y / gcdVal}
 
 	antinodes := []Point{}
 
@@ -114,37 +131,71 @@ func (g Grid) findAllAntinodesOnLine(a1, a2 Point) []Point {
 	current := a1
 	for g.isInBounds(current) {
 		antinodes = append(antinodes, current)
-		current = Point{Row: current.Row + stepRow, Col: current.Col + stepCol}
+		current = current.Add(step)
 	}
 
 	// Walk backward from a1 (excluding a1 since it's already included)
-	current = Point{Row: a1.Row - stepRow, Col: a1.Col - stepCol}
+	current = a1.Sub(step)
 	for g.isInBounds(current) {
 		antinodes = append(antinodes, current)
-		current = Point{Row: current.Row - stepRow, Col: current.Col - stepCol}
+		current = current.Sub(step)
 	}
 
 	return antinodes
 }
 
-// Part2 calculates the number of unique antinode locations using the updated model
-// where any point in line with at least two antennas of the same frequency is an antinode
-func Part2(grid Grid) int {
-	antinodes := make(map[Point]bool)
+// resonantAntinodes collects the unique antinode locations of part 2, including resonant harmonics
+func (g Grid) resonantAntinodes() collections.Set[Point] {
+	antinodes := collections.NewSet[Point]()
 
 	// For each frequency
-	for _, positions := range grid.Antennas {
+	for _, positions := range g.Antennas {
 		// Check all pairs of antennas with the same frequency
 		for i := 0; i < len(positions); i++ {
 			for j := i + 1; j < len(positions); j++ {
 				// Find all antinodes on the line through this pair
-				nodes := grid.findAllAntinodesOnLine(positions[i], positions[j])
-				for _, node := range nodes {
-					antinodes[node] = true
-				}
+				nodes := g.findAllAntinodesOnLine(positions[i], positions[j])
+				antinodes.Add(nodes...)
 			}
 		}
 	}
 
-	return len(antinodes)
+	return antinodes
+}
+
+// Part2 calculates the number of unique antinode locations using the updated model
+// where any point in line with at least two antennas of the same frequency is an antinode
+func Part2(grid Grid) int {
+	return len(grid.resonantAntinodes())
+}
+
+// ExportSVG draws the antenna map: resonant harmonics of part 2 as pale cells, antinodes of part 1
+// as red dots and every antenna labeled with its frequency
+func ExportSVG(w io.Writer, grid Grid, cellSize int) error {
+	doc := svg.New(grid.Width, grid.Height, cellSize)
+	doc.Background = "#f8f8f4"
+
+	harmonic := svg.Style{Fill: "#c9dcf2"}
+	antinode := svg.Style{Fill: "#d23c3c"}
+	antenna := svg.Style{Fill: "#1f2a44"}
+
+	doc.Cells(grid.resonantAntinodes().SortedFunc(vector.Compare), harmonic)
+	for _, node := range grid.antinodes().SortedFunc(vector.Compare) {
+		doc.Circle(node, 0.3, antinode)
+	}
+
+	frequencies := grid.Antennas.Keys()
+	slices.Sort(frequencies)
+	for _, frequency := range frequencies {
+		for _, position := range grid.Antennas.Get(frequency) {
+			doc.Label(position, string(frequency), antenna)
+		}
+	}
+
+	doc.Legend("antenna (frequency)", antenna)
+	doc.Legend("antinode", antinode)
+	doc.Legend("resonant harmonic", harmonic)
+
+	_, err := doc.WriteTo(w)
+	return err
 }
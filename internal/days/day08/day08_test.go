@@ -1,25 +1,52 @@
 package day08
 
 import (
+	"bytes"
 	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/golden"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
 )
 
-func TestPart1(t *testing.T) {
-	grid := Parse(ExampleInput)
-	result := Part1(grid)
-	expected := 14
+var puzzle = testkit.Puzzle[Grid]{
+	Day:   8,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "14"},
+		{Name: "example", Input: ExampleInput, Part: 2, Want: "34"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "ragged lines", Input: "..a.\n.."},
+		{Name: "non-ASCII frequency", Input: "..ä.\n...."},
+	},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 180, Bytes: 24 << 10, PeakBytes: 32 << 10},
+		Part1: testkit.Budget{Allocs: 20, Bytes: 48 << 10, PeakBytes: 64 << 10},
+		Part2: testkit.Budget{Allocs: 580, Bytes: 256 << 10, PeakBytes: 384 << 10},
+	},
+}
+
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
+}
 
-	if result != expected {
-		t.Errorf("Part1() = %d; want %d", result, expected)
+func TestExportSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportSVG(&buf, MustParse(ExampleInput), 20); err != nil {
+		t.Fatalf("ExportSVG() error = %v", err)
 	}
+	golden.Compare(t, "testdata/example.svg", buf.Bytes())
 }
 
-func TestPart2(t *testing.T) {
-	grid := Parse(ExampleInput)
-	result := Part2(grid)
-	expected := 34
+// FuzzParse checks that malformed input makes Parse return an error rather than panic
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, nil)
+}
 
-	if result != expected {
-		t.Errorf("Part2() = %d; want %d", result, expected)
-	}
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
 }
@@ -1,7 +1,10 @@
 package day09
 
 import (
+	"fmt"
 	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/interval"
 )
 
 const ExampleInput = `2333133121414131402`
@@ -14,12 +17,15 @@ type DiskMap struct {
 // Parse converts the compact disk map string into an expanded block representation
 // The input alternates between file lengths and free space lengths
 // Example: "12345" -> file(1 block, ID=0), free(2), file(3, ID=1), free(4), file(5, ID=2)
-func Parse(input string) DiskMap {
+func Parse(input string) (DiskMap, error) {
 	input = strings.TrimSpace(input)
 	blocks := []int{}
 	fileID := 0
 
 	for i, ch := range input {
+		if ch < '0' || ch > '9' {
+			return DiskMap{}, fmt.Errorf("position %d: %q is not a length", i+1, ch)
+		}
 		length := int(ch - '0')
 
 		if i%2 == 0 {
@@ -36,7 +42,38 @@ func Parse(input string) DiskMap {
 		}
 	}
 
-	return DiskMap{Blocks: blocks}
+	return DiskMap{Blocks: blocks}, nil
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) DiskMap {
+	diskMap, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return diskMap
+}
+
+// String returns the compact disk map that Parse expands into dm
+// Files missing from the block IDs had length zero; free space longer than nine blocks
+// is split around such empty files, which expands to the same blocks.
+func (dm DiskMap) String() string {
+	var sb strings.Builder
+	for pos, fileID := 0, 0; pos < len(dm.Blocks); fileID++ {
+		length := 0
+		for pos < len(dm.Blocks) && dm.Blocks[pos] == fileID {
+			pos++
+			length++
+		}
+		free := 0
+		for pos < len(dm.Blocks) && dm.Blocks[pos] == -1 && free < 9 {
+			pos++
+			free++
+		}
+		sb.WriteByte(byte('0' + length))
+		sb.WriteByte(byte('0' + free))
+	}
+	return sb.String()
 }
 
 // Compact performs disk compaction by moving file blocks from the end
@@ -103,54 +140,27 @@ func Part1(diskMap DiskMap) int {
 	return dm.Checksum()
 }
 
-// findFile locates a file by ID and returns its start position and length
-// Returns (-1, 0) if file not found
-func (dm DiskMap) findFile(fileID int) (startPos, length int) {
-	startPos = -1
-	length = 0
+// layout scans the blocks once and returns the span of every file, indexed by file ID,
+// together with the set of free spans
+func (dm DiskMap) layout() ([]interval.Interval, *interval.Set) {
+	files := []interval.Interval{}
+	free := interval.NewSet()
 
-	for i, id := range dm.Blocks {
-		if id == fileID {
-			if startPos == -1 {
-				startPos = i
-			}
-			length++
-		} else if startPos != -1 {
-			// File blocks are contiguous, so we're done
-			break
-		}
-	}
-
-	return startPos, length
-}
-
-// findFreeSpan finds the leftmost free space span that can fit targetLength blocks
-// Only searches up to maxPos (exclusive)
-// Returns the start position of the free span, or -1 if not found
-func (dm DiskMap) findFreeSpan(targetLength, maxPos int) int {
-	i := 0
-	for i < maxPos {
-		// Skip non-free blocks
-		if dm.Blocks[i] != -1 {
-			i++
+	for pos, id := range dm.Blocks {
+		if id == -1 {
+			free.Insert(interval.New(pos, 1))
 			continue
 		}
-
-		// Found start of free space, count consecutive free blocks
-		spanStart := i
-		spanLength := 0
-		for i < maxPos && dm.Blocks[i] == -1 {
-			spanLength++
-			i++
+		for len(files) <= id {
+			files = append(files, interval.Interval{Start: -1, End: -1})
 		}
-
-		// Check if this span is large enough
-		if spanLength >= targetLength {
-			return spanStart
+		if files[id].Start == -1 {
+			files[id].Start = pos
 		}
+		files[id].End = pos + 1
 	}
 
-	return -1 // No suitable span found
+	return files, free
 }
 
 // moveFile moves a file from one position to another
@@ -172,40 +182,35 @@ func (dm *DiskMap) moveFile(fileID, fromPos, toPos, length int) {
 // Each file is moved at most once to the leftmost suitable free space
 //
 // Algorithm:
-//  1. Find maximum file ID
+//  1. Locate every file and collect the free spans into an interval set
 //  2. For each file from max ID down to 0:
-//     a. Locate the file (start position and length)
-//     b. Find leftmost free span that can fit the file (must be to the left)
-//     c. If found, move the entire file
-//     d. If not found, file stays in place
+//     a. Find the leftmost free span that can fit the file
+//     b. If it lies to the left of the file, move the entire file there
+//     c. Otherwise the file stays in place
+//  3. Moving a file takes its blocks out of the free set and returns the old ones
 //
-// Time complexity: O(n² × m) worst case, where n = number of files, m = total blocks
+// Time complexity: O(m + n log n) where n = number of files, m = total blocks
 func (dm *DiskMap) CompactWholeFiles() {
-	// Find maximum file ID
-	maxFileID := 0
-	for _, id := range dm.Blocks {
-		if id > maxFileID {
-			maxFileID = id
-		}
-	}
+	files, free := dm.layout()
 
 	// Process files in decreasing ID order
-	for fileID := maxFileID; fileID >= 0; fileID-- {
-		// Find where this file currently is
-		startPos, length := dm.findFile(fileID)
-		if startPos == -1 || length == 0 {
+	for fileID := len(files) - 1; fileID >= 0; fileID-- {
+		file := files[fileID]
+		if file.Empty() {
 			continue // File not found or empty
 		}
 
 		// Find leftmost free span that can fit this file
-		// Only search to the left of the file's current position
-		freePos := dm.findFreeSpan(length, startPos)
-		if freePos == -1 {
+		// Only spans to the left of the file's current position are eligible
+		span, ok := free.FirstFit(file.Len())
+		if !ok || span.Start >= file.Start {
 			continue // No suitable free space found
 		}
 
 		// Move the file to the free space
-		dm.moveFile(fileID, startPos, freePos, length)
+		dm.moveFile(fileID, file.Start, span.Start, file.Len())
+		free.Remove(interval.New(span.Start, file.Len()))
+		free.Insert(file)
 	}
 }
 
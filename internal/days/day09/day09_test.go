@@ -1,26 +1,67 @@
 package day09
 
 import (
+	"fmt"
+	"maps"
+	"strings"
 	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/prop"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
 )
 
+var puzzle = testkit.Puzzle[DiskMap]{
+	Day:   9,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "1928"},
+		{Name: "example", Input: ExampleInput, Part: 2, Want: "2858"},
+		// 0..111....22222: neither file 1 nor file 2 finds space to its left
+		// 0*0 + 3*1 + 4*1 + 5*1 + 10*2 + 11*2 + 12*2 + 13*2 + 14*2 = 132
+		{Name: "small example", Input: "12345", Part: 2, Want: "132"},
+		// 0...1... becomes 01......: 0*0 + 1*1 = 1
+		{Name: "file can move", Input: "1313", Part: 2, Want: "1"},
+		// 0...1..2... becomes 021........: 0*0 + 1*2 + 2*1 = 4
+		{Name: "multiple files move left", Input: "131213", Part: 2, Want: "4"},
+		// 012 has no free space, so nothing moves: 0*0 + 1*1 + 2*2 = 5
+		{Name: "no fragmentation", Input: "101010", Part: 2, Want: "5"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "letter", Input: "12a45"},
+		{Name: "negative length", Input: "1-2"},
+	},
+	Seeds: []string{"0"},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 35, Bytes: 6 << 20, PeakBytes: 4 << 20},
+		Part1: testkit.Budget{Allocs: 2, Bytes: 1200 << 10, PeakBytes: 2 << 20},
+		Part2: testkit.Budget{Allocs: 63_500, Bytes: 7 << 20, PeakBytes: 8 << 20},
+	},
+}
+
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
+}
+
 func TestParse(t *testing.T) {
-	diskMap := Parse("12345")
+	diskMap := MustParse("12345")
 	expected := []int{0, -1, -1, 1, 1, 1, -1, -1, -1, -1, 2, 2, 2, 2, 2}
 
 	if len(diskMap.Blocks) != len(expected) {
-		t.Fatalf("Parse() length = %d; want %d", len(diskMap.Blocks), len(expected))
+		t.Fatalf("MustParse() length = %d; want %d", len(diskMap.Blocks), len(expected))
 	}
 
 	for i, val := range diskMap.Blocks {
 		if val != expected[i] {
-			t.Errorf("Parse() block[%d] = %d; want %d", i, val, expected[i])
+			t.Errorf("MustParse() block[%d] = %d; want %d", i, val, expected[i])
 		}
 	}
 }
 
 func TestCompact(t *testing.T) {
-	diskMap := Parse("12345")
+	diskMap := MustParse("12345")
 	diskMap.Compact()
 
 	// After compaction: 022111222
@@ -37,18 +78,8 @@ func TestCompact(t *testing.T) {
 	}
 }
 
-func TestPart1(t *testing.T) {
-	diskMap := Parse(ExampleInput)
-	result := Part1(diskMap)
-	expected := 1928
-
-	if result != expected {
-		t.Errorf("Part1() = %d; want %d", result, expected)
-	}
-}
-
 func TestCompactWholeFiles(t *testing.T) {
-	diskMap := Parse("12345")
+	diskMap := MustParse("12345")
 	diskMap.CompactWholeFiles()
 
 	// After whole-file compaction: 0..111....22222
@@ -69,70 +100,68 @@ func TestCompactWholeFiles(t *testing.T) {
 	}
 }
 
-func TestPart2(t *testing.T) {
-	diskMap := Parse(ExampleInput)
-	result := Part2(diskMap)
-	expected := 2858
+// FuzzParse checks that malformed input makes Parse return an error rather than panic,
+// and that parsing what String writes gives back the same blocks
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, puzzle.RoundTrip(DiskMap.String))
+}
 
-	if result != expected {
-		t.Errorf("Part2() = %d; want %d", result, expected)
-	}
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
 }
 
-func TestPart2_SmallExample(t *testing.T) {
-	// "12345" -> 0..111....22222
-	// After whole-file compaction, file 1 can't move (no space of size 3 to the left)
-	// File 2 can't move (no space of size 5 to the left)
-	// Final: 0..111....22222
-	diskMap := Parse("12345")
-	result := Part2(diskMap)
-	// Checksum: 0*0 + 3*1 + 4*1 + 5*1 + 10*2 + 11*2 + 12*2 + 13*2 + 14*2 = 0 + 3 + 4 + 5 + 20 + 22 + 24 + 26 + 28 = 132
-	expected := 132
-
-	if result != expected {
-		t.Errorf("Part2('12345') = %d; want %d", result, expected)
+// digitString writes the digits of a disk map as its input
+func digitString(digits []int) string {
+	var sb strings.Builder
+	for _, digit := range digits {
+		sb.WriteByte(byte('0' + digit))
 	}
+	return sb.String()
 }
 
-func TestPart2_FileCanMove(t *testing.T) {
-	// "1313" -> 0...1...
-	// File 1 (size 1) can move to position 1
-	// Final: 01......
-	diskMap := Parse("1313")
-	result := Part2(diskMap)
-	// Checksum: 0*0 + 1*1 = 1
-	expected := 1
-
-	if result != expected {
-		t.Errorf("Part2('1313') = %d; want %d", result, expected)
+// diskMaps generates the digits of disk maps, which shrink by dropping digits and lowering them
+func diskMaps() prop.Gen[[]int] {
+	gen := prop.SliceOf(prop.Int(0, 9))
+	gen.Format = func(digits []int) string {
+		return fmt.Sprintf("%q", digitString(digits))
 	}
+	return gen
 }
 
-func TestPart2_MultipleFilesMoveLeft(t *testing.T) {
-	// "131213" -> 0...1..2...
-	// File 2 (size 1) can move to position 1
-	// File 1 (size 1) can move to position 2
-	// Final: 021........
-	diskMap := Parse("131213")
-	result := Part2(diskMap)
-	// After compaction: 021........
-	// Checksum: 0*0 + 1*2 + 2*1 = 0 + 2 + 2 = 4
-	expected := 4
-
-	if result != expected {
-		t.Errorf("Part2('131213') = %d; want %d", result, expected)
+// fileBlocks counts the blocks of every file
+func fileBlocks(blocks []int) map[int]int {
+	counts := make(map[int]int)
+	for _, id := range blocks {
+		if id != -1 {
+			counts[id]++
+		}
 	}
+	return counts
 }
 
-func TestPart2_NoFragmentation(t *testing.T) {
-	// "101010" -> 012 (no free space)
-	// Files are packed together, no moves possible
-	diskMap := Parse("101010")
-	result := Part2(diskMap)
-	// Checksum: 0*0 + 1*1 + 2*2 = 0 + 1 + 4 = 5
-	expected := 5
+// preservesFiles returns a property that compacting a disk map keeps the size of the disk and every file block
+func preservesFiles(compact func(*DiskMap)) func([]int) error {
+	return func(digits []int) error {
+		diskMap := MustParse(digitString(digits))
+		before := fileBlocks(diskMap.Blocks)
+		size := len(diskMap.Blocks)
 
-	if result != expected {
-		t.Errorf("Part2('101010') = %d; want %d", result, expected)
+		compact(&diskMap)
+		if len(diskMap.Blocks) != size {
+			return fmt.Errorf("disk has %d blocks after compacting, want %d", len(diskMap.Blocks), size)
+		}
+		if after := fileBlocks(diskMap.Blocks); !maps.Equal(after, before) {
+			return fmt.Errorf("file blocks %v after compacting, want %v", after, before)
+		}
+		return nil
 	}
 }
+
+func TestCompactPreservesFiles(t *testing.T) {
+	prop.Check(t, diskMaps(), preservesFiles((*DiskMap).Compact))
+}
+
+func TestCompactWholeFilesPreservesFiles(t *testing.T) {
+	prop.Check(t, diskMaps(), preservesFiles((*DiskMap).CompactWholeFiles))
+}
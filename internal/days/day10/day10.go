@@ -1,7 +1,19 @@
 package day10
 
 import (
+	"fmt"
+	"image/color"
+	"io"
+	"slices"
+	"strconv"
 	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/graph"
+	"github.com/amoilanen/advent-of-code-2024/internal/memo"
+	"github.com/amoilanen/advent-of-code-2024/internal/render"
+	"github.com/amoilanen/advent-of-code-2024/internal/svg"
+	"github.com/amoilanen/advent-of-code-2024/internal/utils"
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
 )
 
 const ExampleInput = `89010123
@@ -13,10 +25,8 @@ const ExampleInput = `89010123
 01329801
 10456732`
 
-// Position represents a coordinate on the topographic map
-type Position struct {
-	Row, Col int
-}
+// Position represents a coordinate on the topographic map (X is the column, Y is the row)
+type Position = vector.Vec2
 
 // TopoMap represents the topographic map with heights 0-9
 type TopoMap struct {
@@ -26,20 +36,22 @@ type TopoMap struct {
 }
 
 // Parse converts the input string into a TopoMap
-// Each character represents a height from 0-9
-func Parse(input string) TopoMap {
-	lines := strings.Split(strings.TrimSpace(input), "\n")
-	rows := len(lines)
-	if rows == 0 {
-		return TopoMap{}
+// Each character represents a height from 0-9; '.' marks impassable ground, which no trail can step on
+func Parse(input string) (TopoMap, error) {
+	lines, err := utils.AsGrid(input)
+	if err != nil {
+		return TopoMap{}, err
 	}
-
+	rows := len(lines)
 	cols := len(lines[0])
 	grid := make([][]int, rows)
 
 	for i, line := range lines {
 		grid[i] = make([]int, cols)
 		for j, ch := range line {
+			if ch != '.' && (ch < '0' || ch > '9') {
+				return TopoMap{}, fmt.Errorf("line %d, column %d: %q is not a height", i+1, j+1, ch)
+			}
 			grid[i][j] = int(ch - '0')
 		}
 	}
@@ -48,7 +60,34 @@ func Parse(input string) TopoMap {
 		Grid: grid,
 		Rows: rows,
 		Cols: cols,
+	}, nil
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) TopoMap {
+	topoMap, err := Parse(input)
+	if err != nil {
+		panic(err)
 	}
+	return topoMap
+}
+
+// String returns the map in the format of the puzzle input
+func (tm TopoMap) String() string {
+	lines := make([]string, tm.Rows)
+	for i, row := range tm.Grid {
+		line := make([]byte, len(row))
+		for j, height := range row {
+			line[j] = byte('0' + height)
+		}
+		lines[i] = string(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// at returns the height at the given position
+func (tm TopoMap) at(pos Position) int {
+	return tm.Grid[pos.Y][pos.X]
 }
 
 // FindTrailheads returns all positions with height 0
@@ -58,7 +97,7 @@ func (tm TopoMap) FindTrailheads() []Position {
 	for r := 0; r < tm.Rows; r++ {
 		for c := 0; c < tm.Cols; c++ {
 			if tm.Grid[r][c] == 0 {
-				trailheads = append(trailheads, Position{r, c})
+				trailheads = append(trailheads, Position{X: c, Y: r})
 			}
 		}
 	}
@@ -66,14 +105,12 @@ func (tm TopoMap) FindTrailheads() []Position {
 	return trailheads
 }
 
+// GetNeighbors returns the orthogonally adjacent positions inside the map
 func (tm TopoMap) GetNeighbors(pos Position) []Position {
-	directions := []Position{{-1, 0}, {1, 0}, {0, -1}, {0, 1}}
 	neighbors := []Position{}
-	for _, dir := range directions {
-		newRow := pos.Row + dir.Row
-		newCol := pos.Col + dir.Col
-		if newRow >= 0 && newRow < tm.Rows && newCol >= 0 && newCol < tm.Cols {
-			neighbors = append(neighbors, Position{newRow, newCol})
+	for _, neighbor := range pos.Neighbors4() {
+		if neighbor.InBounds(tm.Cols, tm.Rows) {
+			neighbors = append(neighbors, neighbor)
 		}
 	}
 	return neighbors
@@ -85,7 +122,7 @@ func (tm TopoMap) GetTrailContinuations(pos Position, currentHeight int) []Posit
 	continuations := []Position{}
 	neighbors := tm.GetNeighbors(pos)
 	for _, neighbor := range neighbors {
-		if tm.Grid[neighbor.Row][neighbor.Col] == currentHeight+1 {
+		if tm.at(neighbor) == currentHeight+1 {
 			continuations = append(continuations, neighbor)
 		}
 	}
@@ -96,38 +133,19 @@ func (tm TopoMap) GetTrailContinuations(pos Position, currentHeight int) []Posit
 // Score = number of distinct height-9 positions reachable via valid hiking trails
 // Uses BFS to explore all reachable positions
 func (tm TopoMap) ScoreTrailhead(start Position) int {
-	// Set to track unique height-9 positions reached
-	reachedNines := make(map[Position]bool)
-
-	// BFS queue - stores positions to explore
-	queue := []Position{start}
-
-	// Track visited positions to avoid cycles
-	visited := make(map[Position]bool)
-	visited[start] = true
-
-	for len(queue) > 0 {
-		current := queue[0]
-		queue = queue[1:]
-
-		currentHeight := tm.Grid[current.Row][current.Col]
-
-		// If we reached height 9, record it
-		if currentHeight == 9 {
-			reachedNines[current] = true
-			continue
-		}
+	continuations := func(pos Position) []Position {
+		return tm.GetTrailContinuations(pos, tm.at(pos))
+	}
 
-		// Explore neighbors with height = currentHeight + 1
-		for _, neighbor := range tm.GetTrailContinuations(current, currentHeight) {
-			if !visited[neighbor] {
-				visited[neighbor] = true
-				queue = append(queue, neighbor)
-			}
+	// Count unique height-9 positions reached
+	reachedNines := 0
+	for _, pos := range graph.BFS(start, continuations).Order {
+		if tm.at(pos) == 9 {
+			reachedNines++
 		}
 	}
 
-	return len(reachedNines)
+	return reachedNines
 }
 
 // Part1 calculates the sum of scores for all trailheads
@@ -150,37 +168,38 @@ func Part1(topoMap TopoMap) int {
 	return totalScore
 }
 
-// CountPaths calculates the number of distinct hiking trails from a position
-// to any height-9 position using dynamic programming with memoization
-// Returns: number of distinct paths from pos to any height-9 position
-func (tm TopoMap) CountPaths(pos Position, memo map[Position]int) int {
-	// Check if already computed
-	if count, exists := memo[pos]; exists {
-		return count
-	}
-
-	currentHeight := tm.Grid[pos.Row][pos.Col]
+// newPathCounter creates a memoized counter of distinct hiking trails from a position
+// to any height-9 position
+// Each position is computed at most once per counter, so it can be shared across trailheads
+func (tm TopoMap) newPathCounter() *memo.Memo[Position, int] {
+	return memo.New(func(countPaths func(Position) int, pos Position) int {
+		currentHeight := tm.at(pos)
 
-	// Base case: reached height 9 - this is one complete path
-	if currentHeight == 9 {
-		memo[pos] = 1
-		return 1
-	}
+		// Base case: reached height 9 - this is one complete path
+		if currentHeight == 9 {
+			return 1
+		}
 
-	// Recursive case: sum paths from all valid continuations
-	totalPaths := 0
-	for _, neighbor := range tm.GetTrailContinuations(pos, currentHeight) {
-		totalPaths += tm.CountPaths(neighbor, memo)
-	}
+		// Recursive case: sum paths from all valid continuations
+		totalPaths := 0
+		for _, neighbor := range tm.GetTrailContinuations(pos, currentHeight) {
+			totalPaths += countPaths(neighbor)
+		}
+		return totalPaths
+	})
+}
 
-	memo[pos] = totalPaths
-	return totalPaths
+// CountPaths calculates the number of distinct hiking trails from a position
+// to any height-9 position using dynamic programming with memoization
+// Returns: number of distinct paths from pos to any height-9 position
+func (tm TopoMap) CountPaths(pos Position) int {
+	return tm.newPathCounter().Get(pos)
 }
 
 // RateTrailhead calculates the rating for a single trailhead
 // Rating = number of distinct hiking trails that begin at this trailhead
-func (tm TopoMap) RateTrailhead(start Position, memo map[Position]int) int {
-	return tm.CountPaths(start, memo)
+func (tm TopoMap) RateTrailhead(start Position) int {
+	return tm.CountPaths(start)
 }
 
 // Part2 calculates the sum of ratings for all trailheads
@@ -190,18 +209,73 @@ func (tm TopoMap) RateTrailhead(start Position, memo map[Position]int) int {
 // 3. Sum the ratings
 //
 // Time complexity: O(R × C) - each position computed at most once via memoization
-// Space complexity: O(R × C) for memoization map
+// Space complexity: O(R × C) for memoized path counts
 func Part2(topoMap TopoMap) int {
 	trailheads := topoMap.FindTrailheads()
 	totalRating := 0
 
-	// Shared memoization map across all trailheads for efficiency
-	memo := make(map[Position]int)
+	// Shared memoized counter across all trailheads for efficiency
+	pathCounter := topoMap.newPathCounter()
 
 	for _, trailhead := range trailheads {
-		rating := topoMap.RateTrailhead(trailhead, memo)
+		rating := pathCounter.Get(trailhead)
 		totalRating += rating
 	}
 
 	return totalRating
 }
+
+// Trails returns every distinct hiking trail from start to a height-9 position
+// Each trail lists its positions from start to summit; the number of trails is the rating of start
+func (tm TopoMap) Trails(start Position) [][]Position {
+	trails := [][]Position{}
+
+	var walk func(trail []Position)
+	walk = func(trail []Position) {
+		pos := trail[len(trail)-1]
+		if tm.at(pos) == 9 {
+			trails = append(trails, slices.Clone(trail))
+			return
+		}
+		for _, next := range tm.GetTrailContinuations(pos, tm.at(pos)) {
+			walk(append(trail, next))
+		}
+	}
+	walk([]Position{start})
+
+	return trails
+}
+
+// ExportSVG draws the map with darker cells for higher ground and every hiking trail as a line,
+// colored by the trailhead it starts from
+func ExportSVG(w io.Writer, topoMap TopoMap, cellSize int) error {
+	doc := svg.New(topoMap.Cols, topoMap.Rows, cellSize)
+
+	for r := 0; r < topoMap.Rows; r++ {
+		for c := 0; c < topoMap.Cols; c++ {
+			shade := uint8(245 - 18*topoMap.Grid[r][c])
+			doc.Cell(Position{X: c, Y: r}, svg.Style{Fill: svg.Hex(color.Gray{Y: shade})})
+		}
+	}
+
+	for i, trailhead := range topoMap.FindTrailheads() {
+		trail := svg.Style{Stroke: svg.Hex(render.Distinct(i)), StrokeWidth: 0.15 * float64(cellSize), Opacity: 0.8}
+		for _, path := range topoMap.Trails(trailhead) {
+			doc.Polyline(path, trail)
+		}
+	}
+
+	for r := 0; r < topoMap.Rows; r++ {
+		for c := 0; c < topoMap.Cols; c++ {
+			if height := topoMap.Grid[r][c]; height == 0 || height == 9 {
+				doc.Label(Position{X: c, Y: r}, strconv.Itoa(height), svg.Style{Fill: "#000000"})
+			}
+		}
+	}
+
+	doc.Legend("trailhead (0) and summit (9)", svg.Style{Fill: "#000000"})
+	doc.Legend("hiking trail, one color per trailhead", svg.Style{Stroke: svg.Hex(render.Distinct(0))})
+
+	_, err := doc.WriteTo(w)
+	return err
+}
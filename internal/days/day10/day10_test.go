@@ -1,21 +1,76 @@
 package day10
 
 import (
+	"bytes"
 	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/golden"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
 )
 
-func TestPart1Example(t *testing.T) {
-	topoMap := Parse(ExampleInput)
-	result := Part1(topoMap)
-	expected := 36
+var puzzle = testkit.Puzzle[TopoMap]{
+	Day:   10,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "36"},
+		{Name: "example", Input: ExampleInput, Part: 2, Want: "81"},
+		{
+			Name: "small example 1",
+			Input: `.....0.
+..4321.
+..5..2.
+..6543.
+..7..4.
+..8765.
+..9....`,
+			Part: 2,
+			Want: "3",
+		},
+		{
+			Name: "small example 2",
+			Input: `..90..9
+...1.98
+...2..7
+6543456
+765.987
+876....
+987....`,
+			Part: 2,
+			Want: "13",
+		},
+		{
+			Name: "small example 3",
+			Input: `012345
+123456
+234567
+345678
+4.6789
+56789.`,
+			Part: 2,
+			Want: "227",
+		},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "ragged lines", Input: "0123\n12"},
+		{Name: "letter", Input: "0123\n12a4"},
+	},
+	Seeds: []string{"..90..\n...1..\n..8.2."},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 75, Bytes: 48 << 10, PeakBytes: 64 << 10},
+		Part1: testkit.Budget{Allocs: 32_000, Bytes: 3500 << 10, PeakBytes: 5 << 20},
+		Part2: testkit.Budget{Allocs: 20_500, Bytes: 1350 << 10, PeakBytes: 2 << 20},
+	},
+}
 
-	if result != expected {
-		t.Errorf("Part1(ExampleInput) = %d; expected %d", result, expected)
-	}
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
 }
 
 func TestFindTrailheads(t *testing.T) {
-	topoMap := Parse(ExampleInput)
+	topoMap := MustParse(ExampleInput)
 	trailheads := topoMap.FindTrailheads()
 
 	if len(trailheads) != 9 {
@@ -23,62 +78,42 @@ func TestFindTrailheads(t *testing.T) {
 	}
 }
 
-func TestPart2Example(t *testing.T) {
-	topoMap := Parse(ExampleInput)
-	result := Part2(topoMap)
-	expected := 81
+func TestTrails(t *testing.T) {
+	topoMap := MustParse(ExampleInput)
 
-	if result != expected {
-		t.Errorf("Part2(ExampleInput) = %d; expected %d", result, expected)
+	for _, trailhead := range topoMap.FindTrailheads() {
+		trails := topoMap.Trails(trailhead)
+		if len(trails) != topoMap.RateTrailhead(trailhead) {
+			t.Errorf("Trails(%v) found %d trails; want the rating %d", trailhead, len(trails), topoMap.RateTrailhead(trailhead))
+		}
+		for _, trail := range trails {
+			if len(trail) != 10 || trail[0] != trailhead {
+				t.Errorf("Trails(%v) returned %v; want 10 steps from the trailhead", trailhead, trail)
+			}
+			for i := 1; i < len(trail); i++ {
+				if trail[i].Manhattan(trail[i-1]) != 1 || topoMap.at(trail[i]) != i {
+					t.Errorf("Trails(%v) returned invalid step %v -> %v", trailhead, trail[i-1], trail[i])
+				}
+			}
+		}
 	}
 }
 
-func TestPart2SmallExample1(t *testing.T) {
-	input := `.....0.
-..4321.
-..5..2.
-..6543.
-..7..4.
-..8765.
-..9....`
-	topoMap := Parse(input)
-	result := Part2(topoMap)
-	expected := 3
-
-	if result != expected {
-		t.Errorf("Part2(small example 1) = %d; expected %d", result, expected)
+func TestExportSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportSVG(&buf, MustParse(ExampleInput), 20); err != nil {
+		t.Fatalf("ExportSVG() error = %v", err)
 	}
+	golden.Compare(t, "testdata/example.svg", buf.Bytes())
 }
 
-func TestPart2SmallExample2(t *testing.T) {
-	input := `..90..9
-...1.98
-...2..7
-6543456
-765.987
-876....
-987....`
-	topoMap := Parse(input)
-	result := Part2(topoMap)
-	expected := 13
-
-	if result != expected {
-		t.Errorf("Part2(small example 2) = %d; expected %d", result, expected)
-	}
+// FuzzParse checks that malformed input makes Parse return an error rather than panic,
+// and that parsing what String writes gives back the same map
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, puzzle.RoundTrip(TopoMap.String))
 }
 
-func TestPart2SmallExample3(t *testing.T) {
-	input := `012345
-123456
-234567
-345678
-4.6789
-56789.`
-	topoMap := Parse(input)
-	result := Part2(topoMap)
-	expected := 227
-
-	if result != expected {
-		t.Errorf("Part2(small example 3) = %d; expected %d", result, expected)
-	}
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
 }
@@ -1,23 +1,52 @@
 package day11
 
 import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/big"
 	"strconv"
 	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/answer"
+	"github.com/amoilanen/advent-of-code-2024/internal/collections"
 )
 
 const ExampleInput = `125 17`
 
 // Parse converts the input string into a slice of stone values
-func Parse(input string) []int {
-	fields := strings.Fields(strings.TrimSpace(input))
-	stones := make([]int, len(fields))
-	for i, field := range fields {
-		val, _ := strconv.Atoi(field)
-		stones[i] = val
+func Parse(input string) ([]int, error) {
+	return ParseReader(strings.NewReader(input))
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) []int {
+	stones, err := Parse(input)
+	if err != nil {
+		panic(err)
 	}
 	return stones
 }
 
+// ParseReader reads the stone values from r one word at a time
+// Every word has to be a non-negative number
+func ParseReader(r io.Reader) ([]int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+	stones := []int{}
+	for scanner.Scan() {
+		val, err := strconv.Atoi(scanner.Text())
+		if err != nil {
+			return nil, err
+		}
+		if val < 0 {
+			return nil, fmt.Errorf("stone %d is negative", val)
+		}
+		stones = append(stones, val)
+	}
+	return stones, scanner.Err()
+}
+
 // countDigits returns the number of digits in a number
 func countDigits(n int) int {
 	if n == 0 {
@@ -63,34 +92,26 @@ func transformStone(value int) []int {
 	return []int{value * 2024}
 }
 
-func initialCounts(stones []int) map[int]int {
-	stoneCount := make(map[int]int)
-	for _, stone := range stones {
-		stoneCount[stone]++
-	}
-	return stoneCount
+func initialCounts(stones []int) collections.Counter[int] {
+	return collections.NewCounter(stones...)
 }
 
-func nextCounts(currentCounts map[int]int) map[int]int {
-	newStoneCount := make(map[int]int)
+func nextCounts(currentCounts collections.Counter[int]) collections.Counter[int] {
+	newStoneCount := make(collections.Counter[int], len(currentCounts))
 
 	// Process each unique stone value
 	for value, count := range currentCounts {
 		// Transform the stone and add results to new map
 		results := transformStone(value)
 		for _, result := range results {
-			newStoneCount[result] += count
+			newStoneCount.Add(result, count)
 		}
 	}
 	return newStoneCount
 }
 
-func countStones(currentCounts map[int]int) int {
-	total := 0
-	for _, count := range currentCounts {
-		total += count
-	}
-	return total
+func countStones(currentCounts collections.Counter[int]) int {
+	return currentCounts.Total()
 }
 
 // simulateBlinks simulates the stone transformations for a given number of blinks
@@ -103,6 +124,38 @@ func simulateBlinks(stones []int, blinks int) int {
 	return countStones(stoneCount)
 }
 
+// SimulateBlinksBig is simulateBlinks for blink counts whose stone total overflows int64
+// Stone values themselves stay small, only the number of copies of each value grows,
+// so the counts are kept as big integers while values remain plain ints.
+func SimulateBlinksBig(stones []int, blinks int) answer.Answer {
+	counts := map[int]*big.Int{}
+	add := func(counts map[int]*big.Int, value int, n *big.Int) {
+		if counts[value] == nil {
+			counts[value] = new(big.Int)
+		}
+		counts[value].Add(counts[value], n)
+	}
+
+	for _, stone := range stones {
+		add(counts, stone, big.NewInt(1))
+	}
+	for i := 0; i < blinks; i++ {
+		next := make(map[int]*big.Int, len(counts))
+		for value, count := range counts {
+			for _, result := range transformStone(value) {
+				add(next, result, count)
+			}
+		}
+		counts = next
+	}
+
+	total := new(big.Int)
+	for _, count := range counts {
+		total.Add(total, count)
+	}
+	return answer.FromBig(total)
+}
+
 // Part1 solves part 1: count stones after 25 blinks
 // Algorithm:
 // 1. Use frequency map to track unique stone values and their counts
@@ -1,27 +1,43 @@
 package day11
 
 import (
+	"io"
+	"math/rand/v2"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
-)
 
-func TestPart1Example(t *testing.T) {
-	stones := Parse(ExampleInput)
-	result := Part1(stones)
-	expected := 55312
+	"github.com/amoilanen/advent-of-code-2024/internal/answer"
+	"github.com/amoilanen/advent-of-code-2024/internal/heapstat"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
+)
 
-	if result != expected {
-		t.Errorf("Part1(ExampleInput) = %d; expected %d", result, expected)
-	}
+var puzzle = testkit.Puzzle[[]int]{
+	Day:   11,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "55312"},
+		{Name: "example", Input: ExampleInput, Part: 2, Want: "65601038650482"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "not a number", Input: "125 x"},
+		{Name: "negative", Input: "125 -17"},
+		{Name: "too large", Input: "99999999999999999999"},
+	},
+	Seeds: []string{"0 1 10 99 999"},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 4, Bytes: 8 << 10, PeakBytes: 16 << 10},
+		Part1: testkit.Budget{Allocs: 3300, Bytes: 192 << 10, PeakBytes: 256 << 10},
+		Part2: testkit.Budget{Allocs: 137_000, Bytes: 7500 << 10, PeakBytes: 6 << 20},
+	},
 }
 
-func TestPart2Example(t *testing.T) {
-	stones := Parse(ExampleInput)
-	result := Part2(stones)
-	expected := 65601038650482
-
-	if result != expected {
-		t.Errorf("Part1(ExampleInput) = %d; expected %d", result, expected)
-	}
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
 }
 
 func TestTransformStone(t *testing.T) {
@@ -82,6 +98,22 @@ func TestSimulateBlinksSmall(t *testing.T) {
 	}
 }
 
+func TestSimulateBlinksBig(t *testing.T) {
+	// Small blink counts must agree with the int version
+	for blinks := 0; blinks <= 75; blinks += 5 {
+		want := answer.FromInt(simulateBlinks([]int{125, 17}, blinks))
+		if got := SimulateBlinksBig([]int{125, 17}, blinks); !got.Equal(want) {
+			t.Errorf("SimulateBlinksBig(%d) = %v; expected %v", blinks, got, want)
+		}
+	}
+
+	// After 250 blinks the total no longer fits into int64
+	result := SimulateBlinksBig([]int{125, 17}, 250)
+	if result.Kind() != answer.Big {
+		t.Errorf("SimulateBlinksBig(250) = %v of kind %v; expected a big integer", result, result.Kind())
+	}
+}
+
 func TestCountDigits(t *testing.T) {
 	tests := []struct {
 		input    int
@@ -130,3 +162,116 @@ func TestSplitNumber(t *testing.T) {
 		}
 	}
 }
+
+func TestParseReader(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []int
+	}{
+		{"example", ExampleInput, []int{125, 17}},
+		{"several lines", "0 1\n10 99\n\n999\n", []int{0, 1, 10, 99, 999}},
+		{"empty", "", []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseReader(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("ParseReader() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseReader() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// BenchmarkParseReader compares the peak heap of parsing a large input from a string and streaming it from a reader
+// Run with -bench BenchmarkParseReader -benchtime 1x to see the peak-heap-B metric; both keep every stone,
+// which dwarfs the input text, so unlike the days that stream records the peaks are of the same order
+func BenchmarkParseReader(b *testing.B) {
+	const copies = 1_000_000 // About 7 MB of input
+	block := ExampleInput + "\n"
+
+	b.Run("string", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			data, _ := io.ReadAll(peak.Reader(heapstat.Repeat(block, copies)))
+			stones := MustParse(string(data))
+			peak.Force()
+			_ = stones
+			peak.Report(b)
+		}
+	})
+
+	b.Run("reader", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			if _, err := ParseReader(peak.Reader(heapstat.Repeat(block, copies))); err != nil {
+				b.Fatal(err)
+			}
+			peak.Report(b)
+		}
+	})
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, nil)
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
+
+// referenceBlinks keeps every stone in a list, in order, and returns how many there are after blinking
+// It treats the engravings as text, so it shares neither the digit arithmetic nor the counting by value
+func referenceBlinks(stones []int, blinks int) int {
+	line := make([]string, len(stones))
+	for i, stone := range stones {
+		line[i] = strconv.Itoa(stone)
+	}
+
+	for range blinks {
+		var next []string
+		for _, stone := range line {
+			switch {
+			case stone == "0":
+				next = append(next, "1")
+			case len(stone)%2 == 0:
+				right := strings.TrimLeft(stone[len(stone)/2:], "0")
+				if right == "" {
+					right = "0"
+				}
+				next = append(next, stone[:len(stone)/2], right)
+			default:
+				n, _ := strconv.Atoi(stone)
+				next = append(next, strconv.Itoa(n*2024))
+			}
+		}
+		line = next
+	}
+	return len(line)
+}
+
+// TestSimulateBlinksMatchesReference compares counting stones by value with blinking a literal list
+func TestSimulateBlinksMatchesReference(t *testing.T) {
+	rng := rand.New(rand.NewPCG(11, 2024))
+	for range 2000 {
+		stones := make([]int, 1+rng.IntN(4))
+		for i := range stones {
+			// One to seven digits, with zeros and numbers like 1000 that split into a zero half
+			stones[i] = rng.IntN(10)
+			for range rng.IntN(7) {
+				stones[i] = stones[i]*10 + rng.IntN(10)*rng.IntN(2)
+			}
+		}
+		blinks := rng.IntN(16)
+
+		if got, want := simulateBlinks(stones, blinks), referenceBlinks(stones, blinks); got != want {
+			t.Fatalf("simulateBlinks(%v, %d) = %d, want %d", stones, blinks, got, want)
+		}
+	}
+}
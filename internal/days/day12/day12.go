@@ -1,7 +1,15 @@
 package day12
 
 import (
+	"image/color"
+	"io"
 	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/render"
+	"github.com/amoilanen/advent-of-code-2024/internal/svg"
+	"github.com/amoilanen/advent-of-code-2024/internal/unionfind"
+	"github.com/amoilanen/advent-of-code-2024/internal/utils"
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
 )
 
 const ExampleInput = `RRRRIICCFF
@@ -23,109 +31,86 @@ type Grid struct {
 }
 
 // Parse converts the input string into a Grid
-func Parse(input string) Grid {
-	lines := strings.Split(strings.TrimSpace(input), "\n")
-	rows := len(lines)
-	if rows == 0 {
-		return Grid{}
+// The lines have to form a rectangle, as regions are labelled on a Rows x Cols grid
+func Parse(input string) (Grid, error) {
+	lines, err := utils.AsGrid(input)
+	if err != nil {
+		return Grid{}, err
 	}
-
-	cols := len(lines[0])
 	return Grid{
 		Cells: lines,
-		Rows:  rows,
-		Cols:  cols,
-	}
+		Rows:  len(lines),
+		Cols:  len(lines[0]),
+	}, nil
 }
 
-// findRegion uses BFS to find all cells in a connected region
-// Returns a set of all cells in the region starting at (startRow, startColumn)
-func findRegion(grid Grid, visited [][]bool, startRow, startColumn int) map[[2]int]bool {
-	plantType := grid.Cells[startRow][startColumn]
-	regionCells := make(map[[2]int]bool)
-
-	queue := [][2]int{{startRow, startColumn}}
-	visited[startRow][startColumn] = true
-
-	directions := [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}}
-
-	for len(queue) > 0 {
-		current := queue[0]
-		queue = queue[1:]
-		currentRow, currentColumn := current[0], current[1]
-
-		regionCells[[2]int{currentRow, currentColumn}] = true
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) Grid {
+	grid, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return grid
+}
 
-		// Explore neighbors
-		for _, dir := range directions {
-			neighborRow, neighborColumn := currentRow+dir[0], currentColumn+dir[1]
+// String returns the garden in the format of the puzzle input
+func (g Grid) String() string {
+	return strings.Join(g.Cells, "\n")
+}
 
-			if neighborRow >= 0 && neighborRow < grid.Rows &&
-				neighborColumn >= 0 && neighborColumn < grid.Cols &&
-				grid.Cells[neighborRow][neighborColumn] == plantType &&
-				!visited[neighborRow][neighborColumn] {
-				visited[neighborRow][neighborColumn] = true
-				queue = append(queue, [2]int{neighborRow, neighborColumn})
-			}
-		}
-	}
+// labelRegions labels the connected regions of same-type plants
+// Each cell receives a region ID; region sizes are the areas
+func labelRegions(grid Grid) *unionfind.Labeling {
+	return unionfind.LabelGrid(grid.Rows, grid.Cols, func(row1, col1, row2, col2 int) bool {
+		return grid.Cells[row1][col1] == grid.Cells[row2][col2]
+	})
+}
 
-	return regionCells
+// inRegion checks if (row, col) is inside the grid and belongs to the given region
+func inRegion(regions *unionfind.Labeling, row, col, region int) bool {
+	return row >= 0 && row < regions.Rows &&
+		col >= 0 && col < regions.Cols &&
+		regions.At(row, col) == region
 }
 
-// calculatePerimeter calculates the perimeter of a region
+// calculatePerimeters calculates the perimeter of every region
 // Perimeter is the count of edges that border cells outside the region
-func calculatePerimeter(grid Grid, regionCells map[[2]int]bool) int {
-	perimeter := 0
+func calculatePerimeters(regions *unionfind.Labeling) []int {
+	perimeters := make([]int, regions.Count())
 	directions := [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}}
 
-	for cell := range regionCells {
-		currentRow, currentColumn := cell[0], cell[1]
-		plantType := grid.Cells[currentRow][currentColumn]
-
-		// Check each of the 4 sides
-		for _, dir := range directions {
-			neighborRow, neighborColumn := currentRow+dir[0], currentColumn+dir[1]
+	for row := 0; row < regions.Rows; row++ {
+		for col := 0; col < regions.Cols; col++ {
+			region := regions.At(row, col)
 
-			// Edge contributes to perimeter if out of bounds or different plant type
-			if neighborRow < 0 || neighborRow >= grid.Rows ||
-				neighborColumn < 0 || neighborColumn >= grid.Cols ||
-				grid.Cells[neighborRow][neighborColumn] != plantType {
-				perimeter++
+			// Edge contributes to perimeter if out of bounds or in a different region
+			for _, dir := range directions {
+				if !inRegion(regions, row+dir[0], col+dir[1], region) {
+					perimeters[region]++
+				}
 			}
 		}
 	}
 
-	return perimeter
+	return perimeters
 }
 
 // Part1 calculates the total fencing cost for all regions
 // Algorithm:
-// 1. Use flood fill (BFS) to identify connected regions of same plant type
-// 2. For each region, calculate area (cell count) and perimeter (edges not touching same type)
+// 1. Label connected regions of same plant type with a union-find over the grid
+// 2. For each region, take area (cell count) and perimeter (edges not touching same region)
 // 3. Price per region = area * perimeter
 // 4. Total price = sum of all region prices
 //
-// Time complexity: O(rows * cols) - each cell visited once
-// Space complexity: O(rows * cols) - for visited tracking
+// Time complexity: O(rows * cols) - each cell visited a constant number of times
+// Space complexity: O(rows * cols) - for region labels
 func Part1(grid Grid) int {
-	visited := make([][]bool, grid.Rows)
-	for i := range visited {
-		visited[i] = make([]bool, grid.Cols)
-	}
+	regions := labelRegions(grid)
+	perimeters := calculatePerimeters(regions)
 
 	totalPrice := 0
-
-	// Find all regions using flood fill
-	for r := 0; r < grid.Rows; r++ {
-		for c := 0; c < grid.Cols; c++ {
-			if !visited[r][c] {
-				regionCells := findRegion(grid, visited, r, c)
-				area := len(regionCells)
-				perimeter := calculatePerimeter(grid, regionCells)
-				totalPrice += area * perimeter
-			}
-		}
+	for region, area := range regions.Sizes {
+		totalPrice += area * perimeters[region]
 	}
 
 	return totalPrice
@@ -133,10 +118,11 @@ func Part1(grid Grid) int {
 
 // isCorner checks if a corner exists at a cell's corner position
 // Returns true if either an outer or inner corner is detected
-func isCorner(regionCells map[[2]int]bool, row, col, dRow1, dCol1, dRow2, dCol2 int) bool {
-	neighbor1 := regionCells[[2]int{row + dRow1, col + dCol1}]
-	neighbor2 := regionCells[[2]int{row + dRow2, col + dCol2}]
-	diagonal := regionCells[[2]int{row + dRow1 + dRow2, col + dCol1 + dCol2}]
+func isCorner(regions *unionfind.Labeling, row, col, dRow1, dCol1, dRow2, dCol2 int) bool {
+	region := regions.At(row, col)
+	neighbor1 := inRegion(regions, row+dRow1, col+dCol1, region)
+	neighbor2 := inRegion(regions, row+dRow2, col+dCol2, region)
+	diagonal := inRegion(regions, row+dRow1+dRow2, col+dCol1+dCol2, region)
 
 	// Outer corner: both orthogonal neighbors are outside region
 	if !neighbor1 && !neighbor2 {
@@ -151,13 +137,13 @@ func isCorner(regionCells map[[2]int]bool, row, col, dRow1, dCol1, dRow2, dCol2
 	return false
 }
 
-// countCorners counts the number of corners in a region
+// countCorners counts the number of corners of every region
 // Key insight: number of sides = number of corners in any closed polygon
 //
 // For each cell, we check 4 possible corners (NW, NE, SW, SE):
 // - Outer corner: both orthogonal neighbors are NOT in region
 // - Inner corner: both orthogonal neighbors ARE in region, but diagonal is NOT
-func countCorners(regionCells map[[2]int]bool) int {
+func countCorners(regions *unionfind.Labeling) []int {
 	// Define the 4 corner configurations: [vertical offset, horizontal offset]
 	// Each corner is defined by two orthogonal directions
 	cornerConfigs := [][4]int{
@@ -167,26 +153,26 @@ func countCorners(regionCells map[[2]int]bool) int {
 		{1, 0, 0, 1},   // SE: bottom, right
 	}
 
-	totalCorners := 0
-
-	for cell := range regionCells {
-		row, col := cell[0], cell[1]
+	corners := make([]int, regions.Count())
 
-		// Check all 4 corners of this cell
-		for _, config := range cornerConfigs {
-			if isCorner(regionCells, row, col, config[0], config[1], config[2], config[3]) {
-				totalCorners++
+	for row := 0; row < regions.Rows; row++ {
+		for col := 0; col < regions.Cols; col++ {
+			// Check all 4 corners of this cell
+			for _, config := range cornerConfigs {
+				if isCorner(regions, row, col, config[0], config[1], config[2], config[3]) {
+					corners[regions.At(row, col)]++
+				}
 			}
 		}
 	}
 
-	return totalCorners
+	return corners
 }
 
 // Part2 calculates the total fencing cost using bulk discount
 // Algorithm:
-// 1. Use flood fill (BFS) to identify connected regions of same plant type
-// 2. For each region, calculate area and number of sides (corners)
+// 1. Label connected regions of same plant type with a union-find over the grid
+// 2. For each region, take area and number of sides (corners)
 // 3. Key insight: In any closed polygon, sides = corners
 // 4. Count corners by checking each cell's 4 corner positions:
 //   - Outer corners: both orthogonal neighbors outside region
@@ -194,27 +180,171 @@ func countCorners(regionCells map[[2]int]bool) int {
 //
 // 5. Price per region = area * sides
 //
-// Time complexity: O(rows * cols) - each cell visited once
-// Space complexity: O(rows * cols) - for visited tracking and region storage
+// Time complexity: O(rows * cols) - each cell visited a constant number of times
+// Space complexity: O(rows * cols) - for region labels
 func Part2(grid Grid) int {
-	visited := make([][]bool, grid.Rows)
-	for i := range visited {
-		visited[i] = make([]bool, grid.Cols)
-	}
+	regions := labelRegions(grid)
+	sides := countCorners(regions)
 
 	totalPrice := 0
+	for region, area := range regions.Sizes {
+		totalPrice += area * sides[region]
+	}
+
+	return totalPrice
+}
+
+// Render draws the garden with every region in its own color
+// Each cell becomes a scale x scale square of pixels
+func Render(grid Grid, scale int) *render.Canvas {
+	regions := labelRegions(grid)
+	palette := render.Palette[int]{Colors: make(map[int]color.Color, regions.Count())}
+	for region := 0; region < regions.Count(); region++ {
+		palette.Colors[region] = render.Distinct(region)
+	}
+	return render.Grid(grid.Cols, grid.Rows, scale, func(x, y int) int {
+		return regions.At(y, x)
+	}, palette)
+}
+
+// fenceEdge is one unit of fence between two grid corners
+// Corner (x, y) is the top-left corner of the cell in column x and row y
+type fenceEdge struct {
+	from, to vector.Vec2
+}
+
+// outlines traces the fences of every region as closed polygons of grid corners
+// A region with holes has one polygon for its outside and one for every hole.
+//
+// Algorithm:
+// 1. Emit a directed unit edge for every cell side that borders another region,
+// oriented clockwise so the region is always on the right
+// 2. Starting from any unused edge, follow edges end to start until the loop closes;
+// where two edges leave the same corner (regions touching diagonally) always turn right,
+// so holes that share a corner are traced as a single figure-eight
+// 3. Keep only the corners where the fence changes direction
+//
+// The number of corners kept equals the number of sides of the region.
+// Time complexity: O(rows * cols)
+func outlines(regions *unionfind.Labeling) [][][]vector.Vec2 {
+	edges := make([][]fenceEdge, regions.Count())
+	for row := 0; row < regions.Rows; row++ {
+		for col := 0; col < regions.Cols; col++ {
+			region := regions.At(row, col)
+			topLeft := vector.Vec2{X: col, Y: row}
+			topRight := topLeft.Add(vector.Right)
+			bottomRight := topRight.Add(vector.Down)
+			bottomLeft := topLeft.Add(vector.Down)
+
+			if !inRegion(regions, row-1, col, region) {
+				edges[region] = append(edges[region], fenceEdge{topLeft, topRight})
+			}
+			if !inRegion(regions, row, col+1, region) {
+				edges[region] = append(edges[region], fenceEdge{topRight, bottomRight})
+			}
+			if !inRegion(regions, row+1, col, region) {
+				edges[region] = append(edges[region], fenceEdge{bottomRight, bottomLeft})
+			}
+			if !inRegion(regions, row, col-1, region) {
+				edges[region] = append(edges[region], fenceEdge{bottomLeft, topLeft})
+			}
+		}
+	}
+
+	polygons := make([][][]vector.Vec2, regions.Count())
+	for region, regionEdges := range edges {
+		polygons[region] = traceLoops(regionEdges)
+	}
+	return polygons
+}
 
-	// Find all regions using flood fill
-	for r := 0; r < grid.Rows; r++ {
-		for c := 0; c < grid.Cols; c++ {
-			if !visited[r][c] {
-				regionCells := findRegion(grid, visited, r, c)
-				area := len(regionCells)
-				sides := countCorners(regionCells)
-				totalPrice += area * sides
+// traceLoops joins directed unit edges into closed loops and drops corners on straight runs
+func traceLoops(edges []fenceEdge) [][]vector.Vec2 {
+	outgoing := map[vector.Vec2][]int{}
+	for i, edge := range edges {
+		outgoing[edge.from] = append(outgoing[edge.from], i)
+	}
+	used := make([]bool, len(edges))
+
+	loops := [][]vector.Vec2{}
+	for start := range edges {
+		if used[start] {
+			continue
+		}
+
+		// Follow the fence until it returns to where it started
+		corners := []vector.Vec2{}
+		for current := start; current >= 0; {
+			used[current] = true
+			edge := edges[current]
+			corners = append(corners, edge.from)
+			current = nextEdge(edges, outgoing[edge.to], used, edge.to.Sub(edge.from))
+		}
+		loops = append(loops, turningCorners(corners))
+	}
+	return loops
+}
+
+// nextEdge picks the unused edge to continue with after moving in direction dir
+// Prefers turning right, then going straight, then turning left; returns -1 when the loop is closed
+func nextEdge(edges []fenceEdge, candidates []int, used []bool, dir vector.Vec2) int {
+	for _, want := range []vector.Vec2{dir.RotateRight(), dir, dir.RotateLeft()} {
+		for _, i := range candidates {
+			if !used[i] && edges[i].to.Sub(edges[i].from) == want {
+				return i
 			}
 		}
 	}
+	return -1
+}
 
-	return totalPrice
+// turningCorners keeps the corners of a closed loop where the direction changes
+func turningCorners(corners []vector.Vec2) []vector.Vec2 {
+	n := len(corners)
+	turning := []vector.Vec2{}
+	for i, corner := range corners {
+		in := corner.Sub(corners[(i+n-1)%n])
+		out := corners[(i+1)%n].Sub(corner)
+		if in != out {
+			turning = append(turning, corner)
+		}
+	}
+	return turning
+}
+
+// ExportSVG draws every region in its own color, outlines the fences and labels each region
+// with its plant type in the region's first cell
+func ExportSVG(w io.Writer, grid Grid, cellSize int) error {
+	regions := labelRegions(grid)
+	doc := svg.New(grid.Cols, grid.Rows, cellSize)
+
+	for row := 0; row < grid.Rows; row++ {
+		for col := 0; col < grid.Cols; col++ {
+			fill := svg.Hex(render.Distinct(regions.At(row, col)))
+			doc.Cell(vector.Vec2{X: col, Y: row}, svg.Style{Fill: fill})
+		}
+	}
+
+	fence := svg.Style{Stroke: "#202020", StrokeWidth: 2}
+	for _, loops := range outlines(regions) {
+		for _, loop := range loops {
+			doc.Polygon(loop, fence)
+		}
+	}
+
+	// Region IDs are numbered in reading order, so the first cell seen of each region is its first cell
+	labeled := make([]bool, regions.Count())
+	for row := 0; row < grid.Rows; row++ {
+		for col := 0; col < grid.Cols; col++ {
+			if region := regions.At(row, col); !labeled[region] {
+				labeled[region] = true
+				doc.Label(vector.Vec2{X: col, Y: row}, string(grid.Cells[row][col]), svg.Style{Fill: "#202020"})
+			}
+		}
+	}
+
+	doc.Legend("fence", fence)
+
+	_, err := doc.WriteTo(w)
+	return err
 }
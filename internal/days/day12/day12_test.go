@@ -1,106 +1,143 @@
 package day12
 
 import (
+	"bytes"
 	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/golden"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
 )
 
-func TestPart1WithExamples(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    string
-		expected int
-	}{
-		{
-			name: "Small example",
-			input: `AAAA
+const smallExample = `AAAA
 BBCD
 BBCC
-EEEC`,
-			expected: 140,
-		},
-		{
-			name: "Example with nested regions",
-			input: `OOOOO
+EEEC`
+
+const nestedExample = `OOOOO
 OXOXO
 OOOOO
 OXOXO
-OOOOO`,
-			expected: 772,
-		},
-		{
-			name:     "Large example",
-			input:    ExampleInput,
-			expected: 1930,
-		},
-	}
+OOOOO`
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			grid := Parse(tt.input)
-			result := Part1(grid)
-			if result != tt.expected {
-				t.Errorf("Part1() = %d, want %d", result, tt.expected)
-			}
-		})
-	}
-}
+var puzzle = testkit.Puzzle[Grid]{
+	Day:   12,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "Small example", Input: smallExample, Part: 1, Want: "140"},
+		{Name: "Example with nested regions", Input: nestedExample, Part: 1, Want: "772"},
+		{Name: "Large example", Input: ExampleInput, Part: 1, Want: "1930"},
 
-func TestPart2WithExamples(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    string
-		expected int
-	}{
-		{
-			name: "Small example - 4 simple regions",
-			input: `AAAA
-BBCD
-BBCC
-EEEC`,
-			expected: 80, // A=16, B=16, C=32, D=4, E=12
-		},
-		{
-			name: "Example with nested regions",
-			input: `OOOOO
-OXOXO
-OOOOO
-OXOXO
-OOOOO`,
-			expected: 436,
-		},
+		{Name: "Small example - 4 simple regions", Input: smallExample, Part: 2, Want: "80"}, // A=16, B=16, C=32, D=4, E=12
+		{Name: "Example with nested regions", Input: nestedExample, Part: 2, Want: "436"},
 		{
-			name: "E-shaped region",
-			input: `EEEEE
+			Name: "E-shaped region",
+			Input: `EEEEE
 EXXXX
 EEEEE
 EXXXX
 EEEEE`,
-			expected: 236, // E region: 17 area * 12 sides = 204, plus X regions
+			Part: 2,
+			Want: "236", // E region: 17 area * 12 sides = 204, plus X regions
 		},
 		{
-			name: "Nested region with inner holes",
-			input: `AAAAAA
+			Name: "Nested region with inner holes",
+			Input: `AAAAAA
 AAABBA
 AAABBA
 ABBAAA
 ABBAAA
 AAAAAA`,
-			expected: 368, // A has outer+inner sides, B regions are simple
-		},
-		{
-			name:     "Large example",
-			input:    ExampleInput,
-			expected: 1206,
+			Part: 2,
+			Want: "368", // A has outer+inner sides, B regions are simple
 		},
+		{Name: "Large example", Input: ExampleInput, Part: 2, Want: "1206"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "ragged lines", Input: "AAAA\nBB"},
+		{Name: "non-ASCII plant", Input: "AAÄA"},
+	},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 4, Bytes: 8 << 10, PeakBytes: 16 << 10},
+		Part1: testkit.Budget{Allocs: 10, Bytes: 1250 << 10, PeakBytes: 2 << 20},
+		Part2: testkit.Budget{Allocs: 10, Bytes: 1250 << 10, PeakBytes: 2 << 20},
+	},
+}
+
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
+}
+
+func TestRender(t *testing.T) {
+	grid := MustParse("AAB\nABB")
+	img := Render(grid, 1).Image()
+
+	if size := img.Bounds().Size(); size.X != 3 || size.Y != 2 {
+		t.Fatalf("Render() size = %v; want 3x2", size)
+	}
+	if img.RGBAAt(0, 0) != img.RGBAAt(0, 1) || img.RGBAAt(2, 0) != img.RGBAAt(1, 1) {
+		t.Errorf("Render() colors cells of one region differently")
+	}
+	if img.RGBAAt(0, 0) == img.RGBAAt(2, 0) {
+		t.Errorf("Render() colors different regions the same")
+	}
+}
+
+func TestOutlines(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		loops int
+	}{
+		{"single cell", "A", 1},
+		{"nested regions", "OOOOO\nOXOXO\nOOOOO\nOXOXO\nOOOOO", 5 + 4},
+		// The two holes in A share a corner and are traced as one figure-eight
+		{"touching diagonally", "AAAAAA\nAAABBA\nAAABBA\nABBAAA\nABBAAA\nAAAAAA", 2 + 2},
+		{"large example", ExampleInput, 11},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			grid := Parse(tt.input)
-			result := Part2(grid)
-			if result != tt.expected {
-				t.Errorf("Part2() = %d, want %d", result, tt.expected)
+			regions := labelRegions(MustParse(tt.input))
+			corners := countCorners(regions)
+
+			loops := 0
+			for region, polygons := range outlines(regions) {
+				loops += len(polygons)
+
+				// Every kept corner is a turn of the fence, so they add up to the number of sides
+				sides := 0
+				for _, polygon := range polygons {
+					sides += len(polygon)
+				}
+				if sides != corners[region] {
+					t.Errorf("region %d outlines have %d corners; want %d", region, sides, corners[region])
+				}
+			}
+			if loops != tt.loops {
+				t.Errorf("outlines() traced %d loops; want %d", loops, tt.loops)
 			}
 		})
 	}
 }
+
+func TestExportSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportSVG(&buf, MustParse(ExampleInput), 20); err != nil {
+		t.Fatalf("ExportSVG() error = %v", err)
+	}
+	golden.Compare(t, "testdata/example.svg", buf.Bytes())
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic,
+// and that parsing what String writes gives back the same garden
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, puzzle.RoundTrip(Grid.String))
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
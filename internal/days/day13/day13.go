@@ -1,9 +1,14 @@
 package day13
 
 import (
+	"fmt"
+	"io"
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/utils"
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
 )
 
 const ExampleInput = `Button A: X+94, Y+34
@@ -23,10 +28,7 @@ Button B: X+27, Y+71
 Prize: X=18641, Y=10279`
 
 // Vector represents a 2D coordinate or movement
-type Vector struct {
-	X int
-	Y int
-}
+type Vector = vector.Vec2
 
 // Machine represents a claw machine configuration
 type Machine struct {
@@ -43,60 +45,107 @@ type Solution struct {
 	Cost     int  // Total cost in tokens
 }
 
+// Regular expressions for parsing
+var (
+	buttonRegex = regexp.MustCompile(`Button [AB]: X\+(\d+), Y\+(\d+)`)
+	prizeRegex  = regexp.MustCompile(`Prize: X=(\d+), Y=(\d+)`)
+)
+
 // Parse converts the input string into a slice of Machine configurations
-func Parse(input string) []Machine {
-	var machines []Machine
+func Parse(input string) ([]Machine, error) {
+	return ParseReader(strings.NewReader(input))
+}
 
-	// Regular expressions for parsing
-	buttonRegex := regexp.MustCompile(`Button [AB]: X\+(\d+), Y\+(\d+)`)
-	prizeRegex := regexp.MustCompile(`Prize: X=(\d+), Y=(\d+)`)
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) []Machine {
+	machines, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return machines
+}
 
-	lines := strings.Split(strings.TrimSpace(input), "\n")
+// ParseReader reads all Machine configurations from r
+func ParseReader(r io.Reader) ([]Machine, error) {
+	var machines []Machine
+	err := EachMachine(r, func(machine Machine) {
+		machines = append(machines, machine)
+	})
+	return machines, err
+}
 
+// EachMachine calls fn with the machines of r one at a time
+// Machines are separated by empty lines; only the machine being read is held in memory.
+// Lines that are not about buttons or prizes are skipped, but a malformed button or prize is an error.
+func EachMachine(r io.Reader, fn func(Machine)) error {
 	var currentMachine Machine
 	lineInMachine := 0
 
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
+	err := utils.ScanLines(r, func(line string) error {
 		if line == "" {
 			// Empty line separates machines
 			if lineInMachine > 0 {
-				machines = append(machines, currentMachine)
+				fn(currentMachine)
 				currentMachine = Machine{}
 				lineInMachine = 0
 			}
-			continue
+			return nil
 		}
 
-		if strings.HasPrefix(line, "Button A:") {
-			matches := buttonRegex.FindStringSubmatch(line)
-			x, _ := strconv.Atoi(matches[1])
-			y, _ := strconv.Atoi(matches[2])
-			currentMachine.ButtonA = Vector{X: x, Y: y}
-			lineInMachine++
-		} else if strings.HasPrefix(line, "Button B:") {
-			matches := buttonRegex.FindStringSubmatch(line)
-			x, _ := strconv.Atoi(matches[1])
-			y, _ := strconv.Atoi(matches[2])
-			currentMachine.ButtonB = Vector{X: x, Y: y}
-			lineInMachine++
-		} else if strings.HasPrefix(line, "Prize:") {
-			matches := prizeRegex.FindStringSubmatch(line)
-			x, _ := strconv.Atoi(matches[1])
-			y, _ := strconv.Atoi(matches[2])
-			currentMachine.Prize = Vector{X: x, Y: y}
-			lineInMachine++
+		var err error
+		switch {
+		case strings.HasPrefix(line, "Button A:"):
+			currentMachine.ButtonA, err = parseVector(buttonRegex, line)
+		case strings.HasPrefix(line, "Button B:"):
+			currentMachine.ButtonB, err = parseVector(buttonRegex, line)
+		case strings.HasPrefix(line, "Prize:"):
+			currentMachine.Prize, err = parseVector(prizeRegex, line)
+		default:
+			return nil
 		}
+		lineInMachine++
+		return err
+	})
+	if err != nil {
+		return err
 	}
 
 	// Don't forget the last machine
 	if lineInMachine > 0 {
-		machines = append(machines, currentMachine)
+		fn(currentMachine)
 	}
+	return nil
+}
 
-	return machines
+// String returns the machine in the format of the puzzle input
+func (m Machine) String() string {
+	return fmt.Sprintf("Button A: X+%d, Y+%d\nButton B: X+%d, Y+%d\nPrize: X=%d, Y=%d",
+		m.ButtonA.X, m.ButtonA.Y, m.ButtonB.X, m.ButtonB.Y, m.Prize.X, m.Prize.Y)
+}
+
+// parseVector reads the X and Y values of a button or prize line
+func parseVector(pattern *regexp.Regexp, line string) (Vector, error) {
+	matches := pattern.FindStringSubmatch(line)
+	if matches == nil {
+		return Vector{}, fmt.Errorf("malformed line %q", line)
+	}
+	x, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return Vector{}, err
+	}
+	y, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return Vector{}, err
+	}
+	return Vector{X: x, Y: y}, nil
 }
 
+// Tokens it costs to press each button
+const (
+	ButtonACost = 3
+	ButtonBCost = 1
+)
+
 // SolveMachineWithConstraints finds the optimal solution using Cramer's rule
 // Algorithm:
 // We need to solve the system of linear equations:
@@ -117,11 +166,6 @@ func Parse(input string) []Machine {
 // Time complexity: O(1)
 // Space complexity: O(1)
 func SolveMachineWithConstraints(machine Machine, maxPresses int) Solution {
-	const (
-		ButtonACost = 3
-		ButtonBCost = 1
-	)
-
 	ax, ay := machine.ButtonA.X, machine.ButtonA.Y
 	bx, by := machine.ButtonB.X, machine.ButtonB.Y
 	px, py := machine.Prize.X, machine.Prize.Y
@@ -129,9 +173,9 @@ func SolveMachineWithConstraints(machine Machine, maxPresses int) Solution {
 	// Calculate determinant
 	det := ax*by - ay*bx
 
-	// If determinant is 0, the buttons are parallel (no unique solution)
+	// If determinant is 0, the buttons are parallel (no unique solution, but maybe a cheapest one)
 	if det == 0 {
-		return Solution{Valid: false}
+		return solveParallel(machine, maxPresses)
 	}
 
 	// Apply Cramer's rule
@@ -171,6 +215,75 @@ func SolveMachineWithConstraints(machine Machine, maxPresses int) Solution {
 	}
 }
 
+// solveParallel finds the cheapest presses when both buttons move the claw along the same line
+// The prize must then lie on that line as well, and only the distance along one axis matters.
+// The solutions of a*u + b*v = p are a = a0 + k*v/g, b = b0 - k*u/g for the g = gcd(u, v),
+// and as the cost changes linearly with k, the cheapest one is the first or the last within the limits.
+func solveParallel(machine Machine, maxPresses int) Solution {
+	buttonA, buttonB, prize := machine.ButtonA, machine.ButtonB, machine.Prize
+	if buttonA.X*prize.Y != buttonA.Y*prize.X || buttonB.X*prize.Y != buttonB.Y*prize.X {
+		return Solution{Valid: false}
+	}
+
+	// Measure along X unless both buttons move the claw vertically only
+	u, v, p := buttonA.X, buttonB.X, prize.X
+	if u == 0 && v == 0 {
+		u, v, p = buttonA.Y, buttonB.Y, prize.Y
+	}
+	a, b, ok := cheapestPresses(u, v, p, maxPresses)
+	if !ok || a*buttonA.X+b*buttonB.X != prize.X || a*buttonA.Y+b*buttonB.Y != prize.Y {
+		return Solution{Valid: false}
+	}
+	return Solution{Valid: true, APresses: a, BPresses: b, Cost: a*ButtonACost + b*ButtonBCost}
+}
+
+// cheapestPresses solves a*u + b*v = p for non-negative a and b of at most maxPresses (-1 for no limit)
+// with the lowest cost; u, v and p are not negative
+func cheapestPresses(u, v, p, maxPresses int) (a, b int, ok bool) {
+	withinLimit := func(presses int) bool { return maxPresses < 0 || presses <= maxPresses }
+
+	switch {
+	case u == 0 && v == 0:
+		return 0, 0, p == 0
+	case u == 0:
+		// Button A does not move the claw, so it is never worth pressing
+		return 0, p / v, p%v == 0 && withinLimit(p/v)
+	case v == 0:
+		return p / u, 0, p%u == 0 && withinLimit(p/u)
+	}
+
+	g, x, _ := utils.ExtendedGCD(u, v)
+	if p%g != 0 {
+		return 0, 0, false
+	}
+
+	// a is a solution exactly when it is congruent to x*p/g modulo step; b shrinks as a grows
+	step := v / g
+	first := vector.Mod(x, step) * ((p / g) % step) % step
+
+	// Both counts must be within [0, maxPresses]; b = (p - a*u)/v bounds a from both sides
+	lo, hi := 0, p/u
+	if maxPresses >= 0 {
+		hi = min(hi, maxPresses)
+		if rest := p - maxPresses*v; rest > 0 {
+			lo = (rest + u - 1) / u
+		}
+	}
+	if first < lo {
+		first += (lo - first + step - 1) / step * step
+	}
+	if first > hi {
+		return 0, 0, false
+	}
+
+	// Pressing A as often as possible pays off when it moves the claw further per token than B
+	a = first
+	if u*ButtonBCost > v*ButtonACost {
+		a = first + (hi-first)/step*step
+	}
+	return a, (p - a*u) / v, true
+}
+
 // SolveMachine finds the optimal solution with the standard 100-press limit
 func SolveMachine(machine Machine) Solution {
 	return SolveMachineWithConstraints(machine, 100)
@@ -186,14 +299,9 @@ func SolveMachine(machine Machine) Solution {
 // Space complexity: O(1) additional space
 func Part1(machines []Machine) int {
 	totalCost := 0
-
 	for _, machine := range machines {
-		solution := SolveMachine(machine)
-		if solution.Valid {
-			totalCost += solution.Cost
-		}
+		totalCost += part1Cost(machine)
 	}
-
 	return totalCost
 }
 
@@ -209,27 +317,47 @@ func Part1(machines []Machine) int {
 // Time complexity: O(n) where n is number of machines
 // Space complexity: O(1) additional space
 func Part2(machines []Machine) int {
-	const PrizeOffset = 10000000000000
-
 	totalCost := 0
-
 	for _, machine := range machines {
-		// Create a corrected machine with offset prize coordinates
-		correctedMachine := Machine{
-			ButtonA: machine.ButtonA,
-			ButtonB: machine.ButtonB,
-			Prize: Vector{
-				X: machine.Prize.X + PrizeOffset,
-				Y: machine.Prize.Y + PrizeOffset,
-			},
-		}
+		totalCost += part2Cost(machine)
+	}
+	return totalCost
+}
 
-		// Solve without press limit
-		solution := SolveMachineWithConstraints(correctedMachine, -1)
-		if solution.Valid {
-			totalCost += solution.Cost
-		}
+// part1Cost is the cost of winning the prize of a machine with at most 100 presses per button, 0 if impossible
+func part1Cost(machine Machine) int {
+	solution := SolveMachine(machine)
+	if !solution.Valid {
+		return 0
 	}
+	return solution.Cost
+}
 
-	return totalCost
+// part2Cost is the cost of winning the corrected prize of a machine without a press limit, 0 if impossible
+func part2Cost(machine Machine) int {
+	const PrizeOffset = 10000000000000
+
+	// Create a corrected machine with offset prize coordinates
+	correctedMachine := Machine{
+		ButtonA: machine.ButtonA,
+		ButtonB: machine.ButtonB,
+		Prize:   machine.Prize.Add(Vector{X: PrizeOffset, Y: PrizeOffset}),
+	}
+
+	// Solve without press limit
+	solution := SolveMachineWithConstraints(correctedMachine, -1)
+	if !solution.Valid {
+		return 0
+	}
+	return solution.Cost
+}
+
+// SolveReader sums the costs of both parts while streaming the machines from r
+// Memory use stays constant however many machines the input has
+func SolveReader(r io.Reader) (part1 int, part2 int, err error) {
+	err = EachMachine(r, func(machine Machine) {
+		part1 += part1Cost(machine)
+		part2 += part2Cost(machine)
+	})
+	return part1, part2, err
 }
@@ -1,11 +1,46 @@
 package day13
 
 import (
+	"io"
+	"math/rand/v2"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/heapstat"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
 )
 
+var puzzle = testkit.Puzzle[[]Machine]{
+	Day:   13,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		// 2 prizes won (machines 0 and 2), costing 280 + 200 = 480 tokens
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "480"},
+		// After adding 10000000000000 to the prize coordinates only machines 1 and 3 are solvable
+		{Name: "example", Input: ExampleInput, Part: 2, Want: "875318608908"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "button without numbers", Input: "Button A: X+a, Y+34"},
+		{Name: "prize without numbers", Input: "Prize: X=, Y=5400"},
+		{Name: "too large", Input: "Prize: X=99999999999999999999, Y=5400"},
+	},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 3600, Bytes: 280 << 10, PeakBytes: 384 << 10},
+		Part1: testkit.Budget{PeakBytes: 16 << 10}, // Does not allocate
+		Part2: testkit.Budget{PeakBytes: 16 << 10}, // Does not allocate
+	},
+}
+
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
+}
+
 func TestParse(t *testing.T) {
-	machines := Parse(ExampleInput)
+	machines := MustParse(ExampleInput)
 
 	if len(machines) != 4 {
 		t.Errorf("Expected 4 machines, got %d", len(machines))
@@ -104,27 +139,6 @@ func TestSolveMachine(t *testing.T) {
 	}
 }
 
-func TestPart1(t *testing.T) {
-	machines := Parse(ExampleInput)
-	result := Part1(machines)
-
-	// Expected: 2 prizes won (machines 0 and 2), costing 280 + 200 = 480 tokens
-	if result != 480 {
-		t.Errorf("Part1() = %d, want 480", result)
-	}
-}
-
-func TestPart2(t *testing.T) {
-	machines := Parse(ExampleInput)
-	result := Part2(machines)
-
-	// After adding 10000000000000 to prize coordinates,
-	// only machines 1 and 3 are solvable (according to problem description)
-	if result != 875318608908 {
-		t.Errorf("Part1() = %d, want 480", result)
-	}
-}
-
 func TestSolveMachineWithoutPressLimit(t *testing.T) {
 	// Test a machine that requires more than 100 presses
 	machine := Machine{
@@ -154,3 +168,161 @@ func TestSolveMachineWithoutPressLimit(t *testing.T) {
 		}
 	}
 }
+
+func TestParseReader(t *testing.T) {
+	// Windows line endings and extra blank lines between machines
+	input := "\r\n" + strings.ReplaceAll(ExampleInput, "\n\n", "\r\n\r\n\r\n") + "\n\n"
+
+	got, err := ParseReader(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseReader() error = %v", err)
+	}
+	if want := MustParse(ExampleInput); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseReader() = %v, want %v", got, want)
+	}
+}
+
+func TestSolveReader(t *testing.T) {
+	machines := MustParse(ExampleInput)
+	part1, part2, err := SolveReader(strings.NewReader(ExampleInput))
+	if err != nil {
+		t.Fatalf("SolveReader() error = %v", err)
+	}
+	if part1 != Part1(machines) || part2 != Part2(machines) {
+		t.Errorf("SolveReader() = %d, %d, want %d, %d", part1, part2, Part1(machines), Part2(machines))
+	}
+}
+
+// BenchmarkSolveReader compares the peak heap of parsing a large input from a string and streaming it from a reader
+// Run with -bench BenchmarkSolveReader -benchtime 1x to see the peak-heap-B metric; streaming keeps one machine at a time
+func BenchmarkSolveReader(b *testing.B) {
+	const copies = 20_000 // About 5 MB of input
+	block := ExampleInput + "\n"
+
+	b.Run("string", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			data, _ := io.ReadAll(peak.Reader(heapstat.Repeat(block+"\n", copies)))
+			machines := MustParse(string(data))
+			peak.Force()
+			Part1(machines)
+			Part2(machines)
+			peak.Report(b)
+		}
+	})
+
+	b.Run("reader", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			if _, _, err := SolveReader(peak.Reader(heapstat.Repeat(block+"\n", copies))); err != nil {
+				b.Fatal(err)
+			}
+			peak.Report(b)
+		}
+	})
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic,
+// and that parsing what String writes gives back the same machines
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, puzzle.RoundTrip(testkit.Joined[Machine]("\n\n")))
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
+
+func TestSolveMachineParallelButtons(t *testing.T) {
+	tests := []struct {
+		name      string
+		machine   Machine
+		maxPress  int
+		wantValid bool
+		wantA     int
+		wantB     int
+	}{
+		{"B is cheaper per step", Machine{Vector{X: 2, Y: 2}, Vector{X: 1, Y: 1}, Vector{X: 6, Y: 6}}, 100, true, 0, 6},
+		{"A moves far enough to pay off", Machine{Vector{X: 4, Y: 8}, Vector{X: 1, Y: 2}, Vector{X: 9, Y: 18}}, 100, true, 2, 1},
+		{"only a mix reaches the prize", Machine{Vector{X: 3, Y: 0}, Vector{X: 2, Y: 0}, Vector{X: 7, Y: 0}}, 100, true, 1, 2},
+		{"press limit forces A", Machine{Vector{X: 2, Y: 2}, Vector{X: 1, Y: 1}, Vector{X: 150, Y: 150}}, 100, true, 25, 100},
+		{"prize off the line", Machine{Vector{X: 1, Y: 1}, Vector{X: 2, Y: 2}, Vector{X: 4, Y: 5}}, 100, false, 0, 0},
+		{"steps never add up", Machine{Vector{X: 2, Y: 4}, Vector{X: 4, Y: 8}, Vector{X: 5, Y: 10}}, -1, false, 0, 0},
+		{"vertical buttons", Machine{Vector{X: 0, Y: 3}, Vector{X: 0, Y: 5}, Vector{X: 0, Y: 11}}, -1, true, 2, 1},
+		{"buttons that do nothing", Machine{Vector{}, Vector{}, Vector{X: 1, Y: 0}}, -1, false, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SolveMachineWithConstraints(tt.machine, tt.maxPress)
+			if got.Valid != tt.wantValid || got.APresses != tt.wantA || got.BPresses != tt.wantB {
+				t.Errorf("SolveMachineWithConstraints(%v, %d) = %+v, want valid %v with %d A and %d B presses",
+					tt.machine, tt.maxPress, got, tt.wantValid, tt.wantA, tt.wantB)
+			}
+		})
+	}
+}
+
+// referenceCost tries every number of presses of button A up to maxPresses, works out the presses of B
+// that cover the rest of the way, and returns the cheapest cost that reaches the prize, or -1 if none does
+func referenceCost(machine Machine, maxPresses int) int {
+	best := -1
+	for a := 0; a <= maxPresses; a++ {
+		rest := machine.Prize.Sub(machine.ButtonA.Scale(a))
+		b := 0
+		switch {
+		case machine.ButtonB.X != 0:
+			b = rest.X / machine.ButtonB.X
+		case machine.ButtonB.Y != 0:
+			b = rest.Y / machine.ButtonB.Y
+		}
+		if b < 0 || b > maxPresses || machine.ButtonB.Scale(b) != rest {
+			continue
+		}
+		if cost := a*ButtonACost + b*ButtonBCost; best < 0 || cost < best {
+			best = cost
+		}
+	}
+	return best
+}
+
+// randomMachine builds a small machine; a third of them have parallel buttons and most prizes are reachable
+func randomMachine(rng *rand.Rand) Machine {
+	machine := Machine{
+		ButtonA: Vector{X: rng.IntN(12), Y: rng.IntN(12)},
+		ButtonB: Vector{X: rng.IntN(12), Y: rng.IntN(12)},
+	}
+	if rng.IntN(3) == 0 {
+		machine.ButtonB = machine.ButtonA.Scale(1 + rng.IntN(3))
+		if rng.IntN(2) == 0 {
+			machine.ButtonA, machine.ButtonB = machine.ButtonB, machine.ButtonA
+		}
+	}
+	machine.Prize = machine.ButtonA.Scale(rng.IntN(120)).Add(machine.ButtonB.Scale(rng.IntN(120)))
+	if rng.IntN(4) == 0 {
+		machine.Prize = machine.Prize.Add(Vector{X: rng.IntN(3), Y: rng.IntN(3)})
+	}
+	return machine
+}
+
+// TestSolveMachineMatchesReference compares Cramer's rule with trying every number of presses
+func TestSolveMachineMatchesReference(t *testing.T) {
+	rng := rand.New(rand.NewPCG(13, 2024))
+	for range 5000 {
+		machine := randomMachine(rng)
+
+		want := referenceCost(machine, 100)
+		got := SolveMachine(machine)
+		if (want >= 0) != got.Valid || (got.Valid && got.Cost != want) {
+			t.Fatalf("SolveMachine(%v) = %+v, want cost %d (-1 for unsolvable)", machine, got, want)
+		}
+
+		// Without a limit a button that moves the claw can still be pressed at most as often as the
+		// larger prize coordinate, and pressing one that does not is never the cheapest
+		want = referenceCost(machine, max(machine.Prize.X, machine.Prize.Y))
+		got = SolveMachineWithConstraints(machine, -1)
+		if (want >= 0) != got.Valid || (got.Valid && got.Cost != want) {
+			t.Fatalf("SolveMachineWithConstraints(%v, -1) = %+v, want cost %d (-1 for unsolvable)", machine, got, want)
+		}
+	}
+}
@@ -1,9 +1,19 @@
 package day14
 
 import (
+	"fmt"
+	"image/color"
+	"io"
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/cycle"
+	"github.com/amoilanen/advent-of-code-2024/internal/interval"
+	"github.com/amoilanen/advent-of-code-2024/internal/render"
+	"github.com/amoilanen/advent-of-code-2024/internal/term"
+	"github.com/amoilanen/advent-of-code-2024/internal/utils"
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
 )
 
 const ExampleInput = `p=0,4 v=3,-3
@@ -19,11 +29,14 @@ p=7,3 v=-1,2
 p=2,4 v=2,-3
 p=9,5 v=-3,-3`
 
+// Width and Height are the dimensions of the real bathroom; the example uses 11 by 7
+const (
+	Width  = 101
+	Height = 103
+)
+
 // Vector represents a 2D coordinate or movement
-type Vector struct {
-	X int
-	Y int
-}
+type Vector = vector.Vec2
 
 // Robot represents a robot with position and velocity
 type Robot struct {
@@ -31,37 +44,67 @@ type Robot struct {
 	Velocity Vector
 }
 
+// Regular expression for parsing: p=x,y v=vx,vy
+var robotRegex = regexp.MustCompile(`^p=(-?\d+),(-?\d+) v=(-?\d+),(-?\d+)$`)
+
 // Parse converts the input string into a slice of Robot configurations
 // Input format: "p=x,y v=vx,vy" one per line
-func Parse(input string) []Robot {
-	var robots []Robot
+func Parse(input string) ([]Robot, error) {
+	return ParseReader(strings.NewReader(input))
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) []Robot {
+	robots, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return robots
+}
 
-	// Regular expression for parsing: p=x,y v=vx,vy
-	robotRegex := regexp.MustCompile(`p=(-?\d+),(-?\d+) v=(-?\d+),(-?\d+)`)
+// ParseReader reads all Robot configurations from r
+func ParseReader(r io.Reader) ([]Robot, error) {
+	var robots []Robot
+	err := EachRobot(r, func(robot Robot) {
+		robots = append(robots, robot)
+	})
+	return robots, err
+}
 
-	lines := strings.Split(strings.TrimSpace(input), "\n")
+// String returns the robot in the format of the puzzle input
+func (r Robot) String() string {
+	return fmt.Sprintf("p=%d,%d v=%d,%d", r.Position.X, r.Position.Y, r.Velocity.X, r.Velocity.Y)
+}
 
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
+// EachRobot calls fn with the robots of r one at a time
+// Blank lines are skipped; any other line that does not describe a robot is an error, as are
+// numbers that do not fit an int
+func EachRobot(r io.Reader, fn func(Robot)) error {
+	number := 0
+	return utils.ScanLines(r, func(line string) error {
+		number++
 		if line == "" {
-			continue
+			return nil
 		}
-
 		matches := robotRegex.FindStringSubmatch(line)
-		if len(matches) == 5 {
-			px, _ := strconv.Atoi(matches[1])
-			py, _ := strconv.Atoi(matches[2])
-			vx, _ := strconv.Atoi(matches[3])
-			vy, _ := strconv.Atoi(matches[4])
-
-			robots = append(robots, Robot{
-				Position: Vector{X: px, Y: py},
-				Velocity: Vector{X: vx, Y: vy},
-			})
+		if len(matches) != 5 {
+			return fmt.Errorf("line %d: want p=x,y v=vx,vy, got %q", number, line)
 		}
-	}
 
-	return robots
+		var values [4]int
+		for i := range values {
+			value, err := strconv.Atoi(matches[i+1])
+			if err != nil {
+				return fmt.Errorf("line %d: %w", number, err)
+			}
+			values[i] = value
+		}
+		fn(Robot{
+			Position: Vector{X: values[0], Y: values[1]},
+			Velocity: Vector{X: values[2], Y: values[3]},
+		})
+		return nil
+	})
 }
 
 // CalculatePosition calculates where a robot will be after N seconds
@@ -70,19 +113,8 @@ func Parse(input string) []Robot {
 // Time complexity: O(1)
 // Space complexity: O(1)
 func CalculatePosition(robot Robot, seconds int, width int, height int) Vector {
-	// Calculate new position with wrapping
-	newX := (robot.Position.X + robot.Velocity.X*seconds) % width
-	newY := (robot.Position.Y + robot.Velocity.Y*seconds) % height
-
-	// Handle negative modulo results (Go's % can return negative values)
-	if newX < 0 {
-		newX += width
-	}
-	if newY < 0 {
-		newY += height
-	}
-
-	return Vector{X: newX, Y: newY}
+	// Euclidean modulo wraps negative coordinates around to the far side
+	return robot.Position.Add(robot.Velocity.Scale(seconds)).Mod(Vector{X: width, Y: height})
 }
 
 // CountQuadrants counts how many robots are in each quadrant
@@ -91,29 +123,34 @@ func CalculatePosition(robot Robot, seconds int, width int, height int) Vector {
 // Time complexity: O(n) where n is number of positions
 // Space complexity: O(1)
 func CountQuadrants(positions []Vector, width int, height int) (int, int, int, int) {
-	midX := width / 2
-	midY := height / 2
-
-	var topLeft, topRight, bottomLeft, bottomRight int
-
+	var counts [4]int
 	for _, pos := range positions {
-		// Skip robots on the middle lines
-		if pos.X == midX || pos.Y == midY {
-			continue
+		if q := quadrant(pos, width, height); q >= 0 {
+			counts[q]++
 		}
+	}
+	return counts[0], counts[1], counts[2], counts[3]
+}
 
-		if pos.X < midX && pos.Y < midY {
-			topLeft++
-		} else if pos.X > midX && pos.Y < midY {
-			topRight++
-		} else if pos.X < midX && pos.Y > midY {
-			bottomLeft++
-		} else if pos.X > midX && pos.Y > midY {
-			bottomRight++
-		}
+// quadrant returns 0 for top-left, 1 for top-right, 2 for bottom-left and 3 for bottom-right,
+// or -1 for positions exactly on the middle lines
+func quadrant(pos Vector, width int, height int) int {
+	midX := width / 2
+	midY := height / 2
+
+	// Skip robots on the middle lines
+	if pos.X == midX || pos.Y == midY {
+		return -1
 	}
 
-	return topLeft, topRight, bottomLeft, bottomRight
+	q := 0
+	if pos.X > midX {
+		q++
+	}
+	if pos.Y > midY {
+		q += 2
+	}
+	return q
 }
 
 // Part1 calculates the safety factor after 100 seconds
@@ -143,73 +180,57 @@ func Part1(robots []Robot, width int, height int) int {
 	return safetyFactor
 }
 
-// sortInts performs a simple bubble sort on a slice of integers
-// Time complexity: O(n^2)
-// Space complexity: O(1)
-func sortInts(coords []int) {
-	for i := 0; i < len(coords)-1; i++ {
-		for j := i + 1; j < len(coords); j++ {
-			if coords[i] > coords[j] {
-				coords[i], coords[j] = coords[j], coords[i]
-			}
+// Part1Reader calculates the safety factor while streaming the robots from r
+// Only the four quadrant counts are kept, so memory use does not depend on the number of robots
+func Part1Reader(r io.Reader, width int, height int) (int, error) {
+	const seconds = 100
+
+	var counts [4]int
+	err := EachRobot(r, func(robot Robot) {
+		if q := quadrant(CalculatePosition(robot, seconds, width, height), width, height); q >= 0 {
+			counts[q]++
 		}
-	}
+	})
+	return counts[0] * counts[1] * counts[2] * counts[3], err
 }
 
-// countContiguousSequences counts how many contiguous sequences of at least minLength exist
-// in a sorted slice of coordinates
-// Time complexity: O(n)
-// Space complexity: O(1)
-func countContiguousSequences(sortedCoords []int, minLength int) int {
-	if len(sortedCoords) < minLength {
-		return 0
-	}
-
+// countContiguousSequences counts how many runs of at least minLength consecutive integers the set contains
+// Neighbouring coordinates are merged into a single interval on insertion, so every interval is one run
+// Time complexity: O(k) where k is the number of runs
+func countContiguousSequences(coords *interval.Set, minLength int) int {
 	foundSequences := 0
-	currentLength := 1
-
-	for i := 1; i < len(sortedCoords); i++ {
-		if sortedCoords[i] == sortedCoords[i-1]+1 {
-			currentLength++
-		} else {
-			if currentLength >= minLength {
-				foundSequences++
-			}
-			currentLength = 1
+	coords.Each(func(run interval.Interval) bool {
+		if run.Len() >= minLength {
+			foundSequences++
 		}
-	}
-
-	// Don't forget the last sequence
-	if currentLength >= minLength {
-		foundSequences++
-	}
-
+		return true
+	})
 	return foundSequences
 }
 
 // countLinesInDirection counts lines of at least minLength in a specific direction
 // groupBy extracts the coordinate to group by (Y for horizontal, X for vertical)
 // lineCoord extracts the coordinate along the line (X for horizontal, Y for vertical)
-// Time complexity: O(n log n) for sorting + O(n) for counting
+// Time complexity: O(n log n) for building the interval sets + O(n) for counting
 // Space complexity: O(n) for grouping
 func countLinesInDirection(positions []Vector, minLength int, groupBy func(Vector) int, lineCoord func(Vector) int) int {
-	// Group positions by the grouping coordinate
-	byGroup := make(map[int][]int)
+	// Group positions by the grouping coordinate, merging neighbours into runs
+	byGroup := make(map[int]*interval.Set)
 	for _, pos := range positions {
 		key := groupBy(pos)
-		value := lineCoord(pos)
-		byGroup[key] = append(byGroup[key], value)
+		if byGroup[key] == nil {
+			byGroup[key] = interval.NewSet()
+		}
+		byGroup[key].Insert(interval.New(lineCoord(pos), 1))
 	}
 
 	lineCount := 0
 
-	// For each group, sort coordinates and count contiguous sequences
+	// For each group, count the runs that are long enough
 	for _, coords := range byGroup {
-		if len(coords) < minLength {
+		if coords.MaxLen() < minLength {
 			continue
 		}
-
-		sortInts(coords)
 		lineCount += countContiguousSequences(coords, minLength)
 	}
 
@@ -270,17 +291,31 @@ func HasChristmasTreePattern(positions []Vector) bool {
 	return false
 }
 
+// Period returns the number of seconds after which every robot is back at its starting position
+// Each coordinate of each robot moves on its own cycle; Brent's cycle detection measures it
+// and the whole configuration repeats after the least common multiple of all cycle lengths.
+// Time complexity: O(n * (width + height)) where n is robot count
+func Period(robots []Robot, width int, height int) int {
+	period := 1
+	for _, robot := range robots {
+		xs := cycle.Brent(robot.Position.X, func(x int) int { return vector.Mod(x+robot.Velocity.X, width) })
+		ys := cycle.Brent(robot.Position.Y, func(y int) int { return vector.Mod(y+robot.Velocity.Y, height) })
+		period = utils.LCM(period, utils.LCM(xs.Length, ys.Length))
+	}
+	return period
+}
+
 // Part2 finds the minimum number of seconds for robots to display the Easter egg
 // Algorithm:
-// 1. Simulate robots over time (up to width * height iterations max)
+// 1. Simulate robots over time (up to one full period of the configuration)
 // 2. For each time step, check if positions form a Christmas tree pattern
 // 3. Return the first time when the pattern is detected
 //
 // Time complexity: O(T * n log n) where T is time to find pattern, n is robot count
 // Space complexity: O(n) for storing positions
 func Part2(robots []Robot, width int, height int) int {
-	// Maximum cycle length is width * height
-	maxIterations := width * height
+	// After one period the robots repeat, so later seconds show nothing new
+	maxIterations := Period(robots, width, height)
 
 	for seconds := 1; seconds < maxIterations; seconds++ {
 		// Calculate positions at this time
@@ -299,3 +334,98 @@ func Part2(robots []Robot, width int, height int) int {
 	// Pattern not found
 	return -1
 }
+
+// Render draws the robots at the given positions as green cells on a dark floor
+func Render(positions []Vector, width int, height int, scale int) *render.Canvas {
+	canvas := render.NewCanvas(width, height, scale)
+	canvas.Highlight(positions, color.RGBA{R: 40, G: 200, B: 80, A: 255})
+	return canvas
+}
+
+// Animate records the robots once per second, from second 0 up to and including the given second
+// It reports whether every second was recorded rather than stopping because rec was done.
+func Animate(robots []Robot, width int, height int, seconds int, scale int, rec render.Recorder) bool {
+	for second := 0; second <= seconds; second++ {
+		if rec.Done() {
+			return false
+		}
+		rec.Record(func() *render.Canvas {
+			positions := make([]Vector, len(robots))
+			for i, robot := range robots {
+				positions[i] = CalculatePosition(robot, second, width, height)
+			}
+			return Render(positions, width, height, scale)
+		})
+	}
+	return !rec.Done()
+}
+
+// Palette colors the text frames of Bathroom for the terminal player
+var Palette = term.Palette{
+	'1': term.Green, '2': term.Green, '3': term.Green, '4': term.Green, '5': term.Green,
+	'6': term.Green, '7': term.Green, '8': term.Green, '9': term.Green,
+	'+': term.Bold + ";" + term.Green,
+}
+
+// Bathroom shows the robots second by second for the terminal player
+// It runs for one full period, after which the robots repeat their positions
+type Bathroom struct {
+	robots []Robot
+	width  int
+	height int
+	period int
+	second int
+}
+
+// NewBathroom starts with the robots at their initial positions
+func NewBathroom(robots []Robot, width int, height int) *Bathroom {
+	return &Bathroom{robots: robots, width: width, height: height, period: Period(robots, width, height)}
+}
+
+// Step advances one second and reports false once a full period has passed
+func (b *Bathroom) Step() bool {
+	if b.second+1 >= b.period {
+		return false
+	}
+	b.second++
+	return true
+}
+
+// Seek jumps to the given second; positions are computed directly, so any second is cheap
+func (b *Bathroom) Seek(second int) {
+	b.second = second
+}
+
+// Frame draws the number of robots on every tile, with + for ten or more
+func (b *Bathroom) Frame() []string {
+	counts := make([][]int, b.height)
+	for y := range counts {
+		counts[y] = make([]int, b.width)
+	}
+	for _, robot := range b.robots {
+		pos := CalculatePosition(robot, b.second, b.width, b.height)
+		counts[pos.Y][pos.X]++
+	}
+
+	lines := make([]string, b.height)
+	row := make([]byte, b.width)
+	for y, rowCounts := range counts {
+		for x, count := range rowCounts {
+			switch {
+			case count == 0:
+				row[x] = '.'
+			case count < 10:
+				row[x] = byte('0' + count)
+			default:
+				row[x] = '+'
+			}
+		}
+		lines[y] = string(row)
+	}
+	return lines
+}
+
+// Status reports the current second
+func (b *Bathroom) Status() string {
+	return fmt.Sprintf("second %d of %d", b.second, b.period)
+}
@@ -1,11 +1,21 @@
 package day14
 
 import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/heapstat"
+	"github.com/amoilanen/advent-of-code-2024/internal/prop"
+	"github.com/amoilanen/advent-of-code-2024/internal/render"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
 )
 
 func TestParse(t *testing.T) {
-	robots := Parse(ExampleInput)
+	robots := MustParse(ExampleInput)
 
 	if len(robots) != 12 {
 		t.Errorf("Expected 12 robots, got %d", len(robots))
@@ -97,7 +107,7 @@ func TestCalculatePosition(t *testing.T) {
 func TestCountQuadrants(t *testing.T) {
 	// After 100 seconds in example, we should have:
 	// Top-left: 1, Top-right: 3, Bottom-left: 4, Bottom-right: 1
-	robots := Parse(ExampleInput)
+	robots := MustParse(ExampleInput)
 
 	// Calculate positions after 100 seconds
 	var positions []Vector
@@ -123,7 +133,7 @@ func TestCountQuadrants(t *testing.T) {
 }
 
 func TestPart1(t *testing.T) {
-	robots := Parse(ExampleInput)
+	robots := MustParse(ExampleInput)
 	result := Part1(robots, 11, 7)
 
 	// Expected safety factor: 1 * 3 * 4 * 1 = 12
@@ -272,3 +282,224 @@ func TestHasChristmasTreePattern(t *testing.T) {
 		t.Error("Expected HasChristmasTreePattern() = false for small pattern")
 	}
 }
+
+func TestPeriod(t *testing.T) {
+	tests := []struct {
+		name   string
+		robots []Robot
+		width  int
+		height int
+		want   int
+	}{
+		{"standing still", []Robot{{Position: Vector{X: 1, Y: 1}}}, 4, 7, 1},
+		{"shared factor", []Robot{{Velocity: Vector{X: 2, Y: 0}}}, 4, 7, 2},
+		{"both axes", []Robot{{Velocity: Vector{X: 1, Y: -1}}}, 4, 7, 28},
+		{"example", MustParse(ExampleInput), 11, 7, 77},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Period(tt.robots, tt.width, tt.height); got != tt.want {
+				t.Errorf("Period() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnimate(t *testing.T) {
+	robots := MustParse(ExampleInput)
+	recorder := render.NewGIFRecorder(render.GIFOptions{Stride: 2})
+	finished := Animate(robots, 11, 7, 10, 1, recorder)
+
+	// Seconds 0, 2, 4, 6, 8 and 10
+	if recorder.Frames() != 6 || !finished {
+		t.Errorf("Animate() recorded %d frames, finished = %v; want 6 frames and finished", recorder.Frames(), finished)
+	}
+
+	// Six frames fit exactly, three cut the animation short
+	for _, tt := range []struct {
+		maxFrames int
+		want      bool
+	}{{6, true}, {3, false}} {
+		limited := render.NewGIFRecorder(render.GIFOptions{Stride: 2, MaxFrames: tt.maxFrames})
+		if got := Animate(robots, 11, 7, 10, 1, limited); got != tt.want {
+			t.Errorf("Animate() with MaxFrames %d = %v, want %v", tt.maxFrames, got, tt.want)
+		}
+	}
+}
+
+func TestBathroom(t *testing.T) {
+	robots := MustParse(ExampleInput)
+	bathroom := NewBathroom(robots, 11, 7)
+
+	// Seconds 1 to 76, after which the robots are back where they started
+	steps := 0
+	for bathroom.Step() {
+		steps++
+	}
+	if steps != 76 {
+		t.Errorf("Step() succeeded %d times, want 76", steps)
+	}
+
+	bathroom.Seek(5)
+	if got, want := bathroom.Status(), "second 5 of 77"; got != want {
+		t.Errorf("Status() = %q, want %q", got, want)
+	}
+
+	// The example's positions after 100 seconds, which are the same as after 100 - 77 seconds
+	want := []string{
+		"......2..1.",
+		"...........",
+		"1..........",
+		".11........",
+		".....1.....",
+		"...12......",
+		".1....1....",
+	}
+	bathroom.Seek(100 - 77)
+	got := bathroom.Frame()
+	for y := range want {
+		if got[y] != want[y] {
+			t.Errorf("Frame()[%d] = %q, want %q", y, got[y], want[y])
+		}
+	}
+}
+
+func TestParseReader(t *testing.T) {
+	got, err := ParseReader(strings.NewReader(ExampleInput))
+	if err != nil {
+		t.Fatalf("ParseReader() error = %v", err)
+	}
+	if want := MustParse(ExampleInput); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseReader() = %v, want %v", got, want)
+	}
+}
+
+func TestPart1Reader(t *testing.T) {
+	got, err := Part1Reader(strings.NewReader(ExampleInput), 11, 7)
+	if err != nil {
+		t.Fatalf("Part1Reader() error = %v", err)
+	}
+	if got != 12 {
+		t.Errorf("Part1Reader() = %d, want 12", got)
+	}
+}
+
+// BenchmarkPart1Reader compares the peak heap of parsing a large input from a string and streaming it from a reader
+// Run with -bench BenchmarkPart1Reader -benchtime 1x to see the peak-heap-B metric; streaming keeps only the quadrant counts
+func BenchmarkPart1Reader(b *testing.B) {
+	const copies = 50_000 // About 8 MB of input
+	block := ExampleInput + "\n"
+
+	b.Run("string", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			data, _ := io.ReadAll(peak.Reader(heapstat.Repeat(block, copies)))
+			robots := MustParse(string(data))
+			peak.Force()
+			Part1(robots, Width, Height)
+			peak.Report(b)
+		}
+	})
+
+	b.Run("reader", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			peak := heapstat.Start(1)
+			if _, err := Part1Reader(peak.Reader(heapstat.Repeat(block, copies)), Width, Height); err != nil {
+				b.Fatal(err)
+			}
+			peak.Report(b)
+		}
+	})
+}
+
+// puzzle only declares the real input, as the example is a bathroom of 11 by 7 tiles; TestPart1 covers it
+var puzzle = testkit.Puzzle[[]Robot]{
+	Day:   14,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(func(robots []Robot) int { return Part1(robots, Width, Height) }),
+	Part2: testkit.Int(func(robots []Robot) int { return Part2(robots, Width, Height) }),
+	Malformed: []testkit.Malformed{
+		{Name: "too large position", Input: "p=99999999999999999999,4 v=3,-3"},
+		{Name: "too large velocity", Input: "p=0,4 v=3,-99999999999999999999"},
+		{Name: "no velocity", Input: "p=0,4 v=3,-3\np=1,2"},
+		{Name: "trailing junk", Input: "p=0,4 v=3,-3 p=1,2"},
+	},
+	Seeds: []string{ExampleInput},
+	// Part 2 rebuilds the positions of the robots every second
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 1900, Bytes: 192 << 10, PeakBytes: 256 << 10},
+		Part1: testkit.Budget{Allocs: 10, Bytes: 32 << 10, PeakBytes: 48 << 10},
+		Part2: testkit.Budget{Allocs: 12_500_000, Bytes: 1150 << 20, PeakBytes: 8 << 20},
+	},
+}
+
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic,
+// and that parsing what String writes gives back the same robots
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, puzzle.RoundTrip(testkit.Joined[Robot]("\n")))
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
+
+// motion is a robot in a bathroom of width by height tiles and the seconds it moves for
+type motion struct {
+	robot         Robot
+	seconds       int
+	width, height int
+}
+
+// motions generates robots anywhere in bathrooms of up to 120 by 120 tiles with fast velocities in any direction
+// They shrink towards a robot standing still in the corner of a bathroom of one tile.
+func motions() prop.Gen[motion] {
+	return prop.Gen[motion]{
+		Generate: func(rng *rand.Rand, size int) motion {
+			width, height := 1+rng.IntN(120), 1+rng.IntN(120)
+			return motion{
+				robot: Robot{
+					Position: Vector{X: rng.IntN(width), Y: rng.IntN(height)},
+					Velocity: Vector{X: rng.IntN(2001) - 1000, Y: rng.IntN(2001) - 1000},
+				},
+				seconds: rng.IntN(1000 * size),
+				width:   width,
+				height:  height,
+			}
+		},
+		Shrink: func(m motion) []motion {
+			var smaller []motion
+			for i, field := range m.fields() {
+				for _, value := range prop.ShrinkInt(*field, 0) {
+					candidate := m
+					*candidate.fields()[i] = value
+					if candidate.robot.Position.X < candidate.width && candidate.robot.Position.Y < candidate.height {
+						smaller = append(smaller, candidate)
+					}
+				}
+			}
+			return smaller
+		},
+	}
+}
+
+// fields returns the numbers that make up a motion, in the order they are shrunk
+func (m *motion) fields() []*int {
+	return []*int{&m.seconds, &m.robot.Velocity.X, &m.robot.Velocity.Y, &m.robot.Position.X, &m.robot.Position.Y, &m.width, &m.height}
+}
+
+func TestCalculatePositionStaysInBounds(t *testing.T) {
+	prop.Check(t, motions(), func(m motion) error {
+		pos := CalculatePosition(m.robot, m.seconds, m.width, m.height)
+		if pos.X < 0 || pos.X >= m.width || pos.Y < 0 || pos.Y >= m.height {
+			return fmt.Errorf("robot at %v after %d seconds, outside of %d by %d", pos, m.seconds, m.width, m.height)
+		}
+		return nil
+	})
+}
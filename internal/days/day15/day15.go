@@ -1,14 +1,18 @@
 package day15
 
 import (
+	"fmt"
+	"image/color"
 	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/render"
+	"github.com/amoilanen/advent-of-code-2024/internal/term"
+	"github.com/amoilanen/advent-of-code-2024/internal/utils"
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
 )
 
-// Position represents a coordinate in the warehouse
-type Position struct {
-	Row int
-	Col int
-}
+// Position represents a coordinate in the warehouse (X is the column, Y is the row)
+type Position = vector.Vec2
 
 // Warehouse represents the state of the warehouse
 type Warehouse struct {
@@ -19,62 +23,98 @@ type Warehouse struct {
 }
 
 // Parse converts the input string into a Warehouse and list of moves
-// Input format: grid map followed by blank line, then movement commands
+// Input format: grid map followed by blank line, then movement commands.
+// The map must be a rectangle of walls, floor and boxes enclosed by walls, with exactly one robot,
+// as the moves only stop at walls and never check the edge of the grid.
 // Time complexity: O(n) where n is input size
 // Space complexity: O(w*h) for grid storage
-func Parse(input string) (*Warehouse, []rune) {
+func Parse(input string) (*Warehouse, []rune, error) {
 	parts := strings.Split(strings.TrimSpace(input), "\n\n")
 	if len(parts) != 2 {
-		return nil, nil
+		return nil, nil, fmt.Errorf("want a map and moves separated by an empty line, got %d sections", len(parts))
 	}
 
-	gridLines := strings.Split(parts[0], "\n")
-	moveLines := strings.ReplaceAll(parts[1], "\n", "")
+	gridLines, err := utils.AsGrid(parts[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	moveLines := strings.Join(strings.Fields(parts[1]), "")
 
 	// Build grid and find robot
 	var grid [][]rune
-	var robot Position
-	robot.Row = -1
-	robot.Col = -1
+	robot := Position{X: -1, Y: -1}
+	height, width := len(gridLines), len(gridLines[0])
 
 	for row, line := range gridLines {
 		rowRunes := []rune(line)
 		grid = append(grid, rowRunes)
 
-		// Find robot position
 		for col, ch := range rowRunes {
-			if ch == '@' {
-				robot.Row = row
-				robot.Col = col
+			onBorder := row == 0 || row == height-1 || col == 0 || col == width-1
+			switch {
+			case onBorder && ch != '#':
+				return nil, nil, fmt.Errorf("line %d, column %d: the border must be walls, got %q", row+1, col+1, ch)
+			case ch == '@' && robot.X >= 0:
+				return nil, nil, fmt.Errorf("line %d, column %d: second robot", row+1, col+1)
+			case ch == '@':
+				robot = Position{X: col, Y: row}
+			case ch != '#' && ch != '.' && ch != 'O':
+				return nil, nil, fmt.Errorf("line %d, column %d: unexpected %q", row+1, col+1, ch)
 			}
 		}
 	}
+	if robot.X < 0 {
+		return nil, nil, fmt.Errorf("the map has no robot")
+	}
 
 	warehouse := &Warehouse{
 		Grid:   grid,
-		Width:  len(grid[0]),
-		Height: len(grid),
+		Width:  width,
+		Height: height,
 		Robot:  robot,
 	}
 
 	moves := []rune(moveLines)
+	for i, move := range moves {
+		if !strings.ContainsRune("^v<>", move) {
+			return nil, nil, fmt.Errorf("move %d: unexpected %q", i+1, move)
+		}
+	}
 
+	return warehouse, moves, nil
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) (*Warehouse, []rune) {
+	warehouse, moves, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
 	return warehouse, moves
 }
 
+// String returns the map of the warehouse in the format of the puzzle input
+func (w *Warehouse) String() string {
+	lines := make([]string, len(w.Grid))
+	for i, row := range w.Grid {
+		lines[i] = string(row)
+	}
+	return strings.Join(lines, "\n")
+}
+
 // GetDirection converts a move character to a direction vector
 func GetDirection(move rune) Position {
 	switch move {
 	case '^':
-		return Position{-1, 0}
+		return vector.Up
 	case 'v':
-		return Position{1, 0}
+		return vector.Down
 	case '<':
-		return Position{0, -1}
+		return vector.Left
 	case '>':
-		return Position{0, 1}
+		return vector.Right
 	default:
-		return Position{0, 0}
+		return Position{}
 	}
 }
 
@@ -91,8 +131,8 @@ func (w *Warehouse) findPushTarget(startRow, startCol int, dir Position) (int, i
 	col := startCol
 
 	for {
-		row += dir.Row
-		col += dir.Col
+		row += dir.Y
+		col += dir.X
 
 		if !w.isInBounds(row, col) {
 			return 0, 0, 0 // Out of bounds
@@ -109,10 +149,10 @@ func (w *Warehouse) findPushTarget(startRow, startCol int, dir Position) (int, i
 
 // moveRobot updates the robot position on the grid
 func (w *Warehouse) moveRobot(newRow, newCol int) {
-	w.Grid[w.Robot.Row][w.Robot.Col] = '.'
+	w.Grid[w.Robot.Y][w.Robot.X] = '.'
 	w.Grid[newRow][newCol] = '@'
-	w.Robot.Row = newRow
-	w.Robot.Col = newCol
+	w.Robot.Y = newRow
+	w.Robot.X = newCol
 }
 
 // SimulateMove simulates a single robot move
@@ -126,12 +166,12 @@ func (w *Warehouse) moveRobot(newRow, newCol int) {
 // Space complexity: O(1)
 func (w *Warehouse) SimulateMove(move rune) {
 	dir := GetDirection(move)
-	if dir.Row == 0 && dir.Col == 0 {
+	if dir.Y == 0 && dir.X == 0 {
 		return // Invalid move
 	}
 
-	nextRow := w.Robot.Row + dir.Row
-	nextCol := w.Robot.Col + dir.Col
+	nextRow := w.Robot.Y + dir.Y
+	nextCol := w.Robot.X + dir.X
 
 	if !w.isInBounds(nextRow, nextCol) {
 		return
@@ -211,7 +251,7 @@ func ScaleWarehouse(w *Warehouse) *Warehouse {
 				newRow = append(newRow, '.', '.')
 			case '@':
 				newRow = append(newRow, '@', '.')
-				newRobot = Position{Row: row, Col: col * 2}
+				newRobot = Position{X: col * 2, Y: row}
 			}
 		}
 		newGrid[row] = newRow
@@ -234,13 +274,13 @@ func (w *Warehouse) getBoxesToMoveVertically(boxLeftCol, boxRow int, dir Positio
 	var collect func(leftCol, row int) bool
 	collect = func(leftCol, row int) bool {
 		// Record this box position (by its left bracket)
-		boxPos := Position{Row: row, Col: leftCol}
+		boxPos := Position{X: leftCol, Y: row}
 		if boxes[boxPos] {
 			return true // Already processed this box
 		}
 		boxes[boxPos] = true
 
-		newRow := row + dir.Row
+		newRow := row + dir.Y
 
 		// Check what's in the two cells where this box would move
 		leftCell := w.Grid[newRow][leftCol]
@@ -297,11 +337,11 @@ func (w *Warehouse) moveBoxesVertically(boxes map[Position]bool, dir Position) {
 	}
 
 	// Sort by row: if moving up, process top boxes first; if moving down, process bottom first
-	if dir.Row < 0 {
+	if dir.Y < 0 {
 		// Moving up - sort ascending (top first)
 		for i := 0; i < len(boxList); i++ {
 			for j := i + 1; j < len(boxList); j++ {
-				if boxList[j].Row < boxList[i].Row {
+				if boxList[j].Y < boxList[i].Y {
 					boxList[i], boxList[j] = boxList[j], boxList[i]
 				}
 			}
@@ -310,7 +350,7 @@ func (w *Warehouse) moveBoxesVertically(boxes map[Position]bool, dir Position) {
 		// Moving down - sort descending (bottom first)
 		for i := 0; i < len(boxList); i++ {
 			for j := i + 1; j < len(boxList); j++ {
-				if boxList[j].Row > boxList[i].Row {
+				if boxList[j].Y > boxList[i].Y {
 					boxList[i], boxList[j] = boxList[j], boxList[i]
 				}
 			}
@@ -319,25 +359,25 @@ func (w *Warehouse) moveBoxesVertically(boxes map[Position]bool, dir Position) {
 
 	// Move each box
 	for _, pos := range boxList {
-		newRow := pos.Row + dir.Row
+		newRow := pos.Y + dir.Y
 		// Clear old position
-		w.Grid[pos.Row][pos.Col] = '.'
-		w.Grid[pos.Row][pos.Col+1] = '.'
+		w.Grid[pos.Y][pos.X] = '.'
+		w.Grid[pos.Y][pos.X+1] = '.'
 		// Set new position
-		w.Grid[newRow][pos.Col] = '['
-		w.Grid[newRow][pos.Col+1] = ']'
+		w.Grid[newRow][pos.X] = '['
+		w.Grid[newRow][pos.X+1] = ']'
 	}
 }
 
 // SimulateMoveWide simulates a move in the scaled warehouse with wide boxes
 func (w *Warehouse) SimulateMoveWide(move rune) {
 	dir := GetDirection(move)
-	if dir.Row == 0 && dir.Col == 0 {
+	if dir.Y == 0 && dir.X == 0 {
 		return
 	}
 
-	nextRow := w.Robot.Row + dir.Row
-	nextCol := w.Robot.Col + dir.Col
+	nextRow := w.Robot.Y + dir.Y
+	nextCol := w.Robot.X + dir.X
 
 	if !w.isInBounds(nextRow, nextCol) {
 		return
@@ -359,13 +399,13 @@ func (w *Warehouse) SimulateMoveWide(move rune) {
 	// Box in the way
 	if nextCell == '[' || nextCell == ']' {
 		// Horizontal movement - shift all boxes in a line
-		if dir.Col != 0 {
+		if dir.X != 0 {
 			targetRow, targetCol, targetCell := w.findPushTarget(nextRow, nextCol, dir)
 			if targetCell == '.' {
 				// Shift all characters from target back to robot
 				for targetCol != nextCol || targetRow != nextRow {
-					prevCol := targetCol - dir.Col
-					prevRow := targetRow - dir.Row
+					prevCol := targetCol - dir.X
+					prevRow := targetRow - dir.Y
 					w.Grid[targetRow][targetCol] = w.Grid[prevRow][prevCol]
 					targetCol = prevCol
 					targetRow = prevRow
@@ -414,10 +454,10 @@ func (w *Warehouse) SumWideBoxGPS() int {
 // Time complexity: O(M * N) where M = number of moves, N = grid size
 // Space complexity: O(N) for grid storage
 func Part1(input string) int {
-	warehouse, moves := Parse(input)
+	warehouse, moves, err := Parse(input)
 
-	// Handle empty input
-	if warehouse == nil {
+	// Handle empty or malformed input
+	if err != nil {
 		return 0
 	}
 
@@ -432,10 +472,10 @@ func Part1(input string) int {
 
 // Part2 simulates robot moves in scaled warehouse with wide boxes
 func Part2(input string) int {
-	warehouse, moves := Parse(input)
+	warehouse, moves, err := Parse(input)
 
-	// Handle empty input
-	if warehouse == nil {
+	// Handle empty or malformed input
+	if err != nil {
 		return 0
 	}
 
@@ -450,3 +490,112 @@ func Part2(input string) int {
 	// Calculate sum of GPS coordinates for wide boxes
 	return warehouse.SumWideBoxGPS()
 }
+
+// palette colors walls, boxes (both narrow and wide) and the robot; floor stays dark
+var palette = render.Palette[rune]{
+	Colors: map[rune]color.Color{
+		'#': color.RGBA{R: 110, G: 110, B: 120, A: 255},
+		'O': color.RGBA{R: 190, G: 130, B: 60, A: 255},
+		'[': color.RGBA{R: 190, G: 130, B: 60, A: 255},
+		']': color.RGBA{R: 160, G: 105, B: 45, A: 255},
+		'@': color.RGBA{R: 60, G: 200, B: 90, A: 255},
+	},
+	Default: color.RGBA{R: 24, G: 24, B: 32, A: 255},
+}
+
+// Render draws the current state of the warehouse
+// Each cell becomes a scale x scale square of pixels
+func (w *Warehouse) Render(scale int) *render.Canvas {
+	return render.Grid(w.Width, w.Height, scale, func(x, y int) rune {
+		return w.Grid[y][x]
+	}, palette)
+}
+
+// Animate records the warehouse before the first move and after every move
+// With wide set the warehouse is scaled first and moves follow the part 2 rules
+// It reports whether every move was recorded rather than stopping because rec was done;
+// malformed input records nothing.
+func Animate(input string, wide bool, scale int, rec render.Recorder) bool {
+	warehouse, moves, err := Parse(input)
+	if err != nil {
+		return true
+	}
+	if wide {
+		warehouse = ScaleWarehouse(warehouse)
+	}
+
+	draw := func() *render.Canvas { return warehouse.Render(scale) }
+	rec.Record(draw)
+	for _, move := range moves {
+		if rec.Done() {
+			return false
+		}
+		if wide {
+			warehouse.SimulateMoveWide(move)
+		} else {
+			warehouse.SimulateMove(move)
+		}
+		rec.Record(draw)
+	}
+	return !rec.Done()
+}
+
+// Palette colors the text frames of Robot for the terminal player
+var Palette = term.Palette{
+	'#': term.Gray,
+	'O': term.Yellow,
+	'[': term.Yellow,
+	']': term.Yellow,
+	'@': term.Bold + ";" + term.Green,
+}
+
+// Robot replays the robot's moves one at a time for the terminal player
+type Robot struct {
+	warehouse *Warehouse
+	moves     []rune
+	wide      bool
+	next      int
+}
+
+// NewRobot parses the input and prepares to replay its moves
+// With wide set the warehouse is scaled first and moves follow the part 2 rules
+func NewRobot(input string, wide bool) *Robot {
+	warehouse, moves, err := Parse(input)
+	if err != nil {
+		warehouse = &Warehouse{}
+	} else if wide {
+		warehouse = ScaleWarehouse(warehouse)
+	}
+	return &Robot{warehouse: warehouse, moves: moves, wide: wide}
+}
+
+// Step performs the next move and reports false once all moves are done
+func (r *Robot) Step() bool {
+	if r.next >= len(r.moves) {
+		return false
+	}
+	if r.wide {
+		r.warehouse.SimulateMoveWide(r.moves[r.next])
+	} else {
+		r.warehouse.SimulateMove(r.moves[r.next])
+	}
+	r.next++
+	return true
+}
+
+// Frame draws the warehouse as in the puzzle input
+func (r *Robot) Frame() []string {
+	lines := make([]string, len(r.warehouse.Grid))
+	for i, row := range r.warehouse.Grid {
+		lines[i] = string(row)
+	}
+	return lines
+}
+
+// Status shows how many moves are done and which one comes next
+func (r *Robot) Status() string {
+	if r.next < len(r.moves) {
+		return fmt.Sprintf("move %d of %d, next %c", r.next, len(r.moves), r.moves[r.next])
+	}
+	return fmt.Sprintf("move %d of %d", r.next, len(r.moves))
+}
@@ -1,7 +1,16 @@
 package day15
 
 import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/prop"
+	"github.com/amoilanen/advent-of-code-2024/internal/render"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
 )
 
 const SmallExample = `########
@@ -53,7 +62,7 @@ func TestParse(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			warehouse, moves := Parse(tt.input)
+			warehouse, moves := MustParse(tt.input)
 
 			// Check warehouse dimensions
 			if warehouse.Height != tt.wantHeight {
@@ -64,9 +73,9 @@ func TestParse(t *testing.T) {
 			}
 
 			// Check robot position
-			if warehouse.Robot.Row != tt.wantRobotRow || warehouse.Robot.Col != tt.wantRobotCol {
+			if warehouse.Robot.Y != tt.wantRobotRow || warehouse.Robot.X != tt.wantRobotCol {
 				t.Errorf("expected robot at (%d, %d), got (%d, %d)",
-					tt.wantRobotRow, tt.wantRobotCol, warehouse.Robot.Row, warehouse.Robot.Col)
+					tt.wantRobotRow, tt.wantRobotCol, warehouse.Robot.Y, warehouse.Robot.X)
 			}
 
 			// Check moves count
@@ -78,7 +87,7 @@ func TestParse(t *testing.T) {
 }
 
 func TestParse_SmallExample(t *testing.T) {
-	warehouse, moves := Parse(SmallExample)
+	warehouse, moves := MustParse(SmallExample)
 
 	// Check dimensions
 	if warehouse.Height != 8 {
@@ -89,8 +98,8 @@ func TestParse_SmallExample(t *testing.T) {
 	}
 
 	// Check robot initial position (row 2, col 2)
-	if warehouse.Robot.Row != 2 || warehouse.Robot.Col != 2 {
-		t.Errorf("expected robot at (2, 2), got (%d, %d)", warehouse.Robot.Row, warehouse.Robot.Col)
+	if warehouse.Robot.Y != 2 || warehouse.Robot.X != 2 {
+		t.Errorf("expected robot at (2, 2), got (%d, %d)", warehouse.Robot.Y, warehouse.Robot.X)
 	}
 
 	// Check moves
@@ -107,12 +116,12 @@ func TestSimulateMove_NoObstacle(t *testing.T) {
 
 >`
 
-	warehouse, moves := Parse(input)
+	warehouse, moves := MustParse(input)
 	warehouse.SimulateMove(moves[0])
 
 	// Robot should move from (1,1) to (1,2)
-	if warehouse.Robot.Row != 1 || warehouse.Robot.Col != 2 {
-		t.Errorf("expected robot at (1, 2), got (%d, %d)", warehouse.Robot.Row, warehouse.Robot.Col)
+	if warehouse.Robot.Y != 1 || warehouse.Robot.X != 2 {
+		t.Errorf("expected robot at (1, 2), got (%d, %d)", warehouse.Robot.Y, warehouse.Robot.X)
 	}
 }
 
@@ -123,12 +132,12 @@ func TestSimulateMove_Wall(t *testing.T) {
 
 <`
 
-	warehouse, moves := Parse(input)
+	warehouse, moves := MustParse(input)
 	warehouse.SimulateMove(moves[0])
 
 	// Robot should not move (wall to the left)
-	if warehouse.Robot.Row != 1 || warehouse.Robot.Col != 1 {
-		t.Errorf("expected robot to stay at (1, 1), got (%d, %d)", warehouse.Robot.Row, warehouse.Robot.Col)
+	if warehouse.Robot.Y != 1 || warehouse.Robot.X != 1 {
+		t.Errorf("expected robot to stay at (1, 1), got (%d, %d)", warehouse.Robot.Y, warehouse.Robot.X)
 	}
 }
 
@@ -139,12 +148,12 @@ func TestSimulateMove_PushOneBox(t *testing.T) {
 
 >`
 
-	warehouse, moves := Parse(input)
+	warehouse, moves := MustParse(input)
 	warehouse.SimulateMove(moves[0])
 
 	// Robot should move to (1,2), box should move to (1,3)
-	if warehouse.Robot.Row != 1 || warehouse.Robot.Col != 2 {
-		t.Errorf("expected robot at (1, 2), got (%d, %d)", warehouse.Robot.Row, warehouse.Robot.Col)
+	if warehouse.Robot.Y != 1 || warehouse.Robot.X != 2 {
+		t.Errorf("expected robot at (1, 2), got (%d, %d)", warehouse.Robot.Y, warehouse.Robot.X)
 	}
 
 	// Check box moved
@@ -163,12 +172,12 @@ func TestSimulateMove_PushMultipleBoxes(t *testing.T) {
 
 >`
 
-	warehouse, moves := Parse(input)
+	warehouse, moves := MustParse(input)
 	warehouse.SimulateMove(moves[0])
 
 	// Robot should move to (1,2), boxes should shift right
-	if warehouse.Robot.Row != 1 || warehouse.Robot.Col != 2 {
-		t.Errorf("expected robot at (1, 2), got (%d, %d)", warehouse.Robot.Row, warehouse.Robot.Col)
+	if warehouse.Robot.Y != 1 || warehouse.Robot.X != 2 {
+		t.Errorf("expected robot at (1, 2), got (%d, %d)", warehouse.Robot.Y, warehouse.Robot.X)
 	}
 
 	// Check boxes moved
@@ -184,12 +193,12 @@ func TestSimulateMove_PushBoxWall(t *testing.T) {
 
 <<<`
 
-	warehouse, moves := Parse(input)
+	warehouse, moves := MustParse(input)
 
 	// First move left - should not move (wall)
 	warehouse.SimulateMove(moves[0])
-	if warehouse.Robot.Row != 1 || warehouse.Robot.Col != 1 {
-		t.Errorf("expected robot to stay at (1, 1), got (%d, %d)", warehouse.Robot.Row, warehouse.Robot.Col)
+	if warehouse.Robot.Y != 1 || warehouse.Robot.X != 1 {
+		t.Errorf("expected robot to stay at (1, 1), got (%d, %d)", warehouse.Robot.Y, warehouse.Robot.X)
 	}
 }
 
@@ -216,22 +225,6 @@ func TestCalculateGPS(t *testing.T) {
 	}
 }
 
-func TestPart1_SmallExample(t *testing.T) {
-	result := Part1(SmallExample)
-	expected := 2028
-	if result != expected {
-		t.Errorf("expected %d, got %d", expected, result)
-	}
-}
-
-func TestPart1_LargeExample(t *testing.T) {
-	result := Part1(LargeExample)
-	expected := 10092
-	if result != expected {
-		t.Errorf("expected %d, got %d", expected, result)
-	}
-}
-
 const SmallExamplePart2 = `#######
 #...#.#
 #.....#
@@ -243,7 +236,7 @@ const SmallExamplePart2 = `#######
 <vv<<^^<<^^`
 
 func TestScaleWarehouse(t *testing.T) {
-	warehouse, _ := Parse(SmallExample)
+	warehouse, _ := MustParse(SmallExample)
 	scaled := ScaleWarehouse(warehouse)
 
 	// Check dimensions
@@ -255,9 +248,9 @@ func TestScaleWarehouse(t *testing.T) {
 	}
 
 	// Check robot position is scaled correctly
-	expectedRobotCol := warehouse.Robot.Col * 2
-	if scaled.Robot.Col != expectedRobotCol {
-		t.Errorf("expected robot col %d, got %d", expectedRobotCol, scaled.Robot.Col)
+	expectedRobotCol := warehouse.Robot.X * 2
+	if scaled.Robot.X != expectedRobotCol {
+		t.Errorf("expected robot col %d, got %d", expectedRobotCol, scaled.Robot.X)
 	}
 
 	// Check that a wall is doubled
@@ -281,20 +274,20 @@ func TestScaleWarehouse(t *testing.T) {
 }
 
 func TestSimulateMoveWide_HorizontalPush(t *testing.T) {
-	input := `####
+	input := `#####
 #@O.#
-####
+#####
 
 >`
 
-	warehouse, moves := Parse(input)
+	warehouse, moves := MustParse(input)
 	warehouse = ScaleWarehouse(warehouse)
 	warehouse.SimulateMoveWide(moves[0])
 
 	// After scaling: ##########  Robot @ (1,2), Box at (1,4-5), moves right
 	// After move: Robot should be at (1,3), box at (1,4-5)
-	if warehouse.Robot.Row != 1 || warehouse.Robot.Col != 3 {
-		t.Errorf("expected robot at (1, 3), got (%d, %d)", warehouse.Robot.Row, warehouse.Robot.Col)
+	if warehouse.Robot.Y != 1 || warehouse.Robot.X != 3 {
+		t.Errorf("expected robot at (1, 3), got (%d, %d)", warehouse.Robot.Y, warehouse.Robot.X)
 	}
 
 	// Check box moved
@@ -313,14 +306,14 @@ func TestSimulateMoveWide_VerticalPushAligned(t *testing.T) {
 
 ^`
 
-	warehouse, moves := Parse(input)
+	warehouse, moves := MustParse(input)
 	warehouse = ScaleWarehouse(warehouse)
 	warehouse.SimulateMoveWide(moves[0])
 
 	// After scaling robot is at (4,4), boxes at (2,4-5) and (3,4-5)
 	// After move up: robot at (3,4), boxes at (1,4-5) and (2,4-5)
-	if warehouse.Robot.Row != 3 || warehouse.Robot.Col != 4 {
-		t.Errorf("expected robot at (3, 4), got (%d, %d)", warehouse.Robot.Row, warehouse.Robot.Col)
+	if warehouse.Robot.Y != 3 || warehouse.Robot.X != 4 {
+		t.Errorf("expected robot at (3, 4), got (%d, %d)", warehouse.Robot.Y, warehouse.Robot.X)
 	}
 
 	// Boxes should move up
@@ -346,13 +339,13 @@ func TestSimulateMoveWide_VerticalPushOffset(t *testing.T) {
 
 ^`
 
-	warehouse, moves := Parse(input)
+	warehouse, moves := MustParse(input)
 	warehouse = ScaleWarehouse(warehouse)
 	warehouse.SimulateMoveWide(moves[0])
 
 	// Robot should move up from (4,2) to (3,2)
-	if warehouse.Robot.Row != 3 || warehouse.Robot.Col != 2 {
-		t.Errorf("expected robot at (3, 2), got (%d, %d)", warehouse.Robot.Row, warehouse.Robot.Col)
+	if warehouse.Robot.Y != 3 || warehouse.Robot.X != 2 {
+		t.Errorf("expected robot at (3, 2), got (%d, %d)", warehouse.Robot.Y, warehouse.Robot.X)
 	}
 
 	// Bottom box should move up from (3,2-3) to (2,2-3)
@@ -375,23 +368,314 @@ func TestSimulateMoveWide_VerticalBlocked(t *testing.T) {
 
 ^`
 
-	warehouse, moves := Parse(input)
+	warehouse, moves := MustParse(input)
 	warehouse = ScaleWarehouse(warehouse)
 	initialRobot := warehouse.Robot
 
 	warehouse.SimulateMoveWide(moves[0])
 
 	// Robot should not move (blocked by wall above box)
-	if warehouse.Robot.Row != initialRobot.Row || warehouse.Robot.Col != initialRobot.Col {
+	if warehouse.Robot.Y != initialRobot.Y || warehouse.Robot.X != initialRobot.X {
 		t.Errorf("expected robot to stay at (%d, %d), got (%d, %d)",
-			initialRobot.Row, initialRobot.Col, warehouse.Robot.Row, warehouse.Robot.Col)
+			initialRobot.Y, initialRobot.X, warehouse.Robot.Y, warehouse.Robot.X)
 	}
 }
 
-func TestPart2_LargeExample(t *testing.T) {
-	result := Part2(LargeExample)
-	expected := 9021
-	if result != expected {
-		t.Errorf("expected %d, got %d", expected, result)
+func TestRender(t *testing.T) {
+	warehouse, _ := MustParse(SmallExample)
+	img := warehouse.Render(3).Image()
+
+	if size := img.Bounds().Size(); size.X != 8*3 || size.Y != 8*3 {
+		t.Fatalf("Render() size = %v; want 24x24", size)
+	}
+
+	wall := img.RGBAAt(0, 0)
+	box := img.RGBAAt(3*3+1, 1*3+1)
+	robot := img.RGBAAt(2*3+1, 2*3+1)
+	floor := img.RGBAAt(1*3+1, 1*3+1)
+	if wall == box || box == robot || robot == floor || floor == wall {
+		t.Errorf("Render() cells are not told apart: wall %v, box %v, robot %v, floor %v", wall, box, robot, floor)
 	}
 }
+
+func TestAnimate(t *testing.T) {
+	_, moves := MustParse(SmallExample)
+
+	tests := []struct {
+		name string
+		wide bool
+	}{
+		{"narrow", false},
+		{"wide", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := render.NewGIFRecorder(render.GIFOptions{})
+			if !Animate(SmallExample, tt.wide, 1, recorder) {
+				t.Error("Animate() = false without a frame limit")
+			}
+			if recorder.Frames() != len(moves)+1 {
+				t.Errorf("Animate() recorded %d frames; want %d", recorder.Frames(), len(moves)+1)
+			}
+
+			exact := render.NewGIFRecorder(render.GIFOptions{MaxFrames: len(moves) + 1})
+			if !Animate(SmallExample, tt.wide, 1, exact) {
+				t.Error("Animate() = false although every move fit into MaxFrames")
+			}
+			short := render.NewGIFRecorder(render.GIFOptions{MaxFrames: len(moves)})
+			if Animate(SmallExample, tt.wide, 1, short) {
+				t.Error("Animate() = true although MaxFrames cut off the last move")
+			}
+		})
+	}
+}
+
+func TestRobot(t *testing.T) {
+	_, moves := MustParse(LargeExample)
+
+	tests := []struct {
+		name  string
+		wide  bool
+		width int
+		gps   int
+	}{
+		{"narrow", false, 10, 10092},
+		{"wide", true, 20, 9021},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			robot := NewRobot(LargeExample, tt.wide)
+			if got := len(robot.Frame()[0]); got != tt.width {
+				t.Errorf("Frame() width = %d, want %d", got, tt.width)
+			}
+
+			steps := 0
+			for robot.Step() {
+				steps++
+			}
+			if steps != len(moves) {
+				t.Errorf("Step() succeeded %d times, want %d", steps, len(moves))
+			}
+
+			frame := robot.Frame()
+			warehouse := &Warehouse{Width: len(frame[0]), Height: len(frame)}
+			for _, line := range frame {
+				warehouse.Grid = append(warehouse.Grid, []rune(line))
+			}
+			got := warehouse.SumBoxGPS()
+			if tt.wide {
+				got = warehouse.SumWideBoxGPS()
+			}
+			if got != tt.gps {
+				t.Errorf("GPS of the last frame = %d, want %d", got, tt.gps)
+			}
+		})
+	}
+}
+
+// puzzle keeps the input as text, as the parts take the raw input; Parse only checks that it is well formed
+var puzzle = testkit.Puzzle[string]{
+	Day:   15,
+	Input: Input,
+	Parse: func(input string) (string, error) {
+		_, _, err := Parse(input)
+		return input, err
+	},
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "small example", Input: SmallExample, Part: 1, Want: "2028"},
+		{Name: "large example", Input: LargeExample, Part: 1, Want: "10092"},
+		{Name: "large example", Input: LargeExample, Part: 2, Want: "9021"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "map without moves", Input: "####\n#@.#\n####"},
+		{Name: "ragged map", Input: "####\n#@.#\n###\n\n<"},
+		{Name: "no robot", Input: "####\n#..#\n####\n\n<"},
+		{Name: "two robots", Input: "#####\n#@.@#\n#####\n\n<"},
+		{Name: "open border", Input: "####\n.@.#\n####\n\n<"},
+		{Name: "unexpected cell", Input: "#####\n#@.X#\n#####\n\n<"},
+		{Name: "unexpected move", Input: "####\n#@.#\n####\n\n<x>"},
+	},
+	Seeds: []string{SmallExamplePart2},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 80, Bytes: 180 << 10, PeakBytes: 256 << 10},
+		Part1: testkit.Budget{Allocs: 80, Bytes: 180 << 10, PeakBytes: 256 << 10},
+		Part2: testkit.Budget{Allocs: 5500, Bytes: 912 << 10, PeakBytes: 1280 << 10},
+	},
+}
+
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic,
+// and that parsing the map written by String with the same moves gives back the same warehouse
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, func(t *testing.T, input string) {
+		warehouse, moves := MustParse(input)
+		text := warehouse.String() + "\n\n" + string(moves)
+		again, againMoves, err := Parse(text)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", text, err)
+		}
+		if !reflect.DeepEqual(again, warehouse) || !reflect.DeepEqual(againMoves, moves) {
+			t.Errorf("Parse(%q) = %v, %q, want %v, %q", text, again, string(againMoves), warehouse, string(moves))
+		}
+	})
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+// The parts take the raw input, so their times include parsing, and for Part2 scaling the warehouse
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
+
+// scenario is a small warehouse and the moves of its robot, as generated for the property tests
+type scenario struct {
+	rows  [][]byte
+	moves []rune
+}
+
+// String returns the scenario in the format of the puzzle input
+func (s scenario) String() string {
+	return string(bytes.Join(s.rows, []byte("\n"))) + "\n\n" + string(s.moves)
+}
+
+// scenarios generates walled warehouses of up to 10 by 10 cells with boxes, walls and one robot inside,
+// and at least one move
+// They shrink by dropping moves, emptying cells and removing rows and columns the robot is not in.
+func scenarios() prop.Gen[scenario] {
+	return prop.Gen[scenario]{
+		Generate: func(rng *rand.Rand, size int) scenario {
+			width, height := 3+rng.IntN(min(size, 8)), 3+rng.IntN(min(size, 8))
+			rows := make([][]byte, height)
+			for y := range rows {
+				rows[y] = bytes.Repeat([]byte{'#'}, width)
+				for x := 1; y > 0 && y < height-1 && x < width-1; x++ {
+					rows[y][x] = ".....OOO##"[rng.IntN(10)]
+				}
+			}
+			rows[1+rng.IntN(height-2)][1+rng.IntN(width-2)] = '@'
+
+			moves := make([]rune, 1+rng.IntN(4*size))
+			for i := range moves {
+				moves[i] = []rune("^v<>")[rng.IntN(4)]
+			}
+			return scenario{rows, moves}
+		},
+		Shrink: shrinkScenario,
+		Format: scenario.String,
+	}
+}
+
+// shrinkScenario returns simpler scenarios: fewer moves, then fewer rows and columns, then fewer boxes and walls
+func shrinkScenario(s scenario) []scenario {
+	var smaller []scenario
+	for _, moves := range prop.ShrinkSlice(s.moves, nil) {
+		if len(moves) > 0 { // The input needs at least one move
+			smaller = append(smaller, scenario{s.rows, moves})
+		}
+	}
+
+	height, width := len(s.rows), len(s.rows[0])
+	for y := 1; y < height-1 && height > 3; y++ {
+		if bytes.IndexByte(s.rows[y], '@') < 0 {
+			rows := append(append([][]byte{}, s.rows[:y]...), s.rows[y+1:]...)
+			smaller = append(smaller, scenario{rows, s.moves})
+		}
+	}
+	for x := 1; x < width-1 && width > 3; x++ {
+		rows := make([][]byte, height)
+		for y, row := range s.rows {
+			rows[y] = append(append([]byte{}, row[:x]...), row[x+1:]...)
+		}
+		if !bytes.Contains(bytes.Join(rows, nil), []byte{'@'}) {
+			continue
+		}
+		smaller = append(smaller, scenario{rows, s.moves})
+	}
+
+	for y := 1; y < height-1; y++ {
+		for x := 1; x < width-1; x++ {
+			if s.rows[y][x] == 'O' || s.rows[y][x] == '#' {
+				rows := make([][]byte, height)
+				for i, row := range s.rows {
+					rows[i] = bytes.Clone(row)
+				}
+				rows[y][x] = '.'
+				smaller = append(smaller, scenario{rows, s.moves})
+			}
+		}
+	}
+	return smaller
+}
+
+// cells returns the positions of every cell of the warehouse that holds one of kinds
+func cells(w *Warehouse, kinds string) []Position {
+	var found []Position
+	for y, row := range w.Grid {
+		for x, cell := range row {
+			if strings.ContainsRune(kinds, cell) {
+				found = append(found, Position{X: x, Y: y})
+			}
+		}
+	}
+	return found
+}
+
+// checkMoves makes every move on the warehouse and checks after each that the boxes and walls stay
+// and that the robot took at most one step in the direction of the move and did not enter a wall
+// boxes are the cells that make up boxes, counted to see that none disappear or appear.
+func checkMoves(w *Warehouse, moves []rune, simulate func(*Warehouse, rune), boxes string) error {
+	walls := cells(w, "#")
+	boxCells := len(cells(w, boxes))
+	for i, move := range moves {
+		from := w.Robot
+		simulate(w, move)
+
+		if got := len(cells(w, boxes)); got != boxCells {
+			return fmt.Errorf("move %d (%c): %d box cells, want %d\n%s", i+1, move, got, boxCells, w)
+		}
+		if got := cells(w, "#"); !reflect.DeepEqual(got, walls) {
+			return fmt.Errorf("move %d (%c): walls changed\n%s", i+1, move, w)
+		}
+		if to := w.Robot; to != from && to != from.Add(GetDirection(move)) {
+			return fmt.Errorf("move %d (%c): robot went from %v to %v", i+1, move, from, to)
+		}
+		if robots := cells(w, "@"); len(robots) != 1 || robots[0] != w.Robot {
+			return fmt.Errorf("move %d (%c): robot at %v, but the map has it at %v\n%s", i+1, move, w.Robot, robots, w)
+		}
+	}
+	return nil
+}
+
+func TestSimulateMoveInvariants(t *testing.T) {
+	prop.Check(t, scenarios(), func(s scenario) error {
+		warehouse, moves := MustParse(s.String())
+		return checkMoves(warehouse, moves, (*Warehouse).SimulateMove, "O")
+	})
+}
+
+func TestSimulateMoveWideInvariants(t *testing.T) {
+	prop.Check(t, scenarios(), func(s scenario) error {
+		warehouse, moves := MustParse(s.String())
+		wide := ScaleWarehouse(warehouse)
+		err := checkMoves(wide, moves, (*Warehouse).SimulateMoveWide, "[]")
+		if err != nil {
+			return err
+		}
+		// Both halves of every box have to stay side by side
+		for _, left := range cells(wide, "[") {
+			if wide.Grid[left.Y][left.X+1] != ']' {
+				return fmt.Errorf("box at %v lost its right half\n%s", left, wide)
+			}
+		}
+		if left, right := len(cells(wide, "[")), len(cells(wide, "]")); left != right {
+			return fmt.Errorf("%d left and %d right box halves\n%s", left, right, wide)
+		}
+		return nil
+	})
+}
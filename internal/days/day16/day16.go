@@ -0,0 +1,263 @@
+package day16
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/collections"
+	"github.com/amoilanen/advent-of-code-2024/internal/graph"
+	"github.com/amoilanen/advent-of-code-2024/internal/svg"
+	"github.com/amoilanen/advent-of-code-2024/internal/utils"
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
+)
+
+const ExampleInput = `###############
+#.......#....E#
+#.#.###.#.###.#
+#.....#.#...#.#
+#.###.#####.#.#
+#.#.#.......#.#
+#.#.#####.###.#
+#...........#.#
+###.#.#####.#.#
+#...#.....#.#.#
+#.#.#.###.#.#.#
+#.....#...#.#.#
+#.###.#.#.#.#.#
+#S..#.....#...#
+###############`
+
+// Points the reindeer scores for every step forward and for every quarter turn
+const (
+	MoveCost = 1
+	TurnCost = 1000
+)
+
+// Position represents a tile of the maze (X is the column, Y is the row)
+type Position = vector.Vec2
+
+// Maze represents the reindeer maze; tiles outside it count as walls
+type Maze struct {
+	Width  int
+	Height int
+	Start  Position
+	End    Position
+	walls  [][]bool
+}
+
+// State is where the reindeer stands and which way it faces, the node of the shortest path search
+type State struct {
+	Pos    Position
+	Facing vector.Vec2
+}
+
+// Parse parses the maze, which has to hold walls, floor and exactly one start and one end tile
+func Parse(input string) (Maze, error) {
+	lines, err := utils.AsGrid(input)
+	if err != nil {
+		return Maze{}, err
+	}
+	maze := Maze{
+		Width:  len(lines[0]),
+		Height: len(lines),
+		walls:  make([][]bool, len(lines)),
+	}
+
+	foundStart, foundEnd := false, false
+	for y, line := range lines {
+		maze.walls[y] = make([]bool, len(line))
+		for x, ch := range line {
+			pos := Position{X: x, Y: y}
+			switch ch {
+			case '#':
+				maze.walls[y][x] = true
+			case '.':
+			case 'S':
+				if foundStart {
+					return Maze{}, fmt.Errorf("line %d, column %d: second start tile", y+1, x+1)
+				}
+				maze.Start, foundStart = pos, true
+			case 'E':
+				if foundEnd {
+					return Maze{}, fmt.Errorf("line %d, column %d: second end tile", y+1, x+1)
+				}
+				maze.End, foundEnd = pos, true
+			default:
+				return Maze{}, fmt.Errorf("line %d, column %d: unexpected %q", y+1, x+1, ch)
+			}
+		}
+	}
+
+	if !foundStart {
+		return Maze{}, fmt.Errorf("the maze has no start tile")
+	}
+	if !foundEnd {
+		return Maze{}, fmt.Errorf("the maze has no end tile")
+	}
+	return maze, nil
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) Maze {
+	maze, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return maze
+}
+
+// String returns the maze in the format of the puzzle input
+func (m Maze) String() string {
+	lines := make([]string, m.Height)
+	for y, row := range m.walls {
+		line := make([]byte, m.Width)
+		for x, wall := range row {
+			switch pos := (Position{X: x, Y: y}); {
+			case wall:
+				line[x] = '#'
+			case pos == m.Start:
+				line[x] = 'S'
+			case pos == m.End:
+				line[x] = 'E'
+			default:
+				line[x] = '.'
+			}
+		}
+		lines[y] = string(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isWall reports whether the reindeer cannot step on the tile
+func (m Maze) isWall(pos Position) bool {
+	return !pos.InBounds(m.Width, m.Height) || m.walls[pos.Y][pos.X]
+}
+
+// moves returns what the reindeer can do from a state: step forward onto floor or turn a quarter either way
+func (m Maze) moves(s State) []graph.Edge[State] {
+	edges := []graph.Edge[State]{
+		{To: State{Pos: s.Pos, Facing: s.Facing.RotateRight()}, Cost: TurnCost},
+		{To: State{Pos: s.Pos, Facing: s.Facing.RotateLeft()}, Cost: TurnCost},
+	}
+	if ahead := s.Pos.Add(s.Facing); !m.isWall(ahead) {
+		edges = append(edges, graph.Edge[State]{To: State{Pos: ahead, Facing: s.Facing}, Cost: MoveCost})
+	}
+	return edges
+}
+
+// search runs Dijkstra from the start facing east and returns the distances, the lowest score
+// and the states at the end tile reached with that score
+// There are no such states when the end cannot be reached.
+func (m Maze) search() (*graph.Distances[State], int, []State) {
+	distances := graph.Dijkstra(State{Pos: m.Start, Facing: vector.Right}, m.moves)
+
+	best := 0
+	var ends []State
+	for _, facing := range vector.Directions4 {
+		end := State{Pos: m.End, Facing: facing}
+		cost, reached := distances.Dist[end]
+		switch {
+		case !reached:
+		case len(ends) == 0 || cost < best:
+			best, ends = cost, []State{end}
+		case cost == best:
+			ends = append(ends, end)
+		}
+	}
+	return distances, best, ends
+}
+
+// LowestScore returns the lowest score a reindeer can get going from the start to the end tile
+// It reports false when the end cannot be reached.
+func (m Maze) LowestScore() (int, bool) {
+	_, best, ends := m.search()
+	return best, len(ends) > 0
+}
+
+// BestTiles returns every tile that is part of at least one path with the lowest score
+// It is empty when the end cannot be reached.
+func (m Maze) BestTiles() collections.Set[Position] {
+	distances, _, ends := m.search()
+	tiles := collections.NewSet[Position]()
+	for _, state := range distances.OnShortestPaths(ends...).Items() {
+		tiles.Add(state.Pos)
+	}
+	return tiles
+}
+
+// BestPath returns the tiles of one path with the lowest score in order, from the start to the end tile
+// Turning in place does not repeat a tile; the path is nil when the end cannot be reached.
+func (m Maze) BestPath() []Position {
+	distances, _, ends := m.search()
+	if len(ends) == 0 {
+		return nil
+	}
+
+	var path []Position
+	for _, state := range distances.PathTo(ends[0]) {
+		if len(path) == 0 || path[len(path)-1] != state.Pos {
+			path = append(path, state.Pos)
+		}
+	}
+	return path
+}
+
+// Part1 returns the lowest score a reindeer can get, or 0 when the end cannot be reached
+// Algorithm: Dijkstra over (tile, facing) states, where a step costs 1 and a quarter turn 1000
+//
+// Time complexity: O(T log T) where T is the number of floor tiles
+// Space complexity: O(T)
+func Part1(maze Maze) int {
+	score, ok := maze.LowestScore()
+	if !ok {
+		return 0
+	}
+	return score
+}
+
+// Part2 counts the tiles that are part of at least one path with the lowest score
+// Algorithm: Dijkstra keeps every predecessor with an equally low score, so walking those links back
+// from the cheapest end states visits every state on a best path
+//
+// Time complexity: O(T log T) where T is the number of floor tiles
+// Space complexity: O(T)
+func Part2(maze Maze) int {
+	return maze.BestTiles().Len()
+}
+
+// ExportSVG draws the maze with the tiles of every best path shaded, one best path as a line
+// and the start and end tiles labeled
+func ExportSVG(w io.Writer, maze Maze, cellSize int) error {
+	doc := svg.New(maze.Width, maze.Height, cellSize)
+	doc.Background = "#f4f4f0"
+
+	wall := svg.Style{Fill: "#505058"}
+	bestTile := svg.Style{Fill: "#f2d48a"}
+	bestPath := svg.Style{Stroke: "#d23c3c", StrokeWidth: 0.2 * float64(cellSize), Opacity: 0.85}
+	label := svg.Style{Fill: "#1f2a44"}
+
+	var walls []Position
+	for y, row := range maze.walls {
+		for x, isWall := range row {
+			if isWall {
+				walls = append(walls, Position{X: x, Y: y})
+			}
+		}
+	}
+	doc.Cells(walls, wall)
+	doc.Cells(maze.BestTiles().SortedFunc(vector.Compare), bestTile)
+	if path := maze.BestPath(); path != nil {
+		doc.Polyline(path, bestPath)
+	}
+	doc.Label(maze.Start, "S", label)
+	doc.Label(maze.End, "E", label)
+
+	doc.Legend("wall", wall)
+	doc.Legend("tile on some best path", bestTile)
+	doc.Legend("one best path", bestPath)
+	doc.Legend("start (S) and end (E)", label)
+
+	_, err := doc.WriteTo(w)
+	return err
+}
@@ -0,0 +1,129 @@
+package day16
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/golden"
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
+)
+
+const secondExample = `#################
+#...#...#...#..E#
+#.#.#.#.#.#.#.#.#
+#.#.#.#...#...#.#
+#.#.#.#.###.#.#.#
+#...#.#.#.....#.#
+#.#.#.#.#.#####.#
+#.#...#.#.#.....#
+#.#.#####.#.###.#
+#.#.#.......#...#
+#.#.###.#####.###
+#.#.#...#.....#.#
+#.#.#.#####.###.#
+#.#.#.........#.#
+#.#.#.#########.#
+#S#.............#
+#################`
+
+var puzzle = testkit.Puzzle[Maze]{
+	Day:   16,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "7036"},
+		{Name: "example", Input: ExampleInput, Part: 2, Want: "45"},
+		{Name: "second example", Input: secondExample, Part: 1, Want: "11048"},
+		{Name: "second example", Input: secondExample, Part: 2, Want: "64"},
+		// The reindeer starts facing east, so going north costs a turn first
+		{Name: "straight east", Input: "#####\n#S.E#\n#####", Part: 1, Want: "2"},
+		{Name: "turn north", Input: "###\n#E#\n#.#\n#S#\n###", Part: 1, Want: "1002"},
+		// Around either side of the pillar takes 4 steps and 3 turns, so all eight floor tiles are on a best path
+		{Name: "two best paths", Input: "#####\n#...#\n#S#E#\n#...#\n#####", Part: 1, Want: "3004"},
+		{Name: "two best paths", Input: "#####\n#...#\n#S#E#\n#...#\n#####", Part: 2, Want: "8"},
+		{Name: "unreachable end", Input: "#####\n#S#E#\n#####", Part: 1, Want: "0"},
+		{Name: "unreachable end", Input: "#####\n#S#E#\n#####", Part: 2, Want: "0"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "ragged lines", Input: "#S.E#\n###"},
+		{Name: "unexpected tile", Input: "#S.x.E#"},
+		{Name: "no start", Input: "#..E#"},
+		{Name: "no end", Input: "#S..#"},
+		{Name: "two starts", Input: "#S.SE#"},
+		{Name: "two ends", Input: "#SE.E#"},
+	},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 180, Bytes: 48 << 10, PeakBytes: 64 << 10},
+		Part1: testkit.Budget{Allocs: 195_000, Bytes: 48 << 20, PeakBytes: 40 << 20},
+		Part2: testkit.Budget{Allocs: 195_000, Bytes: 48 << 20, PeakBytes: 40 << 20},
+	},
+}
+
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
+}
+
+func TestParse(t *testing.T) {
+	maze := MustParse(ExampleInput)
+
+	if maze.Width != 15 || maze.Height != 15 {
+		t.Errorf("MustParse() size = %dx%d; want 15x15", maze.Width, maze.Height)
+	}
+	if want := (Position{X: 1, Y: 13}); maze.Start != want {
+		t.Errorf("MustParse() Start = %v; want %v", maze.Start, want)
+	}
+	if want := (Position{X: 13, Y: 1}); maze.End != want {
+		t.Errorf("MustParse() End = %v; want %v", maze.End, want)
+	}
+}
+
+func TestBestPath(t *testing.T) {
+	maze := MustParse(ExampleInput)
+	path := maze.BestPath()
+
+	if len(path) == 0 || path[0] != maze.Start || path[len(path)-1] != maze.End {
+		t.Fatalf("BestPath() = %v; want a path from %v to %v", path, maze.Start, maze.End)
+	}
+
+	// 36 steps and 7 turns make the lowest score of 7036
+	score, turns := 0, 0
+	tiles := maze.BestTiles()
+	for i := 1; i < len(path); i++ {
+		step := path[i].Sub(path[i-1])
+		if step.Manhattan(Position{}) != 1 || maze.isWall(path[i]) || !tiles.Contains(path[i]) {
+			t.Fatalf("BestPath() steps from %v to %v", path[i-1], path[i])
+		}
+		if i > 1 && step != path[i-1].Sub(path[i-2]) {
+			turns++
+		}
+		score += MoveCost
+	}
+	if path[1].Sub(path[0]) != (Position{X: 1, Y: 0}) {
+		turns++
+	}
+	score += turns * TurnCost
+	if score != 7036 {
+		t.Errorf("BestPath() scores %d with %d turns; want 7036", score, turns)
+	}
+}
+
+func TestExportSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportSVG(&buf, MustParse(ExampleInput), 20); err != nil {
+		t.Fatalf("ExportSVG() error = %v", err)
+	}
+	golden.Compare(t, "testdata/example.svg", buf.Bytes())
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic,
+// and that parsing what String writes gives back the same maze
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, puzzle.RoundTrip(Maze.String))
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
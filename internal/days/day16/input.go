@@ -0,0 +1,145 @@
+package day16
+
+// DayInput contains the puzzle input for day 16
+// Replace this with your actual puzzle input from https://adventofcode.com/2024/day/16/input
+const DayInput = `#############################################################################################################################################
+#.......#...#.............#.........#.........#...........#.......#.....#...........................#.........#.............#.......#......E#
+#.#####.#.#.#.###.#.#####.#.#####.###.#####.###.#######.#.#.###.#.#.#.#.#######.###################.#.#.#####.###.###.#######.#####.#.#.###.#
+#.....#.#.#...#.#.......#...#...#.....#...#.......#...#.#...#.....#.#.#.....#...#...#.......#.....#.#.#...#...........#.......#.......#.#.#.#
+#.###.#.#.#####.#######.#.###.#####.#####.###.###.#.#.#.#####.#.###.#.#####.#.#.###.#.#.#####.#.###.#####.#######.#####.#####.#.#.#####.#.#.#
+#...#.#.........#.....#.......#...#.#.......#...#.#.#.#...#...#.#...#.......#.....#...#.......#...#.....#.......#.#.......#.....#...#...#...#
+###.#.###########.###.#.#####.#.#.#.#.###.#####.#.#.#.###.#.###.#.#####.#.#######.#.#############.#####.#.#####.#.#.#.###.#.#.#######.#######
+#...#.....#.......#.......#...#.#.#...#...#...#.#.#.#.#...#.....#.#.#...#.......#.#.....#.....#.......#.....#...#.#.#...#...#.#.....#.......#
+#.#.###.###.###.#####.#.#.#.###.#.#####.###.#.#.#.#.#.#.#######.#.#.#.#########.#.#.###.#.#.#.#.#.#.#######.#.###.#.#.#######.#.###.#######.#
+#.#.#...#...#...#...#...#.......#.#.......#.#...#...#.#.....#...#...#...#.....#.#.....#.........#.#.....#...#.#...#.#.........#.#.....#.....#
+#.###.###.###.###.#.#####.#######.#####.#.#.#.#######.#.#.#.#.###.#.###.#.#.#.#.#.###.#.#.#.#.#####.#.#.#.###.#.###.#.#.#####.#.#.###.#.###.#
+#...#.....#.....#.#.......#...........#...#.#.#.......#.#.#.......#...#...#.#.#.......#...#.#.......#.#.#.....#.#.#...#.......#...#...#.#.#.#
+###.###.#.#####.#.#######.###.#.#####.#.###.#.#.#######.#######.###.#.###.#.#.#.#########.#.#.#.#####.#.#####.#.#.###.###########.#.###.#.#.#
+#...#.............#.....#...#...#...#...#...#.#.....#.#.#.....#.#.....#...#.#.....#.........#.#.#...#.#...#.....#...#.#.....#...#.#.#...#.#.#
+#.#.#.#.###########.#.#####.#####.#.#.###.###.#####.#.#.#.###.#.#####.#.###.#.#.#.#.#.#########.#.#.#.###.#.#####.###.#.###.#.#.#.#.#.###.#.#
+#.................#.#.....#.#.....#.#.....#.....#...#.#...#.#...#.....#.#...#.#.#...#...............#...#.#.#.......#...#...#.#.#.#...#...#.#
+###.#######.#####.###.#.#.#.#.#####.###.#########.###.#.###.#####.#####.#.###.#.#.###########.###.#####.###.#.#####.#.#.#.#.#.#.#.#####.#.#.#
+#...#.....#.#...#...#.#.#.#...#...#...#.#.........#.......#...........#.#.#...#.#.............#.......#...#.....#...#.#.....#.#.#...#.......#
+#.#.#.#.#.###.#.###.###.#.#####.#.###.#.#.#######.#####.#.#####.###.#.#.#.#####.#.#.#######.#.#.#####.###.#.###.#.#.#.#.###.#.#.###.#.#.#.###
+#.....#.#.....#.#.....#.#.#.....#.#...#.#.#.......#.....#.#.....#...#...#.#...........#.#...........#.....#...#.#.#.#.#...#.#.#.....#.#.#...#
+#.###.#.#######.#####.#.#.#.#.###.#.#####.#.###.#.#.#.#.#.#.#.###.#######.#.#######.#.#.#.#####.###.#########.###.#.#.#.#.###.#######.#.###.#
+#.#...........#...#.#...#...#.#.........#.#...#.....#...#...#.#...........#.#.........#...#.....#...#.......#.#...#.#.....#...#.......#...#.#
+#.#.#.###.#.#.###.#.#####.#.#.#.#.#####.#.###.#######.#.#####.#.###.###.###.#.#########.#####.###.#.#.#####.#.#.#.###.###.#.#.#########.###.#
+#...#.#.#...#.#.#.#.......#.#.#.#...#.#.#.........#.....#...#.#.#...#...#...#...#.....#.....#.#...#.#.....#.....#.#...#.....#.#.......#...#.#
+#.#.#.#.###.#.#.#.###.#####.#.#.###.#.#.###.###.#.#.#####.#.###.#.###.###.#####.#.#.#.#####.###.#.###.#.#.#########.###.#######.#####.#.#.#.#
+#.#.#.......#...#...#.........#...#...#.....#...#.#.#.....#...#.#.......#.#...#.#.#.......#.....#.#.....#.#.........#.#.#.......#...#.#.#.#.#
+#.#.#######.#.#####.#####.###.###.###.#######.###.###.#####.#.#.#.#.#####.#.#.#.#.#.###.#######.###.#######.###.#.#.#.#.#.#########.#.#.#.#.#
+#.#.....#...#.....#.#...#.....#...#...#.......#.#.....#.....#.#.#.#...#...#.#...#.#.........#...#...#.......#...#.#...#.....#.......#.#.#...#
+#.#####.#.###.###.#.#.#.#######.###.###.###.###.#######.#####.#.#.#.###.###.#####.#.#####.###.###.###.###.###.#.#.###.#.###.#.#####.#.#.#####
+#.#...#...........#.....#.#...........#.#...#...................#.......#.#.#...#.#.....#.....#.....#...#.....#.#.....#...............#.#...#
+#.###.#.#.#.###.#######.#.#.###########.###.#.#####.#.#####.#######.#.###.#.###.#.#.###########.###.###.###.###.###########.###.###.###.###.#
+#.....#.....#.#.......#.#.........#...#...#.........#.#...#.........#.#...#...#.#.#...#.........#.....#.#...#...#.........#.....#.#...#.....#
+#.#.#.#.###.#.###.#####.#.#######.#.#.#.#.#######.#.#.###.#####.#####.###.###.#.#.#.#.#.#########.###.#.#.###.###.#.#.#####.#####.#.#.###.#.#
+#.....#.#.#...#.#.......#...#...#...#.#.#.......#.#.....#...#...#...#.....#...#.#.#...#.#.........#...#...#.#.#...#...#.....#...#...........#
+#.#####.#.#.#.#.#######.#.###.#.###.#.#.#####.#.#.#.###.#.#.#.#.#.#.#####.#.###.#.###.#.#######.###.#######.#.#.#.#.###.#####.#.#.#########.#
+#.#.#...#.#.....#.....#.#.#...#.....#.#.#...#.#.#.#...#...#.#.#.#.#.......#...#.......#.#.......#.#.#.........#...#.....#...#.#.........#...#
+#.#.#.###.#.#####.###.#.###.#########.###.#.###.#.#.#.#####.#.###.#######.###.#########.#.#######.#.#.#####.###.###.#.#.#.#.###########.#.#.#
+#...#.#.#...#.#...#.#.......#...#...#.....#.#...#...#...#...#...#...#.......#.....#.......#.....#...#.....#.....#...#.#.#.#.....#.....#...#.#
+###.#.#.#.#.#.#.###.#.#####.#.#.#.#########.#.###.#####.#.#####.###.#.#####.#####.#.#######.#####.###.#.#.#######.###.#.#.#.#.#.###.#.#####.#
+#...#.#.#...#.#.#...#.#...#.#.#.#.......#...#...#.....#.......#...#.#.....#.......#.#.....#...........#.#.......#...#...#.....#.#.......#...#
+#.#.#.#.###.#.#.#.###.#.#.###.#.###.###.#.###.#.#.###.#.#.###.#.#.#.#####.#####.###.#.#.#.#####.#.###.#.###.###.#.#.#####.###.#.#.#######.###
+#...#.#.....#.#.#...#...#.....#...#...#.#.#.#.#...#...#.#.....#...#.#...#...#...#...#.#.#.......#.#.......#...#.#.#...#.........#.#.....#...#
+#####.#.#####.#.###.#######.#####.#.###.#.#.#.#####.###.#.#####.#.#.#.#####.#####.###.#.#######.###.#####.#.#.#.#.###.#.###.#####.#.###.###.#
+#.....#.#.....#...#.....#...#.....#.#...#.......#...#...#.#.#...#...#.......#.......#...#.....#.........#.#.#.#.#...#.....#.#...#...#...#...#
+#.#####.#.#.#####.###.###.#.#.#####.#.###.#.#####.###.#.#.#.#.#.#.#######.#.#.#########.#.###.###########.#.#.#.#####.###.#.#.#.###.#.###.###
+#.....#.#.#.....#...#...#.#...#.#...#...#.#...#.......#...#.#.....#.......#.#.....#...#...#...................#.....#.....#...#...#.#.....#.#
+#.###.#.#.###.#.###.###.#.#####.#.#####.#.#.#.#.###.#.#.###.#######.#####.#.###.###.#.#####.###.###.#.#####.#######.#############.###.#.###.#
+#...#.#...........#.....#...#.....#.....#...........#.#.........#.#.....#.#.....#...#.......#.#...#...#...........#...#...#.....#.#...#.....#
+#.###.###.#####.#.#.#.#.###.###.###.#.#######.#####.#.#####.###.#.#.###.#########.###########.#.#.#.#########.###.###.#.#.#.###.#.#.#######.#
+#.#...#...........#...#.......#.#.#...........#.....#.#.....#.#.#...#.#.....#.....#.......#.....#.#.........#.......#...#...#.#.#.#.....#...#
+#.#.###.###########.###.#.###.#.#.#.###.#####.#.#######.#.#.#.#.#.###.#####.#.#####.#####.#.#####.#########.#.#.#.#.#.#.#####.#.#.#####.#.###
+#.#...#...........#.#...#.#...#.........#...#.#.........#.#.#.#.#.......#...#...#.#...#...#.#...#.#.....#...#.#...#...#.....#.#...#...#.#.#.#
+#.###.#######.#.#.#.#.#.###.#######.###.#.#.#.###########.#.#.#.#######.#.#.###.#.#.#.#.###.###.#.#.#.#.#.###.###.#####.###.#.#####.#.#.#.#.#
+#...#...#.......#...#.#.....#.......#.......#...#...#.....#...#...#.....#...#...#.#.#.......#.......#...#.....#.#.....#.#...#.#.....#...#.#.#
+#.#####.#####.#.###.#.#.#######.#####.###.#####.#.#.#.#######.###.#.#######.#.###.#.#########.#.#####.#########.###.#.#.#.###.#.#.###.###.#.#
+#.#.....#...........#...#.....#.......#.....#...#.#...#...#...#...#...#.....#.#.#.............#.#...#.#...........#.#.....#...........#...#.#
+#.#.#####.###.#.#.###.#.#.###.#.#####.#.###.#.###.#.###.#.#.###.#######.#####.#.#.###.#########.#.#.#.#.#.###.#.#.#.#.###.#.#####.###.#.###.#
+#.#.....#.#.....#.#.....#...#...#...#.#.#...#...#.....#.#...#.#.#.....#...#...#.....#.....#.#...#.#...#.#.......#.#...#.#.#.#...#.#...#...#.#
+#.###.#.#.#.#####.#.#######.#####.#.#.#.#.#.###.#####.#.#####.#.###.#.###.#.#######.#.###.#.#.###.#####.#######.#.#.#.#.#.#.#.#.###.#.###.#.#
+#.....#...#.......#.......#...#...#...#.#.....#...#...#.....#.#.....#.....#.......#.....#.......#...#.....#.....#.#.#...#.#...#.....#.....#.#
+#####.#####.#.#.###.#.#.#.#.#.#.#.#####.#.#.#.###.#.#######.#.#######.###########.#.###.#.###.###.#.#.#####.###.#.#.###.#.###.#########.#.#.#
+#.....#.#...#...#...#.#.#.#.#.#.#.......#.#...#...#.....#.#...#.......#.........#.#.............#.#...#.....#.....#.....#.#...#.......#.#.#.#
+#.#####.#.#.#.###.#####.#.###.#########.#.#####.#####.#.#.#.###.#######.#######.#.#####.###.###.#.###.#.#####.#######.#.#.#.###.#####.#.#.#.#
+#.#.........#.#...#.....#.............#.#.#...#.....#.#.#...#...#...#...#.....#...#.........#.#.#...#.#.#.....#.......#.#.#...........#.#...#
+#.#.#.#.#####.#.###.#####.#####.###.#.#.#.#.#.#####.###.#.###.###.#.#.###.#########.#########.#.#.#.###.#.#######.#####.#.###.###.#.###.###.#
+#.#.#.....#...#.....#...#.#.....#...#...#...#...........#...#.#.....#.#.........#.........#.......#...#.#...#...#.......#.#.....#.#.........#
+#.#.#######.###.#.###.###.#.#######.#.#####.#########.#.###.#.#.###.#.#########.#.#######.###########.#.###.#.#.#######.#.#.#.#.#####.###.#.#
+#.#.#.......#...#.#.......#.#.....#...........#...#.....#.....#.#...#.............#.....#.#.......#...#.#.#.#.#...#.....#...#.......#.#.....#
+#.#.#.#######.###.#########.#.###.#.#########.#.#.#.#####.#####.#.###########.#.#####.#.#.#.#.###.###.#.#.#.#.###.#.#########.#####.#.#.#####
+#.#.#.......#...#...#.......#.#.#...#.......#.#.#.#.....#.#.....#...........#.#.#.....#.#.#.........#...#.#...#...#.#...........#...#...#...#
+#.#.###.###.###.###.#.#######.#.###.#.#.#.#.#.#.#.#######.#####.###########.#.#.#.#######.###.#####.#####.#####.###.#.#.###.#.#.#.#######.#.#
+#.#...#.#.....#...#...#.......#.....#.#.#...#...#.#...#.........#.....#.....#.#.........#...#.#...#.#.....#.#...#.....#.#...#.#.#.#.......#.#
+#.###.#.#.#####.#.#.#.#.#.#######.#.#.#.#.#######.#.#.#.###.#.#.#####.#.###.#.#.###.###.###.#.#.#.#.#.###.#.#.###.#.###.#.###.###.#.###.#.#.#
+#.#...#.#.........#.#.#...............#.#.....#.#...#.#.....#.......#...#...#.#.....#.#...#...#.#...#.#.....#...#.......#.#...#...#...#...#.#
+#.#.###.###.#.#####.#.#################.###.#.#.#####.#######.###.#.#.###.#.#.#.#####.###.#.###.#####.#.#######.#.###.#.#.#.#.#.#.###.#.###.#
+#.#.....#...#...#...#.....#.........#...#.#.................#.......#.....#.#...#.....#...#...#.#.....#.#.#.....#.....#.#.#...#.......#...#.#
+#.#####.#.#.###.#.#.#####.#.#####.#.#.###.#.#######.#.###################.#.#.#.###.#.#.#####.#.#.#####.#.#.###########.#.###.#########.#.#.#
+#.....#.#.#.#...#.#.#.....#...#...#...#...#.#.......#...#...............#.#.......#.#.#.#...#.#.#...#...#.#...........#.#...........#.#...#.#
+#####.###.#.#.###.#.#.#######.#.#######.#.#.#.#####.###.#.#####.#.#.###.#.#######.###.#.###.#.#.#.#.#.###.###########.#.#.#.#######.#.#.#.#.#
+#.....#...#.#.#...#.#...................#.#...#.....#...#.....#.#...........#.....#...#...#.#...#.#.#.#.................#...#.....#.#.#.#...#
+#.#####.###.#.#.#.#####.###########.###.#.#####.#####.#.#####.#.###.#####.#.#.#####.#####.#.#.#.###.#.###.#####################.#.#.#.#.###.#
+#.......#.....#.....#...#.....#...#.#...#...#...#.#...#.....#.#...#.........#.#.....#.....#.....#...#.#...#.....................#.#...#.#...#
+#####.###.#####.###.#.###.###.#.#.###.#####.#.#.#.#.###.#.#.#.###.#########.#.#.###.#.#######.#.#.#.#.#.###.#######.#####.#.#.#.###.#.#.###.#
+#.....#...#.....#...#...#.#.#...#.........#.#.#.#.#.#...#.#.#...#.....#...#.#.#...#.#.......#...#.#.....#.....#.........#.#.#...#...#.#.....#
+###.#.#.###.#####.#.###.#.#.#.#####.#####.#.#.#.#.#.#.###.#####.#####.#.#.#.#.#.#.#.#.#####.#.###.#######.###.#.#####.#.#.#.###.#.#.#.#####.#
+#...#.#.#.#.#...#.....#.#...#.....#...#.......#...#.#...#.....#.....#.#.#.#.#...#.#...#.....#...#...#.....#.#...#...#.#...#...#.#.#...#...#.#
+#.###.#.#.#.#.#.#.#####.###.#.###.###.#.#####.###.#.#.#.#####.###.#.#.#.#.#####.#.###.#.#######.###.#.#####.#.###.#.#.#######.###.#####.#.#.#
+#.....#.#...#...#.........#.#...#...#.#.#.....#...#.....#...#...#.#.#...#.........#...#.......#...#...#.....#...#.#...#.........#.....#.#.#.#
+#.#####.###.#.#.###.#.#####.###.#.###.#.#######.#########.#.###.#.#.#####.#.#####.###########.###.#.###.#.#.#.#.#.#########.###.###.###.#.#.#
+#.....#...#...#...#.........#.....#...#.#.......#...#.....#.....#...#...#...#...#.#...........#...#.........#.#.#.#.......#...#.....#...#.#.#
+#.#.#.###.#.#####.#.#####.#.#.#.#.#.###.#.#######.#.#.#############.#.#.###.#.#.#.#.###.###.#.#.###########.#.#.#.#.#####.#.#########.###.#.#
+#.#.#.....#.#...#.#...#.....#...#.#.#.......#.....#.#...#...........#.#...#.#.#.#.#.#...#...#.#...#.......#...#.....#...#...#...#...#...#...#
+#.#.#.#####.#.#.#.#.#.#.#.#.#.#.#.#.#######.###.###.###.#.###.#######.###.###.#.###.#.###.###.###.#.#####.###.#####.#.#.#.#.#.#.#.#.###.#####
+#...#...#...#.#...#.....#.#.#.#...#.......#...#...#.#.#.#.....#.........#.#...#.....#.......#.....#.....#.#.....#.....#.#.#...#...#...#.#...#
+#.#####.#####.#.#.#.#.#.#.#.#.###########.###.#.#.#.#.#.#####.#.#########.#.#####.#.#.#.###.#####.#####.#.#.###.#.#.#.#.#.#.###.#.###.#.#.#.#
+#...#...#.....#.#...#.#.#...#...........#.#...#.#.#...#.....#.#.....#.....#.....#...#.#...#.........#...#.#...#.#.#...#.#.#...#...#...#...#.#
+#.###.#.#.#####.#.#.#.#.#.###.###.#####.#.###.###.###.###.#.#.#####.#.#########.#######.#.#########.#.###.###.#.#.#.###.#.#.#.#.###.#####.#.#
+#.#...#.#...#...#.#.#.#.#.#.....#.#.#...#...#.....#...#...#.#.......#.........#.....................#...#...#...#.#.....#.#...#.....#...#.#.#
+#.#.#####.#.###.#.#.#.###.#######.#.#.#####.#######.###.#.###.#.#######.###.#.#####################.###.#.#.###.#.#.#.###.#.###.###.#.#.#.#.#
+#...#.......#...#...#...#.........#...#...#.#.....#...#.#.#...#.#.....#.#...#.#.......#.............#.....#.....#.....#...#...#.#.....#...#.#
+#.#.#.#####.#.#########.#########.#.###.###.#.###.###.#.###.###.#.###.#.#.###.#.#####.#.#.#####.###.#.#.#.#.###.#.#.#######.#.#.#.###.#.###.#
+#.....#...............#.....#.....#...#...#.#...#...#.#...#.#...#.#.......#.#.#.#...#.....#.....#.....#.........#.#...........#.#...#.#.....#
+#.#####.###.###.#.#####.###.#.###.###.###.#.#######.#.###.#.#.###.#########.#.#.#.#######.#.#######.#.#########.#.#.###########.#.###.#####.#
+#.#...#...#.#...#.....#.#...#.#.#...#...#.#.......#...#.....#.#.#.......#.......#.......#...#.......#.....#...#...#.....#...#...#...#...#...#
+#.#.#.###.#.#.#.#####.#.###.#.#.#.#####.#.#######.#####.###.#.#.#######.#######.#.###.###.#.#.#####.#####.#.#.#.#.###.#.#.#.#.###.#.###.#####
+#...#.........#.....#.#...#.#...#...........#...#.....#.....#.#.#.....#.....#.....#.#.........#...#...#.#.#.#.....#...#.#.#...#...#...#.#...#
+#########.#.#.#####.#.###.###.###.#.#####.#.#.#.#####.#.###.#.#.#.###.#####.###.#.#.###########.#.###.#.#.###.#####.###.#.#.#.#.#.###.#.#.#.#
+#.........#.........#.........#...#.#.........#...#...#.....#.......#.....#...#.......#...#.....#.#.....#...........#.#.#.#.....#...#.#.#.#.#
+###.#.###.#####.#.#####.#######.#.#.#.#.#########.#.#########.#.#####.###.###.#######.###.#.#.#####.#########.#####.#.#.#####.#####.#.#.#.#.#
+#...#...#...................#...#.#.#.#.#.......#.#.#.....#.......#.........#.#...#.......#.#.......#.......#.#...#...#.....#.......#.#.#.#.#
+#.#####.###.#.#.###########.#.#####.#.###.#####.#.#.#.###.#.#####.#.#########.#.#.###.###.#.#########.#####.#.#.#.###.#####.#######.#.#.#.#.#
+#.......#...#.#.#...#.....#.#.......#...#.#.....#.#.....#.#.........#.....#.....#...#.#.............#.#...#.#.#.#.#...#...#.#.#.......#...#.#
+#.#.#####.###.#.###.#.#.###.###.#######.#.#.#.#.#########.#.#.#####.#.###.#.#.#.###.###.#######.###.#.#.#.#.###.#.#.###.#.#.#.#.###.#.#.###.#
+#.#...#...#.#...#...#.#.....#.........#.#.#.#.#.#...........#.......#...#...#.#...............#...#.#.....#.#...#.#.#.....#.#.#.#.#...#...#.#
+#.#.#.#.###.#####.###.#######.#.#####.#.#.#.#.###.#.###.#.#.#.#.###.###.#.###.###.#.#########.#####.#####.#.#.###.#.#.###.#.#.#.#.#######.#.#
+#...#.#.#.........#.........#.#.#.#...#.#...#...#.#.#...#.#...........#...#...#...#.........#.........#...#...#.#.#.#.#...#.#.#.#...#...#.#.#
+#####.#.#########.#.###.#.###.#.#.#.###.###.###.#.#.#.###.#.###.#####.###.#####.###.#######.#########.#.#######.#.###.#.###.#.#.###.#.#.#.#.#
+#...........#.....#.#.........#.....#.....#...#...#.#.#...#...#.#.......#.......#...#.....#.......#...#...#.....#.....#.....#...#...#.#.....#
+#.#########.#.#####.#######.###.#.#######.###.#.###.#.###.###.#.#.#####.#.#######.###.###.#.#.###.###.###.#.###.#############.###.###.###.#.#
+#...#.......#.......#.....#.#...#...#.............#.#.......#...#.#...#.....#.....#...#.......#.#...#.#...#.#.....#...........#.....#.#.....#
+###.#.#.#####.#.#####.###.#.#.#####.#.#.#.#####.###.#.#####.#.#.#.#.#.#.###.#.#####.###.#####.#.###.#.#.###.#.###.#.#.#######.#.###.#.#.#####
+#.#.#.....#.#.........#.#...#.#...#...#.........#.........#.#...#...#.#.#...#...#.#.#.#.#.......#...#.#.#...#...#...#.#...#...#.#...#.#.....#
+#.#.#.###.#.###########.###.#.#.#.#####.#.#.###.#.#########.###.###.#.#.#.#####.#.#.#.#.#.###.#.#.#.#.#.#####.#.#######.#.#.#####.#.#.#####.#
+#.#.#...#.#...........#...#.#.#.#.#...........#.#.......#...#.....#.#.#.#.....#.#.#...#.#.....#.#...#.#.....#.#...#.....#.#.....#.#.#...#...#
+#.#.#.#.#.#.#.###.#.###.#.#.#.#.#.###########.#########.#.#.#.#####.#.#.#.###.#.#.###.#.#.#.#.#####.#######.#####.#.#####.#####.#.###.###.#.#
+#.#.......#.....#.#.#...#...#...#...........#.....#.....#.#...#.....#.......#.#.#...#.#.#.#.#.......#.....#.....#.#.#...#...#...#...#.#...#.#
+#.#########.###.#.#.#.#####.###.###########.#####.#.###.#.#.#.#.###.#.#####.###.#.#.#.#.###.#####.#.#.###.#.###.#.#.###.#.###.#####.#.#.###.#
+#...#...#...#.#...#...#.......#.#.........#...#...#.#...#.#.#.#.#.....#...#.#...#.#.#.#...#.#.....#...#...#.#.#.........#.....#...#...#.#.#.#
+#.#.#.#.#.###.#####.###.#.#.#.#.#.#######.###.#.###.#.###.#.#.#.#######.#.#.#.###.###.###.#.#.###.#####.###.#.#.#####.#######.#.#.#####.#.#.#
+#.#...#.......#...#.....#.#...#.#...#...#.#...#.......#...#...#.#...#...#.#...#.#...#...#...#.........#.#...#.#...#...#.....#.....#.......#.#
+#.###########.#.#.#.###.#.#.###.###.#.#.#.#.###.#######.#.###.#.#.#.#.###.###.#.#.#.###.#######.#.#####.#.###.###.###.#.###.#####.#.#####.#.#
+#.............#.#.#.....#...#.........#.#.#.#.........#.#.#.#.#...#.....#.#.......#...#...#.#...#.#.....#.......#.......#.#.....#...#.....#.#
+#.#############.#.###.###.#.#.###.###.#.#.#.#.#######.#.#.#.#.#######.###.#.#.###.#.#####.#.#.#.###.#############.###.###.#####.#########.#.#
+#.............#.#.....#...#...#.#.#.....#...#...........#...#.#.....#.#...#.#...#.#.#...#.#.#.#.#...#...#...........#.....#...#.........#.#.#
+#.#.#.#######.#.###.###.#.#####.#.#.###########.#.#########.#.#.#.#.#.#.#.#####.#.#.#.#.#.#.#.###.#.#.#.#.#########.###.#.#.#.###.#####.#.#.#
+#...#.....#.#.#.#.....#.#...#.......#.........#.........#.....#.#.#.#.#.#.....#.#.#.......#.#...#.....#...#...........#...........#.....#.#.#
+#.#####.#.#.#.#.#.#.#.#.#.#.#########.#######.#.#######.#.#####.#.#.#.#.#.#.#.#.#.#########.###.###.#######.#####.###.#########.#.#.#####.#.#
+#.#...#.#.#...#...#.#.#.#.#...#.....#.#.....#...#.....#...#...#.#...#...#.#.#...#.#.......#.#...#.........#...#.....#.....#.......#.#.....#.#
+###.#.###.#.###.#.#.#.#.#.###.#.#.#.#.#.###.#.###.###.#####.#.#.#.#####.#.#.#####.#.#####.#.#.###.#####.###.#.#####.#####.#.#.#####.#.#.###.#
+#S..#.....#.....#...#.....#...........#...#.......#.........#...#.........#.........#.......#.........#.....#.......................#.......#
+#############################################################################################################################################`
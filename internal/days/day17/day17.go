@@ -0,0 +1,341 @@
+package day17
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const ExampleInput = `Register A: 729
+Register B: 0
+Register C: 0
+
+Program: 0,1,5,4,3,0`
+
+// MaxSteps is how many instructions Run and Trace execute at most before giving up on a program halting
+const MaxSteps = 1 << 24
+
+// Opcode is one of the eight instructions of the 3-bit computer
+type Opcode int
+
+const (
+	Adv Opcode = iota // A = A >> combo
+	Bxl               // B = B ^ literal
+	Bst               // B = combo % 8
+	Jnz               // Jump to literal unless A is 0
+	Bxc               // B = B ^ C, the operand is ignored
+	Out               // Output combo % 8
+	Bdv               // B = A >> combo
+	Cdv               // C = A >> combo
+)
+
+// mnemonics are the names of the opcodes in the puzzle text
+var mnemonics = [...]string{"adv", "bxl", "bst", "jnz", "bxc", "out", "bdv", "cdv"}
+
+// String returns the mnemonic of the opcode
+func (op Opcode) String() string {
+	return mnemonics[op]
+}
+
+// takesCombo reports whether the operand of the opcode is a combo operand rather than a literal
+func (op Opcode) takesCombo() bool {
+	switch op {
+	case Adv, Bst, Out, Bdv, Cdv:
+		return true
+	default:
+		return false
+	}
+}
+
+// Registers holds the three registers, which can hold any non-negative integer
+type Registers struct {
+	A, B, C int
+}
+
+// Computer is the 3-bit computer: its registers, its program, the instruction pointer and what it has output
+type Computer struct {
+	Registers
+	Program []int
+	IP      int
+	Output  []int
+}
+
+// Parse parses the initial registers and the program
+// Registers have to start non-negative and programs are checked up front, so that they cannot fail while running:
+// every value is a 3-bit number, instructions come in opcode and operand pairs, no combo operand is the reserved 7
+// and jumps land on opcodes.
+func Parse(input string) (Computer, error) {
+	registers, program, found := strings.Cut(strings.TrimSpace(input), "\n\n")
+	if !found {
+		return Computer{}, fmt.Errorf("want the registers and the program separated by a blank line")
+	}
+
+	var c Computer
+	lines := strings.Split(registers, "\n")
+	targets := []*int{&c.A, &c.B, &c.C}
+	if len(lines) != len(targets) {
+		return Computer{}, fmt.Errorf("want registers A, B and C, got %d lines", len(lines))
+	}
+	for i, line := range lines {
+		prefix := fmt.Sprintf("Register %c: ", 'A'+i)
+		value, found := strings.CutPrefix(strings.TrimSpace(line), prefix)
+		if !found {
+			return Computer{}, fmt.Errorf("line %d: want %q", i+1, prefix)
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return Computer{}, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		if n < 0 {
+			return Computer{}, fmt.Errorf("line %d: register %c is negative", i+1, 'A'+i)
+		}
+		*targets[i] = n
+	}
+
+	values, found := strings.CutPrefix(strings.TrimSpace(program), "Program: ")
+	if !found {
+		return Computer{}, fmt.Errorf("want %q", "Program: ")
+	}
+	for _, field := range strings.Split(values, ",") {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			return Computer{}, fmt.Errorf("program: %w", err)
+		}
+		if n < 0 || n > 7 {
+			return Computer{}, fmt.Errorf("program: %d is not a 3-bit number", n)
+		}
+		c.Program = append(c.Program, n)
+	}
+	if err := validate(c.Program); err != nil {
+		return Computer{}, err
+	}
+	return c, nil
+}
+
+// validate checks the program instruction by instruction, see Parse
+func validate(program []int) error {
+	if len(program)%2 != 0 {
+		return fmt.Errorf("program: opcode %d at %d has no operand", program[len(program)-1], len(program)-1)
+	}
+	for ip := 0; ip < len(program); ip += 2 {
+		op, operand := Opcode(program[ip]), program[ip+1]
+		if op.takesCombo() && operand == 7 {
+			return fmt.Errorf("program: %s at %d uses the reserved combo operand 7", op, ip)
+		}
+		if op == Jnz && operand%2 != 0 {
+			return fmt.Errorf("program: jnz at %d jumps to operand %d", ip, operand)
+		}
+	}
+	return nil
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) Computer {
+	c, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// String returns the initial registers and the program in the format of the puzzle input
+func (c Computer) String() string {
+	return fmt.Sprintf("Register A: %d\nRegister B: %d\nRegister C: %d\n\nProgram: %s", c.A, c.B, c.C, join(c.Program))
+}
+
+// join writes 3-bit numbers separated by commas, the format of both the program and the output
+func join(values []int) string {
+	fields := make([]string, len(values))
+	for i, value := range values {
+		fields[i] = strconv.Itoa(value)
+	}
+	return strings.Join(fields, ",")
+}
+
+// combo returns the value of a combo operand: 0 to 3 stand for themselves, 4 to 6 for registers A to C
+func (c *Computer) combo(operand int) int {
+	switch operand {
+	case 4:
+		return c.A
+	case 5:
+		return c.B
+	case 6:
+		return c.C
+	default:
+		return operand
+	}
+}
+
+// Halted reports whether the instruction pointer has left the program
+func (c *Computer) Halted() bool {
+	return c.IP < 0 || c.IP >= len(c.Program)
+}
+
+// Step executes the instruction at the instruction pointer
+// It reports false without doing anything once the computer has halted.
+func (c *Computer) Step() bool {
+	if c.Halted() {
+		return false
+	}
+
+	op, operand := Opcode(c.Program[c.IP]), c.Program[c.IP+1]
+	c.IP += 2
+	switch op {
+	case Adv:
+		c.A >>= c.combo(operand)
+	case Bxl:
+		c.B ^= operand
+	case Bst:
+		c.B = c.combo(operand) % 8
+	case Jnz:
+		if c.A != 0 {
+			c.IP = operand
+		}
+	case Bxc:
+		c.B ^= c.C
+	case Out:
+		c.Output = append(c.Output, c.combo(operand)%8)
+	case Bdv:
+		c.B = c.A >> c.combo(operand)
+	case Cdv:
+		c.C = c.A >> c.combo(operand)
+	}
+	return true
+}
+
+// Run executes the program until it halts and returns everything it output
+// It fails for programs that are still running after MaxSteps instructions.
+func (c *Computer) Run() ([]int, error) {
+	return c.run(nil)
+}
+
+// Trace is like Run but first writes every instruction it executes, with the registers before it, to w
+func (c *Computer) Trace(w io.Writer) ([]int, error) {
+	return c.run(w)
+}
+
+// run executes the program, tracing to w unless it is nil
+func (c *Computer) run(w io.Writer) ([]int, error) {
+	for steps := 0; !c.Halted(); steps++ {
+		if steps == MaxSteps {
+			return c.Output, fmt.Errorf("still running after %d steps", MaxSteps)
+		}
+		if w != nil {
+			instruction := Instruction{Op: Opcode(c.Program[c.IP]), Operand: c.Program[c.IP+1]}
+			if _, err := fmt.Fprintf(w, "%2d  A=%d B=%d C=%d  %v\n", c.IP, c.A, c.B, c.C, instruction); err != nil {
+				return c.Output, err
+			}
+		}
+		c.Step()
+	}
+	return c.Output, nil
+}
+
+// Instruction is an opcode with its operand, the unit the disassembler works in
+type Instruction struct {
+	Op      Opcode
+	Operand int
+}
+
+// comboName returns how the disassembler writes a combo operand
+func comboName(operand int) string {
+	if operand >= 4 && operand <= 6 {
+		return string(rune('A' + operand - 4))
+	}
+	return strconv.Itoa(operand)
+}
+
+// String writes the instruction as its mnemonic and operand, registers by name, e.g. "cdv B"
+func (in Instruction) String() string {
+	if in.Op.takesCombo() {
+		return in.Op.String() + " " + comboName(in.Operand)
+	}
+	return in.Op.String() + " " + strconv.Itoa(in.Operand)
+}
+
+// Effect describes what the instruction does in pseudocode, e.g. "C = A >> B"
+func (in Instruction) Effect() string {
+	literal, combo := strconv.Itoa(in.Operand), comboName(in.Operand)
+	switch in.Op {
+	case Adv:
+		return "A = A >> " + combo
+	case Bxl:
+		return "B = B ^ " + literal
+	case Bst:
+		return "B = " + combo + " % 8"
+	case Jnz:
+		return "if A != 0 jump to " + literal
+	case Bxc:
+		return "B = B ^ C"
+	case Out:
+		return "output " + combo + " % 8"
+	case Bdv:
+		return "B = A >> " + combo
+	default:
+		return "C = A >> " + combo
+	}
+}
+
+// Disassemble writes the program one instruction per line, with its address and its effect, e.g.
+// " 4  cdv B  ; C = A >> B"
+func Disassemble(program []int) string {
+	var sb strings.Builder
+	for ip := 0; ip+1 < len(program); ip += 2 {
+		in := Instruction{Op: Opcode(program[ip]), Operand: program[ip+1]}
+		fmt.Fprintf(&sb, "%2d  %-5s  ; %s\n", ip, in, in.Effect())
+	}
+	return sb.String()
+}
+
+// Part1 runs the program and returns its output joined with commas
+// It fails for programs that do not halt within MaxSteps, rather than passing their output off as the answer.
+func Part1(c Computer) (string, error) {
+	output, err := c.Run()
+	if err != nil {
+		return "", err
+	}
+	return join(output), nil
+}
+
+// Quine finds the lowest initial value of register A that makes the program output itself
+// It relies on the shape of the puzzle's programs: a loop that outputs one value per pass, derives B and C
+// from A alone and shifts A right by 3 bits before jumping back while A is not 0. The last output then
+// only depends on the top 3 bits of A, the one before on the top 6 bits and so on, so A is built 3 bits
+// at a time from the top, keeping every choice that produces the matching end of the program.
+// It reports false when no such value exists.
+func (c Computer) Quine() (int, bool) {
+	var search func(a, matched int) (int, bool)
+	search = func(a, matched int) (int, bool) {
+		if matched == len(c.Program) {
+			return a, true
+		}
+		want := c.Program[len(c.Program)-matched-1:]
+		for bits := range 8 {
+			candidate := a<<3 | bits
+			run := Computer{Registers: Registers{A: candidate, B: c.B, C: c.C}, Program: c.Program}
+			if output, err := run.Run(); err == nil && slices.Equal(output, want) {
+				if found, ok := search(candidate, matched+1); ok {
+					return found, true
+				}
+			}
+		}
+		return 0, false
+	}
+	return search(0, 0)
+}
+
+// Part2 returns the lowest initial value of register A that makes the program output itself,
+// or 0 if there is none
+// Algorithm: depth-first search over the 3-bit digits of A from the most significant, see Quine
+//
+// Time complexity: O(8 × P²) runs of the program in practice, where P is its length
+// Space complexity: O(P)
+func Part2(c Computer) int {
+	a, ok := c.Quine()
+	if !ok {
+		return 0
+	}
+	return a
+}
@@ -0,0 +1,184 @@
+package day17
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
+)
+
+const quineExample = `Register A: 2024
+Register B: 0
+Register C: 0
+
+Program: 0,3,5,4,3,0`
+
+var puzzle = testkit.Puzzle[Computer]{
+	Day:   17,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Text(mustPart1),
+	Part2: testkit.Int(Part2),
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "4,6,3,5,6,3,5,2,1,0"},
+		{Name: "quine example", Input: quineExample, Part: 1, Want: "5,7,3,0"},
+		{Name: "quine example", Input: quineExample, Part: 2, Want: "117440"},
+		{Name: "no output", Input: "Register A: 0\nRegister B: 0\nRegister C: 9\n\nProgram: 2,6", Part: 1, Want: ""},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "no program", Input: "Register A: 1\nRegister B: 0\nRegister C: 0"},
+		{Name: "missing register", Input: "Register A: 1\nRegister B: 0\n\nProgram: 0,1"},
+		{Name: "registers out of order", Input: "Register B: 1\nRegister A: 0\nRegister C: 0\n\nProgram: 0,1"},
+		{Name: "negative register", Input: "Register A: -1\nRegister B: 0\nRegister C: 0\n\nProgram: 0,1"},
+		{Name: "empty program", Input: "Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: "},
+		{Name: "not 3-bit", Input: "Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 0,8"},
+		{Name: "missing operand", Input: "Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 0,1,5"},
+		{Name: "reserved combo operand", Input: "Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 5,7"},
+		{Name: "jump to operand", Input: "Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 0,1,3,1"},
+	},
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 14, Bytes: 2 << 10, PeakBytes: 16 << 10},
+		Part1: testkit.Budget{Allocs: 10, Bytes: 1 << 10, PeakBytes: 16 << 10},
+		Part2: testkit.Budget{Allocs: 1100, Bytes: 48 << 10, PeakBytes: 64 << 10},
+	},
+}
+
+// mustPart1 is like Part1 but panics on programs that do not halt, which none of the cases are
+func mustPart1(c Computer) string {
+	output, err := Part1(c)
+	if err != nil {
+		panic(err)
+	}
+	return output
+}
+
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
+}
+
+func TestPart1NeverHalts(t *testing.T) {
+	// A is never changed, so the program jumps back to the start until Run gives up
+	c := Computer{Registers: Registers{A: 1}, Program: []int{3, 0}}
+	if output, err := Part1(c); err == nil {
+		t.Errorf("Part1() = %q, nil for a program that never halts; want an error", output)
+	}
+}
+
+// TestRun runs the small examples of the puzzle text, which check the registers or the output afterwards
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		registers  Registers
+		program    []int
+		wantOutput []int
+		check      func(Registers) bool
+	}{
+		{"bst C", Registers{C: 9}, []int{2, 6}, nil, func(r Registers) bool { return r.B == 1 }},
+		{"out literals and A", Registers{A: 10}, []int{5, 0, 5, 1, 5, 4}, []int{0, 1, 2}, nil},
+		{"loop until A is 0", Registers{A: 2024}, []int{0, 1, 5, 4, 3, 0}, []int{4, 2, 5, 6, 7, 7, 7, 7, 3, 1, 0},
+			func(r Registers) bool { return r.A == 0 }},
+		{"bxl", Registers{B: 29}, []int{1, 7}, nil, func(r Registers) bool { return r.B == 26 }},
+		{"bxc", Registers{B: 2024, C: 43690}, []int{4, 0}, nil, func(r Registers) bool { return r.B == 44354 }},
+		{"bdv", Registers{A: 64, B: 0}, []int{6, 3}, nil, func(r Registers) bool { return r.B == 8 && r.A == 64 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Computer{Registers: tt.registers, Program: tt.program}
+			output, err := c.Run()
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if !slices.Equal(output, tt.wantOutput) {
+				t.Errorf("Run() = %v; want %v", output, tt.wantOutput)
+			}
+			if tt.check != nil && !tt.check(c.Registers) {
+				t.Errorf("Run() left registers %+v", c.Registers)
+			}
+		})
+	}
+}
+
+func TestRunStepLimit(t *testing.T) {
+	// A is never changed, so the program jumps back to the start forever
+	c := Computer{Registers: Registers{A: 1}, Program: []int{5, 4, 3, 0}}
+	if _, err := c.Run(); err == nil {
+		t.Error("Run() error = nil for a program that never halts")
+	}
+}
+
+func TestStep(t *testing.T) {
+	c := MustParse(ExampleInput)
+	steps := 0
+	for c.Step() {
+		steps++
+	}
+	// Ten passes through the three instructions, the last jump falling through
+	if steps != 30 || !c.Halted() {
+		t.Errorf("Step() ran %d instructions; want 30 and a halted computer", steps)
+	}
+	if c.Step() {
+		t.Error("Step() = true after the computer halted")
+	}
+}
+
+func TestQuine(t *testing.T) {
+	c := MustParse(DayInput)
+	a, ok := c.Quine()
+	if !ok {
+		t.Fatal("Quine() found no value for register A")
+	}
+
+	c.A = a
+	output, err := c.Run()
+	if err != nil || !slices.Equal(output, c.Program) {
+		t.Errorf("Run() with A = %d gives %v, %v; want the program %v", a, output, err, c.Program)
+	}
+}
+
+func TestDisassemble(t *testing.T) {
+	want := ` 0  bst A  ; B = A % 8
+ 2  bxl 3  ; B = B ^ 3
+ 4  cdv B  ; C = A >> B
+ 6  adv 3  ; A = A >> 3
+ 8  bxl 5  ; B = B ^ 5
+10  bxc 4  ; B = B ^ C
+12  out B  ; output B % 8
+14  jnz 0  ; if A != 0 jump to 0
+`
+	if got := Disassemble(MustParse(DayInput).Program); got != want {
+		t.Errorf("Disassemble() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	c := MustParse(quineExample)
+	var sb strings.Builder
+	output, err := c.Trace(&sb)
+	if err != nil {
+		t.Fatalf("Trace() error = %v", err)
+	}
+	if want := []int{5, 7, 3, 0}; !slices.Equal(output, want) {
+		t.Errorf("Trace() = %v; want %v", output, want)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(sb.String(), "\n"), "\n")
+	if len(lines) != 12 {
+		t.Errorf("Trace() wrote %d lines; want one per instruction, 12", len(lines))
+	}
+	if want := " 0  A=2024 B=0 C=0  adv 3"; lines[0] != want {
+		t.Errorf("Trace() first line = %q; want %q", lines[0], want)
+	}
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic,
+// and that parsing what String writes gives back the same computer
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, puzzle.RoundTrip(Computer.String))
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
@@ -0,0 +1,9 @@
+package day17
+
+// DayInput contains the puzzle input for day 17
+// Replace this with your actual puzzle input from https://adventofcode.com/2024/day/17/input
+const DayInput = `Register A: 47719761
+Register B: 0
+Register C: 0
+
+Program: 2,4,1,3,7,5,0,3,1,5,4,4,5,5,3,0`
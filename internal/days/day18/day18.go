@@ -0,0 +1,210 @@
+package day18
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/graph"
+	"github.com/amoilanen/advent-of-code-2024/internal/unionfind"
+	"github.com/amoilanen/advent-of-code-2024/internal/utils"
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
+)
+
+const ExampleInput = `5,4
+4,2
+4,5
+3,0
+2,1
+6,3
+2,4
+1,5
+0,6
+3,3
+2,6
+5,1
+1,2
+5,5
+2,5
+6,5
+1,4
+0,4
+6,4
+1,1
+6,1
+1,0
+0,5
+1,6
+2,0`
+
+// Size and Fallen describe the real memory space: Size by Size cells, of which the first Fallen bytes
+// corrupt some before Part1 looks for a path; the example uses 7 by 7 cells and 12 bytes
+const (
+	Size   = 71
+	Fallen = 1024
+)
+
+// Position represents a memory cell (X is the column, Y is the row)
+type Position = vector.Vec2
+
+// Parse parses the positions the bytes fall onto, in the order they fall, one "X,Y" pair per line
+func Parse(input string) ([]Position, error) {
+	var bytes []Position
+	for i, line := range utils.AsLines(input) {
+		xs, ys, found := strings.Cut(line, ",")
+		if !found {
+			return nil, fmt.Errorf("line %d: want X,Y, got %q", i+1, line)
+		}
+		x, err := strconv.Atoi(xs)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		y, err := strconv.Atoi(ys)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		if x < 0 || y < 0 {
+			return nil, fmt.Errorf("line %d: %d,%d is not a memory cell", i+1, x, y)
+		}
+		bytes = append(bytes, Position{X: x, Y: y})
+	}
+	return bytes, nil
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) []Position {
+	bytes, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return bytes
+}
+
+// Format writes the positions of the bytes in the format of the puzzle input
+func Format(bytes []Position) string {
+	lines := make([]string, len(bytes))
+	for i, b := range bytes {
+		lines[i] = fmt.Sprintf("%d,%d", b.X, b.Y)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// corrupt marks the cells of a size x size memory space that the bytes fall onto
+// Bytes outside the memory space are ignored.
+func corrupt(bytes []Position, size int) [][]bool {
+	corrupted := make([][]bool, size)
+	for y := range corrupted {
+		corrupted[y] = make([]bool, size)
+	}
+	for _, b := range bytes {
+		if b.InBounds(size, size) {
+			corrupted[b.Y][b.X] = true
+		}
+	}
+	return corrupted
+}
+
+// ShortestPath returns one shortest path from the top left to the bottom right corner of a size x size
+// memory space once the first fallen bytes have corrupted their cells, both corners included
+// It reports false when the corrupted cells cut the corners off from each other.
+func ShortestPath(bytes []Position, size, fallen int) ([]Position, bool) {
+	corrupted := corrupt(bytes[:min(fallen, len(bytes))], size)
+	start, exit := Position{X: 0, Y: 0}, Position{X: size - 1, Y: size - 1}
+	if size == 0 || corrupted[start.Y][start.X] || corrupted[exit.Y][exit.X] {
+		return nil, false
+	}
+
+	neighbors := func(pos Position) []Position {
+		var next []Position
+		for _, neighbor := range pos.Neighbors4() {
+			if neighbor.InBounds(size, size) && !corrupted[neighbor.Y][neighbor.X] {
+				next = append(next, neighbor)
+			}
+		}
+		return next
+	}
+	return graph.BFSTo(start, func(pos Position) bool { return pos == exit }, neighbors)
+}
+
+// FirstBlocking returns the first byte after which no path leads from the top left to the bottom right
+// corner of a size x size memory space
+// It reports false when a path is left even after every byte has fallen.
+//
+// Algorithm (reverse union-find): let every byte fall and unite the cells that are still free with their
+// free neighbours, then take the bytes back in reverse order. Freeing a cell unites it with its free
+// neighbours, and the first byte whose removal connects the corners is the one that cut them off.
+// A cell hit by several bytes is only freed once all of them have been taken back.
+//
+// Time complexity: O(B + S² × α(S²)) where B is the number of bytes and S the size
+// Space complexity: O(S²)
+func FirstBlocking(bytes []Position, size int) (Position, bool) {
+	index := func(pos Position) int { return pos.Y*size + pos.X }
+	hits := make([]int, size*size)
+	for _, b := range bytes {
+		if b.InBounds(size, size) {
+			hits[index(b)]++
+		}
+	}
+
+	cells := unionfind.New(size * size)
+	free := func(pos Position) {
+		for _, neighbor := range pos.Neighbors4() {
+			if neighbor.InBounds(size, size) && hits[index(neighbor)] == 0 {
+				cells.Union(index(pos), index(neighbor))
+			}
+		}
+	}
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			if pos := (Position{X: x, Y: y}); hits[index(pos)] == 0 {
+				free(pos)
+			}
+		}
+	}
+
+	start, exit := 0, size*size-1
+	connected := func() bool {
+		return hits[start] == 0 && hits[exit] == 0 && cells.Connected(start, exit)
+	}
+	if size == 0 || connected() {
+		return Position{}, false
+	}
+	for i := len(bytes) - 1; i >= 0; i-- {
+		b := bytes[i]
+		if !b.InBounds(size, size) {
+			continue
+		}
+		if hits[index(b)]--; hits[index(b)] > 0 {
+			continue
+		}
+		free(b)
+		if connected() {
+			return b, true
+		}
+	}
+	return Position{}, false
+}
+
+// Part1 returns the fewest steps from the top left to the bottom right corner of a size x size memory space
+// after the first fallen bytes, or 0 when there is no path
+// Algorithm: breadth-first search around the corrupted cells
+//
+// Time complexity: O(B + S²) where B is the number of bytes and S the size
+// Space complexity: O(S²)
+func Part1(bytes []Position, size, fallen int) int {
+	path, ok := ShortestPath(bytes, size, fallen)
+	if !ok {
+		return 0
+	}
+	return len(path) - 1
+}
+
+// Part2 returns the position of the first byte that cuts the exit off, as "X,Y", or an empty string
+// if the exit stays reachable; see FirstBlocking for the algorithm
+func Part2(bytes []Position, size int) string {
+	b, ok := FirstBlocking(bytes, size)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d,%d", b.X, b.Y)
+}
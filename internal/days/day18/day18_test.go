@@ -0,0 +1,141 @@
+package day18
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
+)
+
+// The example falls onto a 7 by 7 memory space and Part1 looks at the first 12 bytes, so it cannot be a
+// testkit.Case; TestPart1 and TestPart2 cover it instead
+var puzzle = testkit.Puzzle[[]Position]{
+	Day:   18,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(func(bytes []Position) int { return Part1(bytes, Size, Fallen) }),
+	Part2: testkit.Text(func(bytes []Position) string { return Part2(bytes, Size) }),
+	Malformed: []testkit.Malformed{
+		{Name: "no comma", Input: "5,4\n42"},
+		{Name: "letter", Input: "5,4\n4,x"},
+		{Name: "negative", Input: "5,4\n-4,2"},
+		{Name: "blank line", Input: "5,4\n\n4,2"},
+	},
+	Seeds: []string{ExampleInput},
+	// Part 2 unites cells once rather than searching for a path after every byte, which these budgets would catch
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 20, Bytes: 448 << 10, PeakBytes: 576 << 10},
+		Part1: testkit.Budget{Allocs: 10_500, Bytes: 3 << 20, PeakBytes: 4 << 20},
+		Part2: testkit.Budget{Allocs: 3300, Bytes: 512 << 10, PeakBytes: 768 << 10},
+	},
+}
+
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
+}
+
+func TestPart1(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		size   int
+		fallen int
+		want   int
+	}{
+		{"example", ExampleInput, 7, 12, 22},
+		{"nothing fallen", ExampleInput, 7, 0, 12},
+		{"exit cut off", ExampleInput, 7, 21, 0},
+		{"more bytes than there are", "1,1", 3, 10, 4},
+		{"bytes outside the memory space", "5,5\n0,7", 3, 2, 4},
+		{"start corrupted", "0,0", 3, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Part1(MustParse(tt.input), tt.size, tt.fallen); got != tt.want {
+				t.Errorf("Part1() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPart2(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		size  int
+		want  string
+	}{
+		{"example", ExampleInput, 7, "6,1"},
+		{"exit stays reachable", "1,1\n2,0", 3, ""},
+		{"exit hit", "1,1\n2,2", 3, "2,2"},
+		// The second byte on 1,0 changes nothing, the byte on 0,1 then walls the start in
+		{"same cell twice", "1,0\n1,0\n0,1", 3, "0,1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Part2(MustParse(tt.input), tt.size); got != tt.want {
+				t.Errorf("Part2() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShortestPath(t *testing.T) {
+	bytes := MustParse(ExampleInput)
+	path, ok := ShortestPath(bytes, 7, 12)
+	if !ok || len(path) != 23 {
+		t.Fatalf("ShortestPath() = %v, %v; want 22 steps", path, ok)
+	}
+
+	corrupted := corrupt(bytes[:12], 7)
+	if path[0] != (Position{X: 0, Y: 0}) || path[len(path)-1] != (Position{X: 6, Y: 6}) {
+		t.Errorf("ShortestPath() runs from %v to %v; want from 0,0 to 6,6", path[0], path[len(path)-1])
+	}
+	for i, pos := range path {
+		if corrupted[pos.Y][pos.X] || (i > 0 && pos.Manhattan(path[i-1]) != 1) {
+			t.Errorf("ShortestPath() steps from %v to %v", path[max(i-1, 0)], pos)
+		}
+	}
+}
+
+// referenceFirstBlocking lets the bytes fall one at a time and searches for a path after each of them
+func referenceFirstBlocking(bytes []Position, size int) (Position, bool) {
+	for fallen := 1; fallen <= len(bytes); fallen++ {
+		if _, ok := ShortestPath(bytes, size, fallen); !ok {
+			return bytes[fallen-1], true
+		}
+	}
+	return Position{}, false
+}
+
+// TestFirstBlockingMatchesReference compares the reverse union-find with searching for a path after every byte
+func TestFirstBlockingMatchesReference(t *testing.T) {
+	rng := rand.New(rand.NewPCG(18, 2024))
+	for range 2000 {
+		size := 1 + rng.IntN(8)
+		// Some bytes land on the same cell twice or outside the memory space
+		bytes := make([]Position, rng.IntN(size*size+3))
+		for i := range bytes {
+			bytes[i] = Position{X: rng.IntN(size + 1), Y: rng.IntN(size + 1)}
+		}
+
+		got, gotOK := FirstBlocking(bytes, size)
+		want, wantOK := referenceFirstBlocking(bytes, size)
+		if got != want || gotOK != wantOK {
+			t.Fatalf("FirstBlocking(%v, %d) = %v, %v, want %v, %v", bytes, size, got, gotOK, want, wantOK)
+		}
+	}
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic,
+// and that parsing what Format writes gives back the same bytes
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, puzzle.RoundTrip(Format))
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
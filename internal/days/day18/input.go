@@ -0,0 +1,3454 @@
+package day18
+
+// DayInput contains the puzzle input for day 18
+// Replace this with your actual puzzle input from https://adventofcode.com/2024/day/18/input
+const DayInput = `43,28
+59,66
+39,51
+51,8
+37,67
+21,65
+7,51
+39,21
+43,8
+25,17
+67,20
+7,45
+3,1
+45,15
+38,3
+53,68
+57,41
+27,59
+9,51
+18,3
+19,53
+27,35
+38,61
+19,9
+9,14
+27,57
+3,53
+24,53
+56,5
+38,59
+55,39
+39,10
+59,53
+67,29
+10,39
+60,37
+63,17
+13,29
+55,1
+13,5
+27,25
+69,34
+21,1
+44,55
+52,23
+13,61
+59,47
+47,15
+7,23
+29,25
+17,57
+43,52
+5,61
+68,51
+33,46
+41,63
+39,69
+15,55
+23,25
+59,68
+25,67
+9,67
+61,40
+15,33
+19,8
+43,7
+19,41
+49,22
+45,69
+53,66
+30,7
+55,33
+23,57
+57,32
+49,31
+47,44
+28,43
+24,1
+39,49
+8,65
+39,15
+41,17
+57,52
+30,31
+35,13
+1,16
+65,16
+67,46
+61,12
+47,19
+41,58
+45,43
+53,28
+5,31
+32,67
+19,37
+13,21
+51,39
+23,23
+61,51
+7,63
+12,51
+2,67
+33,39
+15,59
+43,69
+17,56
+3,28
+19,18
+53,36
+23,37
+37,12
+35,62
+3,19
+45,41
+63,51
+61,19
+35,29
+37,54
+55,31
+63,65
+11,37
+66,21
+70,5
+54,5
+57,39
+53,62
+9,43
+3,47
+3,3
+59,24
+23,55
+31,64
+69,13
+11,19
+22,9
+6,51
+7,48
+46,51
+33,14
+65,21
+59,69
+25,41
+47,3
+61,55
+9,49
+25,66
+11,27
+20,9
+4,59
+66,3
+38,39
+2,3
+59,37
+51,18
+29,14
+68,21
+56,55
+40,59
+58,17
+5,18
+39,3
+31,29
+64,63
+17,47
+33,40
+33,24
+19,43
+35,55
+34,19
+21,66
+69,42
+9,8
+38,7
+53,19
+51,19
+41,68
+6,55
+15,9
+67,7
+50,39
+53,13
+10,3
+41,11
+69,7
+55,9
+69,59
+5,25
+35,67
+46,35
+1,61
+37,62
+4,1
+9,45
+69,60
+51,57
+31,21
+33,57
+53,26
+63,5
+44,5
+37,7
+68,17
+46,61
+10,17
+55,3
+64,13
+33,55
+43,51
+62,65
+45,2
+45,23
+39,35
+29,13
+59,45
+23,24
+38,51
+5,10
+29,51
+4,65
+5,66
+31,12
+49,45
+7,56
+9,33
+17,22
+35,52
+3,38
+61,69
+19,57
+33,63
+33,7
+13,9
+65,9
+3,11
+44,41
+47,10
+11,5
+69,23
+38,29
+31,45
+57,53
+11,45
+55,42
+15,20
+37,63
+21,67
+15,16
+29,63
+9,65
+41,45
+18,45
+35,50
+63,19
+17,50
+61,5
+49,37
+41,3
+35,66
+36,67
+12,63
+63,15
+1,70
+17,33
+25,47
+48,27
+67,5
+53,24
+24,21
+1,10
+25,65
+11,12
+41,57
+51,5
+35,47
+61,1
+45,49
+43,49
+31,30
+1,30
+35,51
+11,51
+22,3
+51,31
+50,65
+10,1
+50,37
+13,26
+23,63
+3,59
+67,67
+48,63
+55,49
+32,65
+68,45
+36,13
+13,1
+1,65
+47,35
+3,20
+39,45
+31,1
+13,55
+21,28
+45,19
+52,39
+47,0
+6,53
+31,10
+43,27
+39,46
+43,18
+27,63
+43,30
+43,63
+25,68
+13,10
+15,68
+27,53
+43,55
+41,61
+55,32
+1,3
+28,19
+69,24
+55,6
+34,9
+35,36
+11,6
+3,43
+58,51
+37,61
+5,69
+67,65
+1,60
+9,15
+63,4
+9,6
+26,53
+0,3
+29,27
+63,14
+5,32
+3,67
+32,33
+43,32
+39,42
+58,49
+12,37
+27,3
+66,63
+63,61
+49,44
+64,23
+40,69
+7,11
+53,38
+49,41
+55,27
+51,3
+62,11
+53,46
+21,29
+5,63
+45,48
+3,15
+3,35
+19,45
+26,35
+11,31
+26,1
+12,23
+14,7
+67,30
+11,7
+59,15
+8,43
+11,65
+13,19
+61,49
+43,29
+43,66
+42,39
+30,59
+21,5
+61,33
+49,17
+34,7
+35,18
+41,15
+27,61
+60,9
+63,54
+9,53
+59,67
+55,18
+27,21
+45,14
+49,1
+70,47
+51,61
+69,15
+50,55
+26,45
+57,1
+1,54
+59,23
+29,52
+27,11
+0,5
+9,3
+30,69
+15,49
+6,13
+57,36
+5,57
+7,42
+11,39
+11,11
+11,64
+61,23
+61,31
+15,52
+65,29
+42,49
+47,40
+65,46
+42,65
+36,27
+38,69
+61,25
+35,24
+37,41
+19,11
+25,63
+2,33
+45,64
+8,11
+47,65
+32,15
+63,29
+34,37
+17,39
+58,59
+63,45
+27,45
+57,10
+21,63
+50,45
+15,58
+6,5
+8,7
+46,41
+37,33
+37,69
+35,49
+33,48
+9,25
+32,35
+48,3
+45,26
+27,65
+49,7
+41,44
+57,45
+53,7
+19,32
+63,13
+63,68
+65,30
+59,34
+53,17
+11,57
+7,37
+3,7
+45,61
+43,31
+69,43
+57,16
+49,2
+60,69
+45,59
+37,25
+61,59
+5,16
+17,58
+64,39
+67,58
+69,65
+13,15
+12,29
+33,19
+55,70
+53,41
+46,11
+27,60
+31,37
+29,40
+57,42
+25,57
+43,45
+43,19
+31,17
+43,39
+47,47
+43,68
+6,47
+9,4
+37,55
+13,3
+13,14
+29,59
+11,63
+57,59
+51,70
+24,57
+14,33
+39,27
+11,53
+11,16
+56,27
+12,45
+22,19
+52,13
+45,33
+59,35
+23,20
+33,56
+52,35
+63,56
+67,2
+61,54
+23,35
+5,34
+36,37
+7,19
+14,35
+65,33
+63,23
+69,4
+57,65
+27,12
+17,66
+33,25
+43,37
+7,43
+17,17
+49,21
+10,57
+60,13
+11,9
+65,18
+9,31
+63,3
+25,34
+33,37
+63,2
+9,21
+47,17
+26,19
+65,44
+36,55
+39,64
+66,13
+69,19
+48,7
+21,34
+15,53
+59,21
+11,15
+18,29
+43,36
+67,10
+27,43
+47,8
+65,61
+11,69
+63,52
+61,62
+17,52
+35,25
+67,60
+28,69
+37,36
+13,34
+56,31
+57,21
+1,0
+61,30
+41,9
+10,21
+59,11
+40,23
+40,37
+29,31
+55,20
+25,19
+55,64
+57,67
+53,31
+8,39
+23,1
+41,19
+7,33
+30,23
+19,39
+19,31
+62,49
+55,30
+1,55
+17,4
+41,5
+30,67
+37,19
+45,42
+67,4
+35,59
+31,48
+45,12
+26,27
+1,47
+57,12
+23,69
+55,40
+11,29
+54,43
+57,61
+64,41
+25,15
+31,69
+29,61
+67,33
+64,37
+29,38
+49,20
+20,23
+43,22
+63,39
+21,38
+65,47
+47,39
+18,53
+16,1
+23,61
+11,21
+43,25
+52,69
+41,29
+5,1
+55,19
+5,65
+59,2
+61,15
+17,51
+65,69
+43,17
+49,33
+63,55
+13,66
+15,2
+37,45
+36,59
+33,11
+14,51
+18,39
+55,12
+44,1
+25,5
+52,63
+53,15
+53,23
+35,65
+7,69
+17,68
+50,25
+29,2
+58,53
+27,49
+21,39
+23,41
+41,60
+61,53
+43,46
+8,13
+61,57
+9,40
+37,48
+67,59
+33,61
+53,44
+40,11
+49,52
+29,41
+10,41
+49,24
+35,56
+26,43
+8,31
+32,21
+25,14
+41,50
+17,41
+46,29
+17,60
+59,55
+22,47
+69,29
+37,27
+65,57
+27,22
+1,39
+54,59
+3,30
+28,23
+69,51
+50,29
+67,69
+69,62
+23,8
+69,3
+2,1
+61,11
+13,59
+13,2
+7,16
+35,37
+67,21
+23,31
+49,23
+5,9
+29,37
+65,58
+43,61
+22,13
+59,63
+39,29
+45,11
+58,29
+61,39
+33,18
+7,30
+9,36
+1,31
+66,7
+9,62
+36,5
+27,9
+47,29
+2,57
+5,49
+9,35
+22,51
+49,58
+10,53
+63,69
+12,59
+11,25
+27,10
+53,1
+30,11
+14,31
+56,49
+25,49
+33,16
+24,11
+14,67
+29,17
+69,47
+33,23
+25,22
+5,4
+38,19
+8,55
+54,39
+17,69
+32,61
+66,39
+69,37
+7,41
+57,8
+51,64
+31,67
+66,11
+21,16
+29,4
+47,49
+22,15
+52,51
+11,35
+34,31
+35,53
+10,11
+68,55
+41,16
+68,13
+5,11
+33,33
+23,46
+41,41
+37,57
+39,38
+31,43
+51,13
+65,55
+13,13
+25,48
+43,34
+22,5
+3,48
+67,42
+59,1
+51,47
+53,20
+51,11
+11,44
+40,19
+21,21
+17,15
+47,11
+30,9
+58,41
+45,70
+4,27
+33,21
+70,59
+50,19
+43,59
+37,42
+15,11
+25,11
+7,49
+65,27
+3,45
+5,12
+25,31
+29,1
+55,21
+39,12
+38,27
+8,3
+13,24
+6,37
+37,5
+37,31
+20,3
+65,59
+65,68
+52,5
+17,23
+50,7
+20,25
+58,55
+51,33
+14,57
+17,19
+21,32
+35,61
+27,8
+24,17
+7,70
+47,7
+0,29
+26,5
+62,47
+23,62
+35,27
+61,0
+67,64
+44,11
+32,31
+63,37
+38,35
+55,53
+19,69
+13,65
+1,25
+60,31
+33,28
+45,1
+53,10
+23,15
+6,65
+15,27
+63,60
+10,47
+53,63
+59,9
+56,45
+55,41
+29,57
+21,13
+6,59
+39,67
+65,17
+17,65
+49,53
+15,17
+2,61
+47,28
+28,49
+19,20
+36,43
+11,32
+62,9
+49,25
+17,9
+42,17
+48,69
+45,35
+62,35
+62,23
+33,47
+37,32
+49,61
+19,63
+37,43
+67,23
+37,16
+5,27
+32,59
+55,65
+12,39
+51,55
+35,35
+15,63
+49,19
+49,10
+60,65
+65,63
+65,5
+23,45
+37,53
+5,7
+60,7
+34,11
+6,57
+31,19
+27,30
+55,54
+58,69
+51,63
+14,17
+47,27
+13,20
+26,13
+33,1
+30,15
+69,5
+5,3
+35,28
+9,57
+52,3
+45,47
+67,68
+21,31
+69,22
+13,37
+50,15
+1,41
+19,23
+1,8
+57,19
+67,55
+35,43
+67,49
+69,10
+39,52
+5,59
+19,17
+17,55
+58,5
+59,29
+17,45
+25,51
+21,19
+49,27
+14,37
+4,43
+55,23
+69,56
+57,43
+27,31
+19,56
+65,43
+65,8
+12,69
+29,9
+45,13
+45,32
+25,36
+31,4
+61,38
+59,44
+45,67
+23,66
+23,53
+41,67
+33,54
+5,47
+15,51
+9,55
+67,17
+23,5
+4,45
+37,65
+13,56
+37,51
+53,30
+23,59
+7,3
+47,54
+19,54
+3,33
+8,33
+35,46
+16,61
+41,13
+1,44
+31,38
+59,7
+32,5
+37,22
+25,13
+45,4
+31,60
+12,5
+61,47
+3,41
+1,21
+47,38
+63,59
+29,11
+27,16
+30,45
+3,51
+37,10
+65,1
+47,33
+55,59
+67,41
+9,60
+41,7
+45,21
+35,33
+1,29
+55,29
+9,29
+67,24
+55,43
+13,42
+55,51
+41,36
+25,50
+59,22
+7,35
+2,21
+17,59
+29,36
+63,27
+7,27
+35,23
+21,23
+53,55
+15,35
+56,51
+23,13
+63,70
+54,9
+47,23
+11,59
+22,55
+1,26
+51,69
+39,9
+1,37
+5,23
+4,61
+11,23
+35,15
+48,65
+51,45
+41,32
+2,65
+47,57
+45,5
+23,43
+33,13
+67,11
+59,59
+49,62
+7,55
+25,21
+26,57
+57,62
+9,61
+3,69
+30,53
+18,7
+54,53
+62,67
+45,52
+29,28
+53,37
+3,14
+31,56
+40,33
+53,29
+7,59
+35,31
+56,21
+7,5
+7,31
+19,68
+17,14
+65,35
+29,35
+1,45
+7,47
+31,7
+29,26
+49,63
+2,39
+5,50
+65,60
+22,25
+25,8
+45,29
+63,42
+51,27
+14,3
+34,67
+17,16
+57,3
+61,34
+69,41
+31,51
+25,46
+41,54
+1,59
+20,69
+29,29
+67,61
+64,11
+45,63
+3,31
+4,23
+68,53
+15,54
+27,62
+3,57
+12,57
+15,29
+44,15
+57,7
+67,51
+25,26
+9,39
+29,55
+28,11
+39,25
+57,35
+15,6
+65,36
+3,40
+54,25
+9,69
+41,33
+29,19
+15,21
+45,44
+13,31
+67,47
+33,45
+33,69
+39,16
+32,19
+37,26
+67,57
+69,39
+51,66
+45,54
+41,53
+28,37
+7,65
+69,8
+65,26
+16,31
+17,61
+53,45
+27,39
+67,25
+49,39
+39,43
+27,37
+51,7
+34,53
+60,19
+11,14
+1,33
+55,11
+25,32
+67,53
+52,55
+39,31
+27,13
+1,46
+26,59
+29,45
+18,35
+31,23
+68,1
+55,57
+29,7
+50,27
+29,15
+43,11
+44,43
+19,40
+25,23
+9,46
+15,26
+31,15
+65,19
+9,19
+33,15
+29,33
+20,29
+69,61
+13,12
+39,4
+3,37
+57,28
+32,7
+45,30
+29,49
+5,51
+47,59
+45,18
+63,44
+51,15
+3,4
+36,3
+4,47
+47,55
+16,39
+51,67
+16,35
+1,13
+44,33
+17,67
+65,41
+61,29
+61,46
+39,11
+6,27
+61,50
+31,39
+59,41
+49,15
+9,47
+30,17
+65,56
+30,33
+67,6
+19,59
+0,37
+53,16
+21,57
+65,37
+10,61
+49,69
+29,30
+37,40
+53,43
+1,51
+65,23
+47,32
+19,26
+41,34
+37,3
+4,55
+16,9
+55,62
+11,55
+5,19
+59,19
+12,53
+19,38
+3,6
+13,35
+47,67
+7,21
+35,39
+53,48
+1,53
+49,35
+40,63
+55,47
+46,3
+69,55
+11,8
+33,51
+21,43
+46,65
+23,47
+65,3
+67,43
+12,15
+39,61
+50,11
+41,1
+21,58
+47,60
+7,25
+65,39
+39,40
+54,15
+19,1
+33,65
+36,9
+39,1
+37,49
+2,15
+47,45
+17,64
+69,57
+55,67
+55,55
+50,67
+53,56
+15,69
+15,31
+31,49
+21,37
+19,3
+65,31
+25,10
+57,24
+41,2
+27,15
+60,29
+63,11
+20,35
+1,49
+34,5
+51,16
+57,5
+3,61
+25,39
+42,9
+46,23
+66,17
+17,53
+12,1
+4,31
+17,6
+54,65
+51,23
+9,59
+15,3
+63,57
+9,37
+46,9
+5,53
+48,41
+21,47
+27,47
+28,1
+35,58
+41,59
+17,27
+47,63
+67,12
+11,33
+51,51
+43,15
+24,29
+42,25
+6,25
+31,24
+37,59
+23,7
+6,29
+45,27
+37,0
+59,5
+31,27
+26,3
+1,17
+11,47
+25,33
+28,47
+33,67
+38,13
+60,49
+67,3
+41,14
+61,41
+3,54
+1,35
+5,17
+47,13
+11,17
+55,0
+7,53
+51,9
+28,25
+43,67
+67,63
+43,41
+23,19
+69,70
+23,52
+57,48
+49,54
+22,43
+13,44
+13,43
+59,13
+15,18
+19,61
+34,41
+43,35
+3,18
+41,55
+2,51
+33,53
+21,69
+19,51
+29,43
+31,3
+47,69
+40,47
+47,43
+24,31
+57,64
+9,66
+19,29
+66,35
+9,7
+63,63
+19,14
+39,6
+69,9
+9,48
+14,61
+37,17
+8,23
+39,33
+21,9
+22,17
+14,13
+13,27
+61,67
+7,7
+63,64
+15,57
+65,49
+43,9
+1,58
+69,53
+23,49
+8,19
+1,15
+32,27
+49,29
+29,6
+49,46
+46,25
+35,9
+45,25
+43,5
+69,67
+39,24
+31,40
+61,65
+65,66
+25,59
+45,16
+13,39
+53,49
+39,22
+27,29
+40,65
+54,21
+3,49
+32,47
+31,62
+25,62
+42,43
+59,51
+10,31
+63,28
+65,45
+31,52
+34,21
+1,67
+63,9
+60,17
+25,40
+7,1
+22,35
+60,53
+27,27
+21,11
+27,54
+69,1
+27,41
+35,21
+15,15
+1,52
+47,51
+61,42
+13,62
+37,34
+7,9
+1,42
+68,15
+50,35
+9,17
+33,29
+61,27
+19,2
+37,20
+21,64
+61,13
+57,2
+55,45
+18,41
+28,67
+31,42
+51,22
+54,35
+13,63
+60,27
+9,27
+31,41
+7,15
+56,3
+49,43
+49,32
+55,58
+5,2
+65,0
+61,61
+39,19
+44,67
+27,7
+11,52
+11,42
+39,5
+3,17
+43,48
+17,25
+70,15
+65,25
+37,37
+17,24
+48,31
+34,63
+57,34
+15,44
+31,54
+37,23
+39,41
+13,11
+69,35
+47,52
+51,60
+54,23
+29,44
+3,5
+63,7
+4,37
+1,27
+47,50
+16,49
+3,27
+41,40
+63,25
+33,9
+45,65
+26,49
+43,57
+55,7
+69,63
+39,47
+17,20
+35,57
+45,45
+29,21
+28,7
+41,35
+2,25
+41,30
+57,11
+48,35
+31,57
+68,31
+11,18
+57,18
+32,57
+57,15
+70,51
+14,49
+51,29
+25,29
+19,7
+44,47
+25,6
+40,29
+41,65
+59,4
+59,33
+22,29
+43,3
+13,60
+20,45
+59,65
+21,44
+62,59
+32,9
+59,60
+44,25
+25,53
+58,45
+8,37
+21,68
+21,0
+62,31
+5,20
+15,5
+7,2
+21,33
+3,62
+37,64
+45,24
+19,10
+57,47
+57,69
+14,65
+38,57
+53,59
+37,1
+51,6
+20,17
+7,39
+62,27
+1,19
+5,45
+49,68
+51,58
+56,59
+60,3
+13,33
+40,49
+17,18
+54,33
+5,35
+47,25
+13,4
+48,23
+5,21
+39,54
+35,3
+39,65
+51,48
+62,7
+61,17
+45,20
+21,22
+61,58
+26,69
+36,23
+43,50
+40,45
+60,43
+19,49
+65,11
+57,63
+9,13
+59,3
+9,23
+41,47
+16,33
+34,3
+10,27
+59,31
+58,21
+25,1
+61,56
+35,32
+59,57
+4,35
+21,7
+12,67
+20,41
+31,59
+63,20
+63,67
+3,39
+52,19
+65,48
+63,35
+17,7
+42,11
+24,43
+59,17
+15,39
+13,18
+22,39
+5,55
+47,5
+35,1
+57,27
+15,25
+7,34
+3,29
+41,49
+3,13
+35,45
+41,56
+21,56
+63,43
+13,7
+67,32
+4,57
+18,63
+17,29
+23,65
+22,11
+19,67
+54,3
+63,21
+25,55
+37,46
+69,36
+51,65
+48,49
+7,29
+67,28
+45,55
+65,34
+58,65
+53,8
+20,49
+65,22
+51,42
+51,1
+25,61
+69,11
+55,35
+5,41
+47,46
+46,67
+23,33
+34,69
+25,18
+46,47
+7,17
+55,36
+42,3
+55,25
+29,3
+8,9
+55,37
+34,1
+43,13
+21,52
+46,21
+67,56
+12,31
+31,53
+51,43
+25,24
+45,51
+27,4
+49,14
+11,24
+67,27
+15,37
+17,21
+53,14
+42,5
+43,23
+25,3
+23,17
+49,9
+15,61
+55,15
+3,9
+21,35
+57,51
+33,26
+35,48
+17,49
+3,21
+25,64
+61,45
+59,27
+1,40
+1,63
+38,1
+39,17
+40,67
+47,62
+9,5
+60,47
+50,61
+13,48
+53,51
+22,21
+33,3
+40,61
+3,8
+23,11
+21,53
+31,25
+7,57
+2,23
+55,13
+28,55
+35,64
+61,22
+35,70
+63,1
+24,61
+67,13
+8,25
+23,21
+1,69
+19,65
+68,37
+51,41
+39,14
+19,5
+43,21
+29,62
+37,18
+53,21
+69,49
+43,47
+29,65
+33,41
+26,51
+1,23
+21,51
+31,26
+65,53
+67,34
+7,18
+6,41
+47,6
+25,9
+27,5
+3,25
+68,7
+45,3
+6,21
+17,36
+19,21
+20,47
+6,7
+21,55
+9,22
+13,49
+53,57
+59,40
+59,10
+5,24
+57,57
+49,47
+21,15
+11,43
+3,55
+17,35
+9,9
+40,21
+44,59
+21,54
+49,67
+50,1
+33,44
+45,36
+67,40
+16,27
+69,27
+68,67
+5,67
+1,1
+33,2
+57,9
+39,39
+37,44
+15,47
+30,49
+21,6
+43,53
+33,34
+63,62
+19,27
+56,23
+51,53
+49,65
+53,47
+9,28
+29,58
+39,55
+23,67
+9,50
+55,69
+49,13
+61,37
+16,11
+37,66
+63,26
+31,63
+12,21
+23,12
+59,49
+5,22
+0,21
+66,53
+19,62
+57,25
+16,13
+49,42
+1,9
+45,9
+36,51
+29,53
+4,69
+49,30
+3,26
+9,26
+19,15
+39,56
+55,63
+11,13
+63,49
+29,46
+61,21
+39,13
+60,57
+31,0
+0,49
+57,68
+41,23
+40,1
+41,51
+5,15
+13,23
+15,23
+53,53
+7,44
+52,11
+56,61
+31,33
+31,55
+49,51
+9,68
+53,69
+24,27
+28,61
+23,36
+67,35
+33,17
+2,47
+10,69
+65,15
+5,68
+14,29
+48,13
+55,17
+5,29
+47,1
+41,52
+11,50
+41,39
+15,8
+65,4
+57,23
+49,49
+31,47
+7,52
+58,33
+52,41
+47,37
+29,56
+19,66
+62,45
+18,27
+23,2
+35,30
+35,19
+49,4
+39,57
+52,29
+66,49
+3,42
+10,33
+16,47
+25,43
+68,49
+53,67
+59,62
+54,13
+61,63
+15,46
+70,41
+2,63
+63,31
+6,9
+35,63
+37,47
+23,38
+13,53
+13,57
+41,31
+67,15
+49,11
+43,1
+21,25
+45,17
+62,5
+37,15
+11,67
+45,57
+69,31
+63,47
+53,33
+42,23
+45,56
+29,69
+27,67
+27,55
+17,70
+52,31
+47,68
+9,11
+36,7
+21,41
+45,39
+65,7
+67,19
+59,36
+35,17
+15,7
+65,67
+14,41
+54,47
+15,1
+51,26
+67,1
+11,49
+18,23
+53,2
+22,41
+25,25
+15,38
+42,63
+11,41
+56,15
+32,69
+59,26
+21,30
+63,32
+59,61
+56,9
+31,11
+27,17
+47,21
+47,31
+67,39
+25,7
+9,44
+36,15
+31,31
+52,33
+19,33
+10,55
+41,21
+33,42
+65,42
+7,13
+16,43
+2,19
+9,63
+33,22
+38,49
+24,55
+27,64
+31,13
+63,41
+13,28
+16,63
+29,5
+59,25
+48,57
+9,1
+37,21
+35,41
+31,61
+64,9
+54,51
+7,28
+17,3
+68,19
+24,41
+65,51
+25,45
+31,9
+39,23
+8,15
+57,26
+51,50
+45,58
+21,27
+1,5
+34,15
+23,64
+35,11
+33,38
+58,39
+21,61
+11,3
+51,35
+23,9
+23,29
+43,62
+5,37
+61,35
+58,11
+33,43
+15,10
+51,44
+65,13
+9,58
+51,21
+27,38
+6,45
+15,19
+35,40
+44,7
+19,13
+15,41
+70,29
+11,1
+27,40
+35,5
+53,11
+55,68
+16,55
+17,13
+57,17
+13,41
+13,45
+43,60
+62,51
+64,29
+19,35
+68,65
+57,33
+53,39
+63,6
+49,59
+7,67
+19,47
+37,39
+7,61
+27,42
+0,63
+30,21
+23,51
+5,64
+1,7
+69,25
+8,49
+47,14
+17,63
+27,69
+5,39
+31,2
+3,63
+5,33
+31,65
+23,39
+65,32
+69,26
+55,61
+62,21
+18,49
+19,19
+16,41
+8,53
+3,36
+21,49
+41,25
+46,17
+5,5
+4,15
+37,35
+15,64
+53,0
+49,5
+23,50
+3,12
+33,50
+67,26
+69,33
+9,41
+21,60
+8,59
+20,51
+23,3
+40,5
+57,58
+41,43
+3,23
+18,11
+28,33
+55,5
+49,57
+10,65
+19,48
+41,27
+19,42
+15,22
+15,13
+13,51
+59,43
+59,20
+43,43
+4,7
+33,12
+34,59
+7,68
+11,34
+15,24
+47,61
+67,9
+52,47
+61,3
+17,11
+57,29
+57,55
+45,7
+62,25
+21,59
+65,65
+21,3
+59,6
+1,11
+19,55
+24,5
+42,13
+33,35
+53,27
+62,37
+13,46
+0,13
+65,54
+68,47
+61,7
+66,65
+1,43
+17,43
+15,30
+49,12
+18,31
+33,27
+24,45
+47,34
+23,32
+17,37
+42,27
+67,44
+61,16
+2,35
+59,39
+57,49
+25,27
+69,17
+67,38
+1,32
+57,46
+25,16
+53,61
+2,11
+65,50
+9,0
+41,69
+65,24
+31,5
+29,20
+25,69
+53,60
+39,63
+63,18
+37,11
+63,53
+27,1
+21,17
+58,15
+45,53
+69,45
+67,45
+5,13
+27,19
+65,52
+15,67
+53,35
+47,56
+29,67
+67,31
+47,9
+37,29
+64,57
+11,10
+41,42
+45,38
+15,43
+39,7
+17,31
+70,67
+13,67
+53,25
+53,5
+35,7
+53,65
+11,61
+25,37
+9,20
+64,1
+63,33
+39,37
+45,31
+41,8
+29,39
+69,21
+17,46
+28,51
+17,5
+27,23
+3,65
+33,31
+31,36
+42,57
+47,41
+21,62
+6,61
+60,63
+27,66
+37,13
+19,25
+1,68
+56,39
+7,62
+29,64
+29,23
+21,26
+13,47
+61,9
+59,14
+38,67
+33,5
+51,37
+21,45
+49,3
+23,68
+17,1
+51,49
+57,56
+39,59
+6,39
+13,69
+27,33
+67,62
+37,24
+33,59
+4,51
+1,50
+15,65
+49,48
+28,27
+45,6
+18,59
+33,49
+53,3
+37,9
+28,15
+49,55
+43,65
+29,18
+51,25
+39,53
+35,69
+50,57
+66,15
+43,33
+26,29
+69,28
+62,17
+1,57
+41,37
+42,19
+18,15
+31,44
+24,59
+44,21
+15,45
+31,35
+38,31
+23,27
+27,51
+57,37
+13,25
+40,27
+9,24
+20,5
+63,34
+57,31
+69,69
+9,64
+20,13
+13,17
+49,16
+27,32
+47,53
+23,58
+57,13
+35,34
+69,38
+20,59
+48,19
+51,52
+51,17
+11,36
+29,47
+43,38
+53,9
+61,43
+25,35
+51,59
+53,54
+48,37
+5,43
+25,38
+45,37
+67,37
+67,16
+2,10
+44,34
+30,41
+58,38
+2,5
+28,10
+54,57
+36,49
+60,12
+54,12
+38,63
+18,64
+31,28
+48,55
+0,22
+62,28
+62,24
+55,8
+21,48
+6,58
+56,38
+62,12
+16,14
+50,70
+45,50
+35,0
+58,70
+40,41
+49,8
+33,62
+59,28
+66,30
+26,46
+39,34
+27,24
+62,36
+23,42
+68,12
+68,69
+32,12
+26,34
+44,29
+34,52
+57,40
+20,21
+52,30
+47,70
+2,45
+66,38
+48,16
+10,70
+3,10
+20,63
+22,67
+55,46
+43,54
+6,1
+34,28
+70,23
+54,44
+64,15
+4,8
+59,18
+24,13
+70,26
+44,27
+32,64
+49,28
+36,22
+28,3
+3,46
+2,49
+14,12
+6,22
+56,43
+37,2
+54,26
+30,1
+66,46
+68,41
+62,29
+66,23
+58,58
+46,7
+54,46
+7,4
+24,35
+60,34
+18,12
+37,70
+10,13
+16,15
+60,10
+13,0
+8,12
+21,20
+54,4
+42,64
+59,52
+7,6
+70,45
+66,37
+55,48
+20,39
+36,45
+12,70
+29,8
+35,44
+50,52
+30,70
+48,59
+0,32
+6,8
+24,9
+3,58
+4,54
+22,4
+38,58
+0,1
+4,60
+10,66
+15,42
+10,10
+70,25
+66,45
+30,18
+44,40
+38,28
+4,3
+14,26
+5,26
+8,62
+62,57
+32,3
+7,54
+22,18
+13,32
+0,6
+6,3
+30,2
+0,34
+22,65
+22,66
+62,61
+24,48
+4,12
+16,16
+34,8
+24,15
+10,42
+57,70
+44,8
+22,28
+50,2
+28,39
+0,65
+1,22
+0,70
+0,30
+20,64
+40,56
+62,26
+40,25
+34,62
+26,33
+62,60
+21,14
+24,2
+24,44
+51,56
+35,22
+59,30
+70,57
+58,8
+41,64
+28,24
+52,25
+64,56
+20,58
+36,8
+2,27
+20,11
+18,2
+20,12
+60,66
+56,36
+2,69
+30,51
+29,48
+2,68
+62,8
+60,24
+3,34
+68,48
+34,10
+26,70
+57,22
+52,60
+66,2
+13,6
+54,17
+54,8
+68,3
+45,34
+26,52
+47,16
+42,18
+12,62
+58,1
+11,40
+1,66
+48,50
+24,58
+10,67
+66,10
+37,30
+60,23
+6,66
+61,70
+56,13
+16,4
+56,17
+27,68
+24,19
+32,4
+4,10
+40,48
+48,42
+40,31
+16,36
+64,14
+50,43
+40,24
+18,17
+24,18
+1,48
+38,18
+16,70
+8,61
+2,32
+64,45
+8,58
+50,60
+44,44
+64,36
+36,17
+8,64
+63,50
+58,68
+45,62
+16,51
+36,30
+31,16
+63,12
+56,26
+8,42
+5,58
+62,43
+44,19
+33,66
+6,69
+19,22
+70,0
+14,23
+33,10
+21,24
+37,60
+64,51
+46,49
+1,38
+50,36
+70,37
+0,14
+40,66
+57,60
+54,2
+0,9
+12,34
+37,38
+57,54
+26,60
+2,70
+10,32
+57,4
+0,61
+25,12
+70,12
+44,58
+34,58
+14,55
+46,43
+39,58
+58,14
+48,39
+56,34
+50,0
+44,57
+57,20
+2,12
+8,48
+56,58
+29,50
+58,47
+52,15
+48,8
+68,8
+32,30
+14,8
+68,60
+19,12
+34,27
+38,20
+0,68
+44,36
+36,52
+52,62
+54,70
+12,54
+10,51
+38,55
+42,68
+56,19
+56,14
+44,17
+56,69
+22,1
+31,14
+24,65
+70,9
+63,66
+0,67
+5,28
+42,22
+64,31
+58,0
+26,9
+20,33
+70,49
+5,44
+8,24
+50,49
+33,36
+48,53
+60,18
+10,36
+14,69
+44,66
+38,56
+4,0
+48,0
+0,4
+64,59
+34,24
+69,46
+26,40
+14,64
+52,42
+68,64
+21,70
+25,54
+22,42
+30,12
+42,1
+55,26
+44,37
+12,24
+56,47
+64,65
+68,43
+24,39
+22,26
+47,18
+59,32
+63,46
+20,10
+54,31
+8,22
+43,10
+24,23
+60,20
+46,58
+38,26
+61,32
+12,40
+13,54
+15,48
+54,11
+70,40
+16,17
+16,5
+32,58
+54,7
+6,35
+20,44
+52,26
+35,26
+53,58
+37,28
+11,48
+10,58
+37,8
+54,32
+12,14
+26,63
+21,8
+70,2
+6,49
+26,50
+52,24
+18,52
+9,32
+2,22
+3,0
+32,10
+36,14
+63,38
+14,38
+47,30
+68,66
+12,64
+16,29
+69,12
+28,48
+28,34
+70,22
+56,25
+68,2
+41,62
+56,8
+42,37
+64,53
+62,48
+64,2
+47,4
+46,54
+55,2
+70,54
+4,36
+68,42
+60,56
+8,28
+31,20
+34,16
+24,62
+70,31
+12,50
+24,20
+40,22
+54,61
+18,0
+20,57
+6,42
+48,1
+34,22
+38,60
+46,55
+16,28
+70,20
+15,36
+33,60
+30,10
+3,70
+30,4
+49,18
+13,16
+28,40
+64,17
+38,21
+0,40
+39,0
+48,21
+52,40
+69,0
+60,67
+12,10
+42,33
+60,48
+43,58
+16,60
+30,39
+42,41
+8,18
+70,53
+42,59
+36,16
+10,23
+38,23
+49,36
+32,17
+56,65
+62,13
+48,9
+18,43
+4,22
+13,38
+31,46
+2,18
+40,64
+62,52
+33,20
+50,18
+34,25
+67,54
+17,30
+13,30
+28,20
+39,68
+66,1
+32,56
+32,54
+12,22
+70,35
+40,4
+42,6
+42,8
+40,40
+34,36
+2,66
+0,7
+46,38
+20,31
+22,33
+34,34
+0,35
+38,0
+65,64
+40,58
+68,61
+38,45
+43,70
+50,53
+54,60
+32,60
+68,27
+36,4
+2,30
+58,40
+14,10
+62,32
+8,63
+60,45
+6,26
+62,46
+56,68
+1,56
+68,34
+46,62
+36,44
+60,15
+48,70
+66,22
+48,5
+26,22
+50,50
+60,51
+14,30
+66,12
+70,24
+34,55
+42,58
+64,33
+66,28
+44,51
+62,18
+49,56
+62,53
+41,24
+24,46
+18,42
+56,18
+54,55
+0,39
+8,52
+46,12
+42,2
+56,22
+56,40
+44,46
+31,58
+53,32
+4,50
+58,54
+21,12
+50,14
+53,64
+22,0
+12,33
+50,5
+7,40
+12,56
+56,56
+33,64
+70,17
+34,49
+16,45
+51,54
+55,34
+22,31
+39,70
+30,58
+10,2
+18,51
+6,33
+4,21
+61,24
+65,38
+38,68
+58,42
+3,50
+14,44
+12,30
+64,69
+66,55
+52,18
+4,66
+16,21
+58,61
+36,10
+39,50
+67,0
+10,0
+70,64
+65,2
+28,2
+0,31
+19,4
+0,59
+26,12
+62,39
+4,16
+39,20
+70,69
+8,54
+6,28
+20,62
+60,70
+30,36
+50,22
+38,9
+28,26
+62,55
+7,0
+62,33
+46,39
+60,32
+30,5
+12,55
+42,55
+22,61
+8,41
+56,54
+8,47
+31,6
+24,37
+69,44
+42,29
+0,56
+34,39
+50,38
+15,70
+19,70
+40,57
+67,50
+48,51
+6,32
+57,30
+54,24
+10,5
+63,8
+54,34
+35,68
+50,62
+46,69
+24,50
+6,17
+51,46
+67,22
+18,6
+50,26
+64,66
+7,60
+34,68
+58,32
+19,6
+16,58
+66,14
+1,12
+61,44
+40,13
+44,26
+70,63
+29,42
+46,18
+48,15
+24,47
+30,62
+70,55
+66,69
+40,38
+61,48
+66,18
+28,6
+41,66
+55,14
+65,6
+66,62
+39,18
+20,15
+34,48
+66,24
+26,4
+66,16
+14,48
+40,18
+9,16
+54,49
+52,0
+63,22
+2,50
+4,24
+56,46
+6,62
+22,53
+35,10
+68,26
+32,2
+62,50
+68,28
+22,56
+22,58
+41,46
+66,40
+46,37
+28,17
+32,29
+28,38
+69,14
+42,21
+9,18
+10,24
+12,28
+44,13
+61,66
+56,67
+20,27
+58,36
+46,45
+1,64
+0,45
+37,14
+18,18
+0,66
+20,1
+60,52
+36,26
+50,20
+30,26
+44,16
+18,16
+70,61
+10,63
+64,38
+49,34
+8,2
+45,8
+27,58
+26,56
+50,41
+40,14
+6,18
+65,12
+15,4
+23,70
+43,40
+68,38
+5,46
+14,60
+10,38
+62,40
+22,52
+20,38
+30,66
+17,12
+36,69
+36,2
+53,70
+43,2
+40,44
+32,55
+38,65
+22,44
+36,32
+62,68
+44,48
+61,20
+48,64
+52,2
+5,52
+70,19
+42,62
+46,14
+69,64
+46,6
+52,14
+31,8
+6,34
+50,33
+29,66
+54,63
+47,42
+12,68
+31,68
+58,20
+0,58
+4,14
+64,44
+44,20
+30,60
+32,63
+10,20
+36,57
+19,64
+51,36
+44,64
+64,40
+55,16
+30,48
+68,4
+22,62
+69,52
+10,18
+32,41
+28,53
+68,18
+2,44
+53,4
+30,19
+32,68
+50,24
+26,20
+61,36
+64,12
+70,33
+32,40
+60,1
+56,20
+56,50
+42,53
+4,32
+44,4
+10,8
+26,10
+9,34
+16,68
+22,70
+6,24
+24,70
+20,22
+36,61
+22,12
+30,63
+44,60
+50,46
+6,2
+58,27
+32,25
+14,27
+14,19
+2,2
+32,8
+48,17
+50,13
+45,0
+28,14
+56,48
+15,14
+54,68
+32,43
+28,31
+9,38
+30,22
+28,66
+40,6
+61,60
+11,68
+32,50
+10,40
+10,52
+10,62
+16,24
+48,22
+42,35
+8,4
+70,39
+44,70
+45,68
+19,60
+6,11
+9,56
+70,46
+38,70
+40,10
+64,8
+33,58
+70,38
+19,52
+4,9
+59,70
+29,10
+32,16
+20,2
+68,46
+24,36
+51,0
+16,42
+40,32
+64,50
+38,44
+66,67
+41,28
+41,48
+2,58
+60,62
+44,32
+43,20
+27,34
+10,19
+23,54
+52,17
+53,52
+58,2
+64,64
+42,56
+28,41
+14,70
+44,69
+16,65
+50,63
+35,38
+16,10
+10,16
+20,24
+28,60
+14,52
+36,36
+48,54
+22,63
+44,53
+42,52
+12,60
+3,2
+69,66
+17,54
+41,22
+59,56
+40,2
+47,24
+65,70
+46,68
+14,15
+25,4
+32,44
+32,51
+47,22
+46,31
+14,28
+46,28
+48,33
+54,16
+38,11
+59,64
+62,38
+26,11
+24,4
+17,34
+26,8
+54,18
+56,35
+20,60
+60,28
+52,48
+36,62
+59,54
+34,4
+64,22
+26,54
+68,63
+26,31
+48,12
+70,16
+24,7
+38,14
+27,14
+24,69
+20,26
+16,3
+22,50
+17,0
+52,59`
@@ -0,0 +1,196 @@
+package day19
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/answer"
+)
+
+const ExampleInput = `r, wr, b, g, bwu, rb, gb, br
+
+brwrr
+bggr
+gbbr
+rrbgbr
+ubwu
+bwurrg
+brgr
+bbrgwb`
+
+// Colors are the stripe colors a towel can have: white, blue, black, red and green
+const Colors = "wubrg"
+
+// Onsen holds the towel patterns on offer and the designs to arrange from them
+type Onsen struct {
+	Patterns []string
+	Designs  []string
+}
+
+// Parse parses the comma-separated towel patterns, a blank line and one design per line
+func Parse(input string) (Onsen, error) {
+	head, body, found := strings.Cut(strings.TrimRight(input, "\n"), "\n\n")
+	if !found {
+		return Onsen{}, fmt.Errorf("want the patterns, a blank line and the designs")
+	}
+
+	var onsen Onsen
+	for i, pattern := range strings.Split(head, ", ") {
+		if err := checkStripes(pattern); err != nil {
+			return Onsen{}, fmt.Errorf("pattern %d: %w", i+1, err)
+		}
+		onsen.Patterns = append(onsen.Patterns, pattern)
+	}
+	for i, design := range strings.Split(body, "\n") {
+		if err := checkStripes(design); err != nil {
+			return Onsen{}, fmt.Errorf("design %d: %w", i+1, err)
+		}
+		onsen.Designs = append(onsen.Designs, design)
+	}
+	return onsen, nil
+}
+
+// checkStripes reports an error unless s is a non-empty sequence of Colors
+func checkStripes(s string) error {
+	if s == "" {
+		return fmt.Errorf("no stripes")
+	}
+	if i := strings.IndexFunc(s, func(r rune) bool { return !strings.ContainsRune(Colors, r) }); i >= 0 {
+		return fmt.Errorf("%q is not a stripe color in %q", s[i], s)
+	}
+	return nil
+}
+
+// MustParse is like Parse but panics on malformed input, for inputs known to be valid
+func MustParse(input string) Onsen {
+	onsen, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return onsen
+}
+
+// String writes the onsen in the format of the puzzle input
+func (o Onsen) String() string {
+	return strings.Join(o.Patterns, ", ") + "\n\n" + strings.Join(o.Designs, "\n")
+}
+
+// trie stores the towel patterns stripe by stripe, so that all patterns a design starts with are found
+// in one walk down from the root
+type trie struct {
+	children [len(Colors)]*trie
+	pattern  bool // Some pattern ends here
+}
+
+// newTrie builds a trie of the patterns
+func newTrie(patterns []string) *trie {
+	root := &trie{}
+	for _, pattern := range patterns {
+		node := root
+		for i := 0; i < len(pattern); i++ {
+			c := strings.IndexByte(Colors, pattern[i])
+			if node.children[c] == nil {
+				node.children[c] = &trie{}
+			}
+			node = node.children[c]
+		}
+		node.pattern = true
+	}
+	return root
+}
+
+// possible reports whether the design can be put together from the patterns in the trie
+// reachable[i] tells whether design[i:] can, found by walking the trie from every i back to front.
+func (t *trie) possible(design string, reachable []bool) bool {
+	reachable = reachable[:len(design)+1]
+	reachable[len(design)] = true
+	for i := len(design) - 1; i >= 0; i-- {
+		reachable[i] = false
+		node := t
+		for j := i; j < len(design) && !reachable[i]; j++ {
+			if node = node.children[strings.IndexByte(Colors, design[j])]; node == nil {
+				break
+			}
+			reachable[i] = node.pattern && reachable[j+1]
+		}
+	}
+	return reachable[0]
+}
+
+// arrangements counts the ways to put the design together from the patterns in the trie into total
+// ways[i] is the number of arrangements of design[i:], found like possible does. The counts grow
+// exponentially with the length of the design: a hundred stripes that are all patterns of one and two
+// stripes already overflow an int64, so they are big integers.
+func (t *trie) arrangements(design string, ways []big.Int, total *big.Int) {
+	ways = ways[:len(design)+1]
+	ways[len(design)].SetInt64(1)
+	for i := len(design) - 1; i >= 0; i-- {
+		ways[i].SetInt64(0)
+		node := t
+		for j := i; j < len(design); j++ {
+			if node = node.children[strings.IndexByte(Colors, design[j])]; node == nil {
+				break
+			}
+			if node.pattern {
+				ways[i].Add(&ways[i], &ways[j+1])
+			}
+		}
+	}
+	total.Add(total, &ways[0])
+}
+
+// longest returns the number of stripes of the longest design
+func (o Onsen) longest() int {
+	longest := 0
+	for _, design := range o.Designs {
+		longest = max(longest, len(design))
+	}
+	return longest
+}
+
+// Arrangements returns the number of ways to put each design together from the patterns,
+// where every pattern can be used any number of times and a pattern offered twice counts once
+//
+// Time complexity: O(P + D × L × M) big integer additions where P is the total length of the patterns,
+// D the number of designs, L the length of a design and M the length of the longest pattern
+// Space complexity: O(P + L)
+func (o Onsen) Arrangements() []*big.Int {
+	t := newTrie(o.Patterns)
+	ways := make([]big.Int, o.longest()+1)
+	counts := make([]*big.Int, len(o.Designs))
+	for i, design := range o.Designs {
+		counts[i] = new(big.Int)
+		t.arrangements(design, ways, counts[i])
+	}
+	return counts
+}
+
+// Part1 returns how many designs can be put together from the patterns
+// Algorithm: dynamic programming over the suffixes of each design, walking a trie of the patterns
+//
+// Time complexity: O(P + D × L × M), see Arrangements
+// Space complexity: O(P + L)
+func Part1(onsen Onsen) int {
+	t := newTrie(onsen.Patterns)
+	reachable := make([]bool, onsen.longest()+1)
+	possible := 0
+	for _, design := range onsen.Designs {
+		if t.possible(design, reachable) {
+			possible++
+		}
+	}
+	return possible
+}
+
+// Part2 returns the total number of ways to put the designs together from the patterns
+// The same dynamic programming as Part1 counts the arrangements of each suffix, see Arrangements
+func Part2(onsen Onsen) answer.Answer {
+	t := newTrie(onsen.Patterns)
+	ways := make([]big.Int, onsen.longest()+1)
+	total := new(big.Int)
+	for _, design := range onsen.Designs {
+		t.arrangements(design, ways, total)
+	}
+	return answer.FromBig(total)
+}
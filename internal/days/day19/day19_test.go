@@ -0,0 +1,123 @@
+package day19
+
+import (
+	"math/big"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/testkit"
+)
+
+var puzzle = testkit.Puzzle[Onsen]{
+	Day:   19,
+	Input: DayInput,
+	Parse: Parse,
+	Part1: testkit.Int(Part1),
+	Part2: Part2,
+	Cases: []testkit.Case{
+		{Name: "example", Input: ExampleInput, Part: 1, Want: "6"},
+		{Name: "example", Input: ExampleInput, Part: 2, Want: "16"},
+		{Name: "nothing fits", Input: "wu, ub\n\nbbb\nwub", Part: 1, Want: "0"},
+		{Name: "trailing newline", Input: ExampleInput + "\n", Part: 2, Want: "16"},
+		// The ways to split 90 stripes into "g" and "gg" are the Fibonacci number F(91), far too many for a
+		// search that does not share counts between the designs' suffixes
+		{Name: "many arrangements", Input: "g, gg\n\n" + strings.Repeat("g", 90), Part: 2, Want: "4660046610375530309"},
+		// F(93) no longer fits into an int64, which must not make the design look impossible
+		{Name: "more arrangements than an int64 holds", Input: "w, ww\n\n" + strings.Repeat("w", 92), Part: 1, Want: "1"},
+		{Name: "more arrangements than an int64 holds", Input: "w, ww\n\n" + strings.Repeat("w", 92), Part: 2,
+			Want: "12200160415121876738"},
+	},
+	Malformed: []testkit.Malformed{
+		{Name: "no designs", Input: "r, wr, b"},
+		{Name: "no blank line", Input: "r, wr, b\nbrwrr"},
+		{Name: "empty pattern", Input: "r, , b\n\nbrwrr"},
+		{Name: "comma without space", Input: "r,wr, b\n\nbrwrr"},
+		{Name: "unknown color", Input: "r, wr, b\n\nbrxrr"},
+		{Name: "blank design", Input: "r, wr, b\n\nbrwrr\n\nbggr"},
+	},
+	// The parts allocate the trie node by node; Part2 also grows the big integers of the counts once
+	Budgets: testkit.Budgets{
+		Parse: testkit.Budget{Allocs: 28, Bytes: 80 << 10, PeakBytes: 112 << 10},
+		Part1: testkit.Budget{Allocs: 1450, Bytes: 88 << 10, PeakBytes: 128 << 10},
+		Part2: testkit.Budget{Allocs: 1600, Bytes: 96 << 10, PeakBytes: 128 << 10},
+	},
+}
+
+func TestPuzzle(t *testing.T) {
+	puzzle.Test(t)
+}
+
+func TestArrangements(t *testing.T) {
+	onsen := MustParse(ExampleInput)
+	want := []int64{2, 1, 4, 6, 0, 1, 2, 0}
+	got := onsen.Arrangements()
+	for i, count := range got {
+		if !count.IsInt64() || count.Int64() != want[i] {
+			t.Errorf("Arrangements() = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+// referenceArrangements tries every distinct pattern at the start of the design and recurses on the rest
+func referenceArrangements(patterns []string, design string) int {
+	if design == "" {
+		return 1
+	}
+	ways := 0
+	for _, pattern := range slices.Compact(slices.Sorted(slices.Values(patterns))) {
+		if rest, found := strings.CutPrefix(design, pattern); found {
+			ways += referenceArrangements(patterns, rest)
+		}
+	}
+	return ways
+}
+
+// TestArrangementsMatchesReference compares the trie's counts and Part1 with trying every pattern in turn
+func TestArrangementsMatchesReference(t *testing.T) {
+	rng := rand.New(rand.NewPCG(19, 2024))
+	// Two colors keep the designs possible often enough
+	stripes := func(n int) string {
+		s := make([]byte, n)
+		for i := range s {
+			s[i] = Colors[rng.IntN(2)]
+		}
+		return string(s)
+	}
+
+	for range 2000 {
+		// The same pattern can be offered twice, which does not make more arrangements
+		onsen := Onsen{Patterns: make([]string, 1+rng.IntN(6)), Designs: make([]string, 1+rng.IntN(4))}
+		for i := range onsen.Patterns {
+			onsen.Patterns[i] = stripes(1 + rng.IntN(3))
+		}
+		for i := range onsen.Designs {
+			onsen.Designs[i] = stripes(1 + rng.IntN(12))
+		}
+
+		got := onsen.Arrangements()
+		for i, design := range onsen.Designs {
+			want := referenceArrangements(onsen.Patterns, design)
+			if got[i].Cmp(big.NewInt(int64(want))) != 0 {
+				t.Fatalf("Arrangements() of %q from %v = %d, want %d", design, onsen.Patterns, got[i], want)
+			}
+			single := Onsen{Patterns: onsen.Patterns, Designs: []string{design}}
+			if possible := Part1(single) == 1; possible != (want > 0) {
+				t.Fatalf("Part1() of %q from %v says possible = %v, want %v", design, onsen.Patterns, possible, want > 0)
+			}
+		}
+	}
+}
+
+// FuzzParse checks that malformed input makes Parse return an error rather than panic,
+// and that parsing what String writes gives back the same onsen
+func FuzzParse(f *testing.F) {
+	puzzle.Fuzz(f, puzzle.RoundTrip(Onsen.String))
+}
+
+// BenchmarkPuzzle benchmarks parsing and both parts on the real input
+func BenchmarkPuzzle(b *testing.B) {
+	puzzle.Benchmark(b)
+}
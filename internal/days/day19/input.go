@@ -0,0 +1,407 @@
+package day19
+
+// DayInput contains the puzzle input for day 19
+// Replace this with your actual puzzle input from https://adventofcode.com/2024/day/19/input
+const DayInput = `bgbwbug, wrgbbrrg, bbbg, bwr, ugbu, wu, gwbgubb, uuwu, gb, rgbrbr, bbr, g, wbbrbr, rrrg, wwwubg, gu, buu, rwwugbrb, wwu, bbuwb, bbbrbub, guurwb, ubbugwb, rwgg, wbbwu, rgrr, rg, rrrbrwur, urgur, bgg, uruu, uggbuug, wwburb, uubwb, b, rwg, wg, wbbruwrg, guub, rubb, guur, rwbb, wuuwwubg, wubggug, gbbgugb, grg, bbwb, bwggu, rrgrwu, ugrbubrb, uguwgrb, wrbggr, grrb, gggg, u, brruurb, rgbrrwbg, bwbrgu, bwbwgbu, rburbru, wgu, grrgwu, bwrrrb, burr, br, wrg, rwbwwu, rrb, uuuuug, wurwb, rbg, bwwuwwu, grbu, ub, urwgubrr, bwggrgub, bbuugwu, gwrbrr, uugwuu, ubr, wwrbgb, bguubb, uggurbb, bgub, wr, bwbrr, ur, uggg, wrruubuu, bwbb, ugr, rwwbwg, rwrbbub, gwrgwu, wrbbu, wbbg, urrbwr, uwrwwur, uur, rgwb, uwrguuwg, bb, bgwbbwwb, wbbuwggu, uwuburrr, bbbu, rgb, brubg, wubwruwr, uu, wugrbr, rug, uubgu, bbu, wwrrwr, bbwg, rgr, rrgbu, rwwgwuwu, rrrgwr, bbbr, r, bwbbggu, gr, rgbuu, uurbug, rwgb, bgrb, rguurbwu, gbgwwg, bu, rruuuugu, ubgwgg, bur, gbwrrg, ruwg, uubbr, ruwubr, uurbbu, wuu, guwwbwgb, bubwb, uub, bbrg, ruwgwbu, wwb, grwb, gurggb, ubwuggg, grru, wgg, ggwu, uwbbu, uwg, bgwuwbbr, brubbgg, rburu, bbwgrb, gwbb, uuubrubb, ugruubgu, bbug, rgwurg, rgugbbb, grwu, gg, bwb, bwubg, ubwrb, bwbwg, gruwr, wwrwgu, bbub, rburb, rbbwbr, rur, rgwwgggr, uwuu, bgrurugb, rrbrwrug, rbwr, uwrg, rbgwrbwb, wrwbruwr, rrugur, rwb, wb, wrr, guwbggb, uggubur, rrugbb, gbbbgu, rrbr, uruwrr, wwbb, rr, ubgbgwu, wubggrgg, ru, ugrgbrgb, wubwbgu, rrbg, uubg, rrrbuwg, buur, guwg, uuwb, rgwgg, rwwwwgbb, rgwwb, uwr, bbwrrgbb, guruwwb, wbgbgrbg, bwurrgb, wgbgu, bbbbu, rwu, rbgruggb, rwuu, brbbuwrb, ubrugg, wgbgwbrr, bwrurbru, wggug, rb, gurrbg, rwbrb, rgg, bbrbb, wwgwwb, wbr, bbrgubg, wwr, bgbu, bgwu, wgrwrr, rrwb, ubu, rrr, rbrwbggb, bwgg, bg, ugbug, ubbuwg, wubu, wurgwugr, rbwrrugu, rwub, wrurggwg, ug, wwbu, gwwuru, bwgrrr, grwbu, brbgbwur, ubwbubg, uuwbugb, rwgrrr, ubg, wrbruwu, ggu, uwru, wgwgrgg, rbubugwu, wbb, rwbrg, bgb, wwgbb, brurrur, gwgu, grr, brrwgwru, ubggb, bbrbrr, buguwg, gbbwuwb, rbgggrub, rwuggrb, wrbg, rbggr, wwwru, wrgu, gwb, ugbuu, bwwgbgur, ugwgur, bubwg, rgbg, rrgwu, bwugwbr, urr, rbrr, rrgg, brbrg, ggg, gubu, wgbgruu, wwwbbrgr, rgu, uwgggbg, gbwuru, uuwgrugu, ugg, wubwb, rwbuu, ruu, uwbbrggb, uugwurru, ubwrg, brgbr, rbwur, ggrgbb, rwrubwr, gru, rbruguu, uggwu, wgbbbu, rggwbg, wugu, wbg, gubr, wbgbu, ruwrbgg, rwrwrbu, rrbubwb, rbgrb, urwb, ggwbbgu, gbgug, wrrwu, wwurwuuu, grbgwwru, rbgb, wru, ubwg, gbgwu, bwrwuwu, bgrwgwrr, ruwggbg, rrg, uuwgwgg, gurub, wbrgug, wbgwgb, wrbrggbb, gbbgr, gwuu, gwwbwbb, wrgrrruu, bgwbugb, buwrgrru, rwbrr, gguwu, bbgu, ubb, bwuwg, buwb, rrgbgrb, ubwuggu, uwwgu, rwuwrb, rrrbu, rbbwb, bwwgg, brwu, bgwrru, uuubwwgg, brbr, ggrbwgbr, wwwbuwr, wgggub, grgrwrr, rwguwb, gbub, uugu, bugrrb, buwwrr, rbgurb, wggurwbg, wruubg, bwrbrbur, rugrg, wrgub, ubruuu, wwrggbgb, wgb, buuugwbg, grb, uurgbr, wwugrg, gwr, bwrr, gur, gbr, wwgrrg, rwbuwug, rwgubrbu, ggbr, bbgbrg, gbgbbwrr, gwugg, ggwwg, bgwrwb, rugguubb, rgwwrwu, ubuuwurb, rru, wrbgrrg, ubbr, bugrgu, uwwwgb, wrrwbggu, rubu, gbrbrbu, wgguubr, uguuwbrg, uwwbwrr, rrwuwbgg, rwbrbg, brbrr, ubuwgg, wggrgbru, rrwwuu, gbur, ubrwbgru, ruub, wugrrur, wbuuru, wburg, gburrbur, bbwuuug, ugwwwr, bubrrg, rrugwwb, guuwgg, wrwrwrrr, urg, brbuuwur, bbrbbgwr, rgggurb, bbgbr, ubwb, rwwbbwb, ugug, grwrbu, urwub, wrgr, rgwrru, rwgugwr
+
+rbrwbggbbgwrrurwrbbubburgrwbgurggbwwwubgbbgbrrrwwuuwrbbu
+bbbrbubbubwgwrgrgburrburwgbgruuubgwrgbubrrg
+wbbgwrgbbrrgugruubgugwbgubbbwbrgugbwuruwgrwrrgrugbbwrrgbb
+wbguurbugggggbwrrrbbrwuubrwbgruuwbburwgbrrugwwbw
+buwbrwwgwuwubbbgguwgrwwugbrbwgrwrrgurrbgrwwgwuwuuwbbrggbwgrwrr
+gbrbuguwggbwrrgugbwrbrburwrgrbubrrgwbgbuwurgwugrgbr
+ubuuwurbgggburrburwbbrbrwrruubuuuubgurbwuruubgbbuwbrbrbgggrub
+rrguwrwwurrbggrwrbgwwbuurbugbbugbwbbgguguruwwb
+uwrguuwgubgbgwuuwbbuwgbgruuubwrggurrbgbgwbugbuwuburrrgrwbw
+uwrguuwgbwbrrbgrbbbbrwugrrurrruuuugubbwgwrbrggbbgwuugurrbg
+wbbuwggurrbrwrugbbwrrgbbrrugurwrbgbuwwrrgggggrgurrrbubugwu
+gurubgburwugrrurubggbwbgbuugwrbgubwugggwruubgwrruubuuguruwwb
+bwrwuwubuwwrruwrugrburrugwwbuggwbbwubrbwrrrbgbwbbwrwuwurrgwuw
+bwrwwrggbgbrrwuwbgguubbrrwggrwbwwuuuubrubbbbrgubgwgwgrggwubwruwr
+rrggrbwrubbguuwggwwbbggwubuuugwbgguburbbwbwbrgug
+rrrgrgwurgbwrrugwwwrrwbrrgubrrwwgwuwuubuuwurbrrrw
+brgbrrwwwwgbbrgrgwwgggrwuuwwubgrbwrgrrrbgrbbrbgbwurgrwbuwbgbu
+gwbgubbubwgwubggrgggrbgwwrugbbgrwrbruwurwuuuwbbuuubbrwwgbb
+ubwuggurrrggrwbubbbgwgguubrubwbrwbrgrwbbrbwurrrwuwbggguwg
+wruurrwbrwurgwugrbwurrgbbbbrrrrgwrrbruguurrbguguwgrbubwuuguwgrbw
+rrbrrugbbrgwbubbruwrurwubwbgwgbbrbuuwurguwbggbuurbugbrubbgg
+rurgrwbuwugrbrrrgwurruuuugubwggrrrgwrgrrruugbbgrrbggrwgg
+wwwbbrgrrgbggurrbgwwrrrbgrrbrgbrgrurrugwwbggwbbgu
+gbubwurugrbgbrrrbrwurwugrrurgurubgrwburrggrubbwbrbwbrrw
+uuubwwggwgurgwurgrbururwbwwuuggurbbrwuggrbwbgbgrbgurgrwubbbrbb
+gbwururrbubwbuggurbbugrbubrbuwrurrrgwurgwugrrwbbgbbgrwbbw
+uruwrrrgubgbuwwgbbbbuwbugwrruubuuuugurrbgbubwg
+rgugbbbrggwbggggrwubrgwrrurugrggggwrbguuwb
+gbubgburgbgwwgugrgbrgbbrubbggrbbwbrbbbbuw
+uubgrwbwubwbguguwgbwubggggggrwrburbgruggbbbwgwrgrrrrbuwgw
+ubuugguburbbgurrbrbwwggbbwbbrbuuwurrwbuubguubbw
+rwguwbbbwbrrugwwbrbgbgwuuguurwubggrggbgwbbwwb
+rburubrgbrgbbwuwbrwgburrwgbrbwrrugurgwubggugggrbwgbr
+uwuuwugubgubrrbrwrugwurwbrwbrgbgwrrurwuggrbbbuburggwbgrbgggrub
+ggbrurwbrwgbwwgbgurwugrbrggrbwgbrrggwbgrrugbbuuwbugb
+ubwgbbbrwrurggwgrrugwwbwwgbbrruggwuggugbwrbwrurbruuwrwwur
+uguwgrbuugwuubwrrrbuwrbrbgbwurgggrwrburrrgwr
+ubwrbbwugwbrrrbrwrugbwuwgrwwgwuwubgbrwguwb
+buuugwbgrwbrbwuwruubgwwrggbgbrggwbgubwugggubbuwguurbbu
+grgrwrrguubguuwggrwbrgrwggbuggbrgwwuruguwggbbbguubbuwgw
+ggrrbrwruggrgrwrrbbrbuurrugururuubbugrrwwuururrrbrwurwbbwu
+ubgwggbwurrgbuurbbugrrgwurgbbrruurbbugrgugubruwruwbburwubw
+rburburrbwrbuwbbugrrbbrgbrubbugbuugurggbrwwwwgbbrubuwgbbbuwb
+wwwrugrbuuwubbubbgwrrurrugwwbrwbrguwgrgugbbb
+wrruubuuugugbrruurbwrbgrrgggggbrgrrurbururgguurwbwgbbbuwrgrrruu
+bgbwbugrurbwbbrwubgwrwbbuwrgrruwgguubrgbbbguwbgwgb
+ubbwbrguggwwgwrrwurwguwbugwwwrbgrurugbwbuururgbrbrw
+wuuwwubguggurbbwuuggugwgugwwbwbbrwbrrbuuugwbgw
+bwbrgubrbrrbgbbrbrruruwrruugwuurrggrbuwwrrwr
+guwgurwbwrwbruwruuwgwggbgwrrubwugwbrwwrrwrurrbwubgw
+buurbwbwgbuguruwgrgbrrwbgbwbrrgrbgwwruwguguwgbgwwuwurgwugr
+ubgwgggbbgrrwgrrrwbgbgrbguwrurbwurubrrugbb
+wrrwbggugrurrrbrwurgubrbubrrguubwbrwwgwuwuwggurwbggwrgwuw
+wbbrbururwbrgwwgrrgrgwrrurrugbbbwurrgbgburrburbrbrrbrbr
+wwbbwbgbgrbguruurruwwurwuuuwwbgwbgubbbbwgrb
+rbgrbrrrbuugggbrbrrubwugggrwgugwrwrurrgguwbbubwbwgrwbb
+bgwrwbgruwrgrwugbgugrbgbbrbrwubggrggggrbwgbrgrbu
+wwwrububwrrrbrwrubwrgrbgwwruguurwbgggggwgurwwbwgrrwbw
+bwrrbgwbugbrgrrrwrbbubwrwrwrrrrgbrrwbgurwubgrbugugugggwrbbu
+wbbuwggurrrgwwbbbwggbwuruwbuurubwurrgbuwruwgbgwr
+uubbrrrrgwrwugrbrgurbgwrwbrrgburgbuuuwrugrwrbuwbbuwgguw
+bgwbbwwbwrrrrggwrrwuwbgrgbguurbbuwwugrgruubrgwbburwwurwgb
+uwgrbrwbggbrbggrwugrbrruwrbggruwgrwbrrbwwgbgur
+wwurwuuubbwbruubuurbbwbgbrwrbbuuubgugrwrbubbgbrbrgbrwwbuw
+uwruruwggbgburugrbubrbwrurggwgbuururguruugrbwgrwrrwb
+brwuggggbwrrbbwrrgbbuurgbrbugrrbwrgbbrrgbrubbgggbbgugbwbbrbr
+ruwgwbuugbugrrbrwuuuuwgrugurgwbbbrbbbrurrurrbggrgrgrwrr
+gburrburrgwurgwbgbubgwrwbgggbrurrurgbbgugbrwuggrb
+wrgurrggrwwugbrburwgubrrgrggrbgurbgbrrburbrurrggwrruubuu
+wwrrbwrwgbgruuwwrwgugrruwbbrggbbbwgrbbbbg
+gurrbgruwrbggbbugwbgwrrwubwruwruwgguubruwgwbugwbbwbbgbrgbrrwbuu
+wrgrwggrgbrurrrbuwgguurgbbgugbuurbugrubbruwrbggrwgugwrrgwgg
+rwbrrgwwuruubbugwbrgwwrwuuuwgruguwubwbbbuwb
+rbgurbwwbbwwrrwrbuguwgrbubugwugrgubgwggwugrbruwg
+brwubguubbgbgwurwgubrburgubwrruurgbrbbrbbburgbrrwbgugggwurwb
+rgwwrwuwgbguruwwbbrbrgbwuruugggbruwuburrruuwgwgg
+rgurgrbwgggrrgwuuggbuugggwwggwbbggwbbguruubw
+uubgurrugwwbbgwbugbrugwwwubggbrbbbrbubggrgbbrbgrb
+bgwbbwugwbruuwruwubgguggurrwgbuguuwbrggrwbubwgrrrrwrwrbuwgbbbu
+rbggrrwgugwrggwwgbwbwgubgbgwuwbbwuwwugrgw
+wburgrrbrwruguwrgrugrguubbbrbrrwubggrgggrwburwg
+uurbbuguwwbwgbwrwbruwrwubggrggggwbbgubwgrrrbgwbugb
+urrbwrgwrugruubgubbgbrrrugwwburuugwbgubbbwbbggu
+rwgbbwggwubugbgwwgbubwgbbbburbgwrbwbbrurrurrrbbuuugwbgwwrbgb
+buguwguwwguurwubbgubwuguwwbbrbruugwuururrgbubbwgrbwubwbguw
+ubbruwrbggrwuggrbwwugrgurwubgwuggwwrbgbrwbrgwrgubbwbwgubrwbgru
+gwrruwgbwwuwwurubbrbgrrwgrrrbwggrgubrrugurrubbgurw
+wwwrurbgwrbwbbbbuubgbgwuguruwwbrgubwrrwuwbgwgbubgbgwuwguwrwrwrrr
+wbgwgbguwwbwgbwwwbuwrbwbrgugrwbuwuugwbgubbwbgbuuwrwwuruwuu
+rugrgguurwburwgubrrgbgbbwrrbwggurguuruwrrbbuwb
+ubwbwrurggwgwwrwgurugbrwubbguwbgbgrbgbrgr
+ubruwwwgbwggrgbruurwubuuubwwggrrugbbwrwrwrrrrbrrubbbwg
+brbrrwgwgrgggbgbbwrruugwuubwggrgubwwgbbuuguw
+gguubwgggwubggugggrbwgbrbrgbruwrwrgubgbbgugbw
+ruwgwburrrrgrwugrrurrrbrwrugbbuugwubgbuwrurgguwg
+bbbwugwbrrwrwrbuubbrguurwubggrgggwrbrrwrbruwuw
+guwbggbubwbubgwwrggbgbwbbuwggugwwbwbbwruubgwuuuubwuggugrgrwb
+bgrwgwrrrbrrbrruurbugbuurwgubrburwwbbwbbgwrwbguurwbrgwwrwuw
+ugbuuwwbbwugrrurwwuuubggbrwwwubgwuurbgwubwbuwuu
+gbbgrruurggubbrgbbgruuuuugrgubrbuuwurguurwbwrrwbgguw
+wrbggrugrwgugwrwrguguwgrbbwrrrbwrgbbrrgubgwurwbw
+wggurwbgwubuwrbruwubggggggwrbgbbwgwrbbubwwgbgurwgbuggwuw
+rbbwbwwbbwrbggruugwuuwubwruwrguruwwbgbrrwbrr
+buubgwwgwwbrubbrgbrbrgbgbbwrrruwrbggrbwrruguw
+wugubbwuuuggbbgugburuwrrruubbrbbggrgbbbgwrwbrwwbbwbw
+bbgbrgurrwgugwrbugrrbrburugwuuwwburbgguwuwwburb
+wurgwugrbubwbruubbwggrbgrbbwrrguruburgurwrbgrrgwwwbuwr
+rwgubrbuuuuurbugbbrrrburrugwwbbuwwrrrbbwbrrwbrrwwurwuuu
+buubbwgrbgrrugbbbguubggbrwbgbuburwubuurbbuubrrrgbugbgug
+brbrggwurbururrugwwbwwbugwrgwurwggububbwgrbrrbgburgbur
+rwwwwgbbuuubrubbrrwbrbubwbwrbgrrguruggbuugw
+wgbbbubrubgwwugrgwwwbbrgrbbwrrgbbuwuuurwubrbwrrugu
+wubururrbwrbrurrurbwwggrbrwbggbgrrugwuurburbruuuubrubbw
+wubwbgubwgrrrgbgwuggwbbguwgbgugrrbrgggurbrrbrwrug
+rrwuwbgggwwurugbgugwrgrwwgwuwuwwuurwgubrrwrbbuwggurwbgwurwbb
+rrugbbbrggggwbgwgbubgbgwuubrbwwuwwurwbrbbuuwbbguwuburrr
+gbgwuubrwbgruwwrrwrrwbwwurwgubrbubbgbrgwugubrbwuwgrwgb
+rrugbbbwggwbgbgrbgggwwgbwubguwrgwwwubgwrrwbgguubuwgg
+rggwbgrburubgwbugbgbgwwgugwgurbbubwbbwgggubwwwruuwrguuwg
+ugrgbrgbrrrgbrbrgburrburwwbbwrrwbggurugguubbbbwggbrbrbuw
+gwwgrrgrbgruggbrbgruggbbwrbrburwbgbgrbguwuuuwbburrwwuu
+bwgguubgwggbgrurugbwrurggwgubggbgrwwurwuuuwgguuwgrugu
+uwbbrggbwggwbbrrrbrbrruggrgrwrrwrwrwrrruwrguuwg
+rrrbrwurrwbrbbggwbbbrbgbwurbwubgbwgggwbrugrg
+bwrurbruuuubrubbrrugurrrrgwrguwgbguwurwbbwbrrbrwugrbgwwru
+guruwwbgbbbgubwbwgbuguwgguurwbwwgrrggbwrrg
+rrrbuwwurwuuugurwgbuugwuurgggurbubrruwgbbbrbubggbrwrgu
+bgbwbugbbbrbubbbrbbgwrwwubbbrubwugguuwrwwurbrruurbwrwrwrrr
+gruwrwugwuuuubgubgrrgbuubwrbwwbbrugrgrgbrbuuwur
+grrbruuuuwubwwuwwubbwgrbuuwbwwwubgbwggwrrwwgwuwu
+gbbgrbbrgguruwwbggwbbgubwggubgrbbwbrgubuwrgrru
+rrgbwrrggubrgrbuubuwggbbgugrwbwuubbrgubggggg
+buuugwbgwbbbwggrgwwgggrrrrgwrrwgbguurbwrrwugrrurwrgbbrrgbubrrg
+gburrburwgrrgbuubwugggwrbrggbbwggrgbruwrrwuw
+bggubruggbbubwbbggubgwbugbrrbgbbgbrbguubbrbgbrwguwbugbug
+rbgruggbrwbrbbrwuwrgrggwgbgruurggwbgbgbwbuggrwugruwrwgggwrgwuw
+guuwggburrgwggrrrbuwgwgggubrugrggrgrwrrwgrwrr
+ugggbbbbubbwgrbuubgurwbrbrwrubwruuwbuuubwwggwwburb
+rrwwbbggggbbbguuwgrugubuuugwbgrbruubwbgbwrwuwurwwbwgrrgwu
+wurwbbwggugrbgwwrurwbwwuugbuubggbwwrrwrrrrbuwgwwugrgwgguubr
+rwwbwguuwurwbuwugbwwgguggwuwruubgwwugrgwbgbgrbgbbwgrbw
+wgugbbgruggbuuguurbugbrgbrbbrgguurwubgbrbrbuwbrgug
+rububbbrbubwrbgbbbburrgubbuwguggwrgrgbggrbuwrrwbggubrbgbwur
+ugbuwwwubgbbwgrbwbuggrgbbbwubgwwurwuuuruwgwbuuubwbrrbubwb
+rurrrbuwgwggugwrbrggbbwbubbrrgbguwrguuwubwruwrw
+rwguwbbbbrgbrbwrrrbuurwuwrbrgwurgbrurrurwrbbuuuuuugggwu
+bbbubuwrgrruubruggrgwwburwbgwrbwgguurwgubrr
+bbugbrruurbgrwugbgwwgggwbbgurbgurbrbwrbrwuuugwurrurrugwwb
+uuuuubwwggbuuugwrgrrruuugrwurgwugrubwbwwurwuuurgwurgugugbwbrr
+brwurgguruwuburrrrrubwbgrwurwwbwguwrguuwgrrbr
+gbgugbbrbrrrrrbrwurubwugguggguurgbrwruubgbrbrg
+brurruruwuburrrrwuubbwrrgbbrgwbbgwbbwwbbuurwbuuruwgrwrrbbuwb
+urwubrwgugwrrwbuwugbgbwwwbbrgrrrgubggbwugrrurgwbgubbgurrbg
+bwurrgbwbbbbwrrgbbgrbuwubwbguwbbrbruwbbugwrgwu
+rrbgrgbwbbgrrgwugwbgwuurbgggrubwrurggwgurrbwrrwbuu
+bwuwgwwrrbbwbbwrguuburgrwbbgrbgwwrubrbuuwurwwgrrgbugrrbw
+rggrbgruggbwrruubuurruwwrggbgbrbrwbggbbrruurbrbgruggb
+wgbgruubbwbbwrbwbbbbgurggrbwrrugubuwwrrrrbgubwbubg
+rwwwwgbbguruwwbggrbwgbrwrbbuuguwgrbrubuwgwgrggrgrrubwg
+gwbugrurgbgwuggurbwrbrburrrgrwuuuwgwgguuwgwgggubruggbuugrrrbu
+uubgubwbbuubrubgbbuubggbrgwwgggruruubuuugwbgw
+bgwuwbbrrwrubwrburwwbbrbgrbwbuurubwbwuguuubrbbuwrb
+gbubwrurggwguubgurruwggbguwrwwuruwrrwwugbrbwrrwbggubbbburbgggrub
+wuguubgbgwuuguguguwgrbbwgrrrwubggugrbwurrwwwwgbbgburggrbwgbr
+wubggrgggurrbgubwbgwgbbbubgrwbrwgugwrrbgbbu
+gwbgubbbwbbgguwbbuwgguwbgwgbbugrrbgwuggrgbgwgwgrgg
+wububgrurugbugrrgwuurgggwwgwrgubrwurwrwrbu
+gbrgbrbrbuggwubrbuuwurwurgwugrubbguwbggbgwrgwubbgbr
+bgwrwbrugrgbwrwuwubruubwbwrbruwugbbwuwbgbbwuwb
+ggurwbrgurgurrwgrrrbrbrrubbuwgrbgruggbbgrwgwrr
+brbuuwurrgwurgbbbrwbbwuuggbwbbggugrwrbubwrbrbur
+bwbwgbwbwgrwubrwbwwurwbrrggwwgrurrrguruuwwurwuuuwbgbgrbgubwbw
+wgbgruugbbgrbuwwrrbbgbrgrbrwbggbubgbgwurwbuu
+bbwbrurwbuuruwgbrburbrubwwgbgurbrwubbwgrbubbwggrgbruw
+rrbrwrugrwuugbbbgugrrrrbbwgguwgbgurgwggwwwbuwrrrrgwr
+wruubgbwwuwwubgwrwbguuwggrbgbwbwguwwgurrgwu
+wrgbbrrgwbbruwrgrwbbbuwwrrgbgbbwrrwbrgug
+bugrrbbuurbgbbrbuuwurwbbwuwgggubuwrwrggwwbwbbbrgbrrrrbugbrbrbuw
+rwgubrbuwrwbruwrbbbuuubrbrrrbruguuggwugwgurggbwrrrgwwgggrw
+ruubbrbuuwurwuguuggwuruwgrburbbbguubwgrwrwrbuburubwb
+bwbbrgrrwbbruwrgrbgrburuurwrbbubwwrbgbubwuggugwrbrrrgbrbrw
+grrugguburrrugwwbrrugurwguuugugruwrgbrbrbuguwwbwgb
+uubbrbwugwbrugubwugggbrubgwbbgugrbwrrrbugbuubwuggg
+wbbuwgguwurgwugrgwbgubbbbbwrrrbwurgwugrurgurwgguubrrwuggrb
+gbgurubuwuuubgwggrrrbuwgbbwuuuggwwbwbburrrugbb
+uburrugurrbrgbrrwbgbwgrrrwwwubggwuubgubrwbrgrbgbgbwrrg
+rwbuwugwrrbgurbrwggubbuwgurruuwgrugubbwbrwwgwuwuw
+urbwrurbrugrwbubrbrgbrbrbubwbrwbrbgbbwgrggwbgrgbrrwbg
+wwurwuuuwwrggbgbbbwgrrbruwwgurrggbwbrguruwgwwwbuwr
+bbbrbrwuuwwubgbbugwbbruwrggrrgwuwggrgbruuguwgrbrwwwwgbb
+uwrguuwguuwgwgggbgwwgrgbuubuwbbwwggrgwrruwgwgrggbwbrgugrb
+rbwurbwrrrrguuguwwbbrgbrbguwrguuwguubgbbbguwwburbwgguubr
+bubwbwgrwrruggbuuggrggubuubguwuburrrbwgrrrwubggrgg
+guwgwrbruwurugrgbguuwbugbbbrgubgubrbbwuuugbbrgubgrgwurgwugu
+wrbrggbbrbwrbgwbbwwbrrbubwbbgububwugggbbbubbwg
+rbggrrgwwgggrurrbwubgbwggrbgbwrgrwuuugwuurwbrbwbugguburbwwuwwu
+wurbgruggbrwgggrwbubbugruubguubbgbrgrgbubuuwurbw
+wurwbbwbwgburgbrbrruwggbgwbrurgubbubwugguwuuwwubg
+uuwbugbggbruubgbrubgbrbrbgubbrwubuubuubuuwurbwrgbbrrgbrbgbwur
+uwbbrggbbuwwrrubbrbbugrrggwgbguwbrbrubgwwugrg
+wbrrwwgwuwubgbwbugwbbbbbgwrgbbrrgbbgbrgrwuggrbrugw
+bbguubruggwruubgrrwwuurbgrbbrbruwrrwbuwugrbubugwu
+rwrwrburburbgbubbwugwbrbrgrwrbuuwuburrrwrbgrwgbuuuuugggwu
+rbruguuurwurgggurbrrurwbrbgrgbggubuwggwrbgrrg
+gurrbgbbwrrgbbgbwururwbuurwbrrrwwgwuwurruuwgggbgrbrrwurwb
+wugrbrwbgburgbrrwbgbwbubgwgggurggbuggbuug
+wbgbgrbgrburugrwuruubwwwbbrgrrwgrrrbgbwbugw
+wggrgbruuubwbggugbbwuwbrubbrgwurgububwuggubgwuwbbrwubwbwbbuwggu
+gwrrburugbubuwuurburbrurwggbbuwbgubbuggwrbrrurwubw
+rgwurgrwguwbwubwruwrbwrrwgugwrbwrbrburwrbgwrrugrwrbubrgbr
+rrwbubwugggruwubrubwrgurubwggwbubbgurrbgbwbbuwrguuwgw
+brbuuwuruurgbrwgguubrrwbuwuguuubrubbwwgbbrbrwbggbbbgbrwgbbbuw
+wrbgwurwbugruuwgrugurrrgrgwwgggrbwurrgbbgwuwbbrgggwgrwrrwubwb
+wgrwrrgrgrrgrwubbrbbgwrrrubrbuuwurrruwgwuuguuguggbwrrg
+uwuurwubbbuggggugwgururuwrruguuwbrgubuuwurbuuwurrrbuwguguwgrbw
+uubgwuuwwubgwrbgbuuggwuuubgurrwbugbuugwwururbgggrub
+wrgubrruguruggwbbgbwggbbgbrwrbgrrgwrwrwrrr
+ubrwbbrbrwbwgbbbuuwrgrbgwwrurwuuwbbwuwrgurwwbwguubbr
+wggugubggbggwuruubwwwbuwrwrrbwbwgbugurrbgbbwuuug
+rwggrgbuuwwrbwrwrbrggbbgrrgwubbbugwruuwubrbuuwur
+uurgbrrwuuwubwruwrwbrgugugggguuwggbrbrwbgrrgrwuwrgrruuw
+ruwgwbuuwuburrruwrwwurggubwgggubwbbgbugrguugug
+wwburbbburwwwwgbbrwbwwubrbuuwurugbuurwggugrgbrgbgrwu
+rbggrgbgwubguubbuwrgbgbwbuggburrwuugbbgrgubuwgbgwbrrbbgu
+grwbbwbrugrrgbuubwrbwgwgrggbubrrgwrrrugwwbbugrguw
+wgggubgbugggbrbrrrrrbrwurgburrburwrurggwggbgwuuugurubggbgrru
+bwurrgbwbbwuwrruubuubgwbugbrbgwrbwbbgubbgbwbuguubgurwgugwr
+wwwbuwrrbrrwwbuguwwbwgbwrurrgwubbbbuubgwbbggrgbb
+wgbwubggrgggbrgwwbwbbubwbuwrubgbubwrggrbwgbrrgugbbbbwrrrb
+gruwruuguubwrbbgbugrwuubbugwbrbrbrrbrugbbuugwurwggurw
+urwbwbbubrugggubrrwbuubgwrruwwbuuurbugbbwbwggrgbru
+uwgggbgwgbwbbgguugggwwgbbwwurwuuurrwuwbggw
+wbbwuuwubwbuurbugbuwwrrgbgwuwbbwuggurgwwrwurwgrrrw
+rrwbbwbwgbubbwgrbggwubwrurbrugwbbbubrrguugugguwuwgbgu
+bugbwuuwwubgwbgbgrbgbwggrgubbwbbggugrrgwubwrwuwubbrbbugbuu
+bgwbbwwbrbrwbggbubbugwbruwbbgwwbrwbuggbuugruwgwbu
+rrugwwbgbbbgugbgwwgbbbrgbgugbwrwuwuubuwggrrwbrbgbrurrurburr
+uurbugrbgggrubwrubuuwbugbrurwubggrggbuugbubrwubwrgrw
+rrgbwugwbrgrrbwbbwugrwrbuuguwgrbrrrbuwrrwbggu
+wubggugwwurwuuubbwbgrbwrbrggbbwgbgruuubbuwgwgguugugurwrr
+rgbrbrubuuwurbbwbrruwbbubbwgrruuruwrrrrbubwbrwgugwrrwrwrbu
+grbgwwrugbbgrwgguguwrguuwggbrbuuwurwrbggrgrbubruuu
+bgrwgwrrwgbbbuuuubrubbgbgwurgrrrrbrbugrgurwrwrbuubrwbgru
+ggguwruwwwbuwrwwurwuuurrbwggurrwwuuwggrgbrurrbrwwbwgwrbggrw
+wubuuwrguuwgwwrrwruwruuruwrrwbrbgbbbgbrgwbgbuubgbgwuw
+uuwbugbgbwrrgrwgubbuwgugruubguruwubrwwrggbgb
+gbwrrrbrwgrrrwwugrggbbwuwbwbbwuubruuubbrgbgrurugb
+wbbuwggurburubbbrbubrugguubbruubugggbrubbggubruuu
+wuburgwggrbwrgbubbbuugwuuguuwbuugwurrurbwrruguuuubwwgg
+ggbrggggwggrgbruwrbruwuugguburwbbgggwubbwrrgbbguwwbwgb
+bbuwbuguwwgugrrwggruwubrwruubgrubuuguwgrbwbgwgbwbbrbr
+ubgbgwurbwrugwgurwuuwwubguurgbruuwgwgguuwgrugugwwbwbbubuwrwrwrrrw
+uruugwrbbgbrggrwburbbwbrwugrrurrgrrubbggwbbgugbbgruwwbwrrbbrbrrw
+gbgbbwrrwrbgrugrgubbugwbuggbuuguguuwbrgubrwbgruw
+bwwgbgurugbuurwbuwugwgrwbbrgugbbbbwrurbruwurwbwurgwugrrwbw
+ubbuwgbbbuwuuwrbrggbbwrbrggbbrwgguuubwwggbwbrruuubrubb
+uuwbwwbrbbbgbrburrbuurbururrgwuwrgruuuuugwbrgugrbubugwuw
+ubruuubgrbbrbrrwbbggbrrrrgbwrgwuurbbwbgbgugbbugbgug
+bwwggrrgbgrbubruuuggrgbbbrwrwbruwrgruwrwrgrrruurwrwrbuwrrrgg
+ubbbguubbgubrrrbgrggruubrubbgrggrbugrbugwb
+wrguwgbwurgwugrbwbrguwwrrwrguuuuuugbugbuuwgwgrggrrbubwb
+ubwbubgrwbwwuubwbbwrurbrubbuugwuguurwbwubggugwrbrggbbw
+uuubwwggruwrbggrugrgrrggbbrbbrrgburwgbwrguggbgwuwbbr
+guwbggbuggbuuggwwbwbbrbwuruurrwwgwuwurruuuwbugbugbuubbbbuuubgw
+wrbbubwbwgbubbgbrrbgggrubbbrbbgwrbugrgububwg
+ubbrrbrbbgbrbrruurbbbuuubbugwbbwbrguwbrgugwgbgruuw
+wwwbuwrruwggbguuubrubbgbbgrurwwurwuuuwugrbrrwuwrbw
+bgwuwwrrgwbwrgrrruuwubwruwrbwbrguwrwrwrrruwbbrggbuggubur
+rrbrwruguwrugwuggbbbrrbwurwggguwwbwgbbgbuwbrguguwrububwgurwub
+gurwugrbrugbuuwgwgrgguggbuugrwrbbubgruwruruuwburgwbr
+rbggrwrgbbugbwbrruwuurbgrbrugrgruwrbggrgurwubrwbrr
+ubbugwbbwbrgurrubrurrurbgrwgugwrubgbgwuggwwgw
+gurubrgwwbrwbrrgrwuubwgwwrwguwrwbruwrgurwrrruggrrrrbubwbw
+ugwgurbbugrgwurgrugrggruwrgrbuubbugwbwgbbburgwb
+ubwbbwrbbrbrrbrgbrwbuurubgwbugbrgubgbgwuwrbggrw
+wrrrbrrubwgwburgbwrbrburbggggggbubrrgbwwuwwuwrwbruwr
+wrruubuuuugwuugrwubguburrwubguuubguuwuburrrbugrgbrbrr
+rbgggrubwwrgurrbgwwbggrgbbrbgbrruwwwgbrwwbwgwgbgwbrr
+uggurbbrbgruggbbgrbwggbwuwgbwrbrburguurbwurrgbruubw
+bwwuwwurbruguuwbgwgbrwgggguwuubwugggubruggwbrrwbuwug
+wrguuggurbbbbwrrgbbbbuwbrrgbgrbuwuburrrrwrbbubw
+rrrbrwurwwwruwubwbrbbgbubwuwgwrugbbwuwbwru
+uwuugurggbbugrguguwwbwgbbwbrgurgwwgggrwrgrrruurbgggrubw
+grbguwbggbubbrruurbugruubguwguuwuwugurgwurgrgwwrwuwrbrggbb
+ugggguubbwugwbrwrgbbrrgrubbggwurwwgwuwuwrbgwruubg
+wrubwbbubwuggubwbwgrrgburuwubrrwuubrwuuurbbuuuwbwubggrgg
+rrgubwugggbbwuuugbuurrgwwgggrgrwrburbgubrbuguwg
+bubwbbrubbgggwuubbwgbuuwwgubrurrurubgwggbbwgrb
+rwbbrwwbbwbuuubrubburrrwbuuwrgubgwugrrgwuwugurruuuugu
+guwgrwuwrbgbwrrgwggugwgbgrrbrrbrwrugwwurwrubwrw
+grwbwrgrrruurrugwwbuuwbwwbbwbrgugurggbwbrguggwbgubb
+ruggwwurubwggrgubuuwuuuuguggwrbrrguubrgugbbbubr
+bugrguuruubgubruggbuguwguuwgruguuwuburrrwggurwbgrrgrwu
+uwuburrrgrwurwuuuruubuwrggbgbbwrrubuuwurbbbbubwugwbr
+uurgbruuwbugbugugrgbgrruwuburrrgwwururbgggrub
+rgwurgrbwrgrrgwugrgrwrrubuggggugbbrbbgwrgbwrrgwrbggr
+bgwbugbuuuuugbwwgbgurrwgrrrrwwwwgbbubguggwuggbuwrgrrurbwur
+wgguggbrbrbubrbrrubbrrburubbrgubgbbubuwbuubbrugrwbrbgwuuwwubg
+rgwgggrwurbruguubbuubwugggbgwrwbbwgrrrrrrbrwurbwgg
+uuubwwggbwbwgbuuwbbuubwuggggggbwguurgrwuubbbrbubbbwuuug
+rwbrbguggurbbugbuguggggrrurwburwrbgrrgrguurbwubbrbbgwr
+bbwgrbwgbgruuwruubgrgwurggruwrwbbwurgrwgugwrgubrugbuugguw
+ggggurguwbbubwrbrburgbruubwbuugwurrubgbuwrugwuurwuggrbrwrubwr
+grrubuwrrwbggurrbrwbwwuwgrwgugwrgbrwwburbugruubguuugwurruwrgr
+uuwgwggbwrrrburgruwgwbuwugurruuuuguwggubwrbubruuuubbr
+wrbggrwrwrwrrrgwgubwrrrbgwrbwbuuguguwggrrugrwwrwguurgbbuwbubwbubg
+rrugbbwwugrggbwurubwrwuwuggrbwgbrbrbgbwurrbuwwgu
+grguuuuugrrgbgrbwruubgbwurubrurruruggbuugbubrrggubrrwgwru
+uuubwwggrggwbgburrugbbubwburgbgrbgbgwuuggwuuwrwwurrwrwrbu
+uubguuurgrgrwrrrbggrrrgbgrbgbbbgurgugbbbwwgrrgw
+uguwgrbuwwwgbbwbwgwrgbbrrgwwwubguurbugrrbgbgbbwrrwwurwuuu
+ubwuggugbbwgrrruggwurbgggrubrgwwgggrbbrgbubwgbbbrgrwbw
+bbubugruubgubbgbrgwgguubrwbbwuwwugbgugbbrgugugrwrubwrbrbrg
+ubwburgurwgbwurgwugrbrbrubrwbgruubugruwrwwrwgbgruuuuwbbgwrru
+ugggwurgwbwwburbrggwbgrwgbubrrgubwbgbubwrbruwurwbbbuuggbuugw
+grrbrrwuwbgggubrrrugurbbgugbrbrbugrgbwggrgubwrruubuuw
+rwgubrburgggurbgwrgwubwbbggugburrruugruubgu
+bwwggugrbwugwbrrrugwgurwubwbgrwugwbbbrubbggrbgurbgbwurubwwgbgurw
+uwgggbguuwbugbwbgbgrbgwggrgbrurgbuuwwwubg
+ubwrbbrubbggwuguwgbbburrgubwugguuwruwrurggwguwruggg
+rgwrruubruuugwwbwbbuwwwgbrgrrrwbrrrwbrbgbwbrrrburubbwbrbgurb
+bbgbrggrrbrbbuwrbrgbgwrrwbrbgbubwgrrrrgugbbb
+uwbbuuuubrubbrgwwrwurwgubrbugrguuubrubbuggurbbrwrubwrbgrwwgwuwu
+rwbrgwwburrbwrbggbbgrrbgrbrbgggrubwrrwuwuuwwubgrrbrwwugbrbbrubbgg
+burrwrgrubwrggbgbbwrrbgbrggubuwggwggurwbguuwgwggw
+wwgrrgbrbrgrwgguubrgbubgrguguguggwurgrrbbugwwrrwrwubwggu
+ubruuuwbgbuuwrurubbwububgubrbgbrwbuwugrbbwb
+uwrgbgbwbugrrgwubbbuguwbggbwrgrgrgrbuwgbguuugurwgbwbr
+uwgggbgguwgrwbwwubguubbgrrgwuurrbwrwuuwbbbbrbbgwrw
+uwrguuwgrbgbrubgruwgwbuwrbrggbbgrrbrubbuwbrwgbgubuwwbwrr
+rrgrwugurrbgrgwggrwbuurrrbuwgrbgrbgurubuwuuggrrwwuu
+uruwrrbbugbbrgubgwrgrbgguurbugbbbgbgwrwbwrrwbgguwwburbwgbgruu
+rwububbrwwburbrwuubgwugbbwuwbugburwbrrggbruguwgrbwrbrggbbw
+urrrgrwgbrbuuwurrwubbwrrgbbbugrrbwrrruuuuguwwbuubbugwbrubu
+guubbrbrrwguwbrwbrbgbbrrgrrrwwugbrbbuguwgbwrwwbbwgggubrrugur
+bgbuwbbrbrbbgubgbwbugbwrrrbrrwuwbggbwuwgbgbuw
+brurrurwurwbubgbgwuggbwbbggurrgrwurbbwbwruubgguruwwbwuguuur
+uuwbugbwwuburuubburbwrwgwgrggwwbrgbgrwbuurrugbbgrgrrrgrwbw
+bbgubgubgrwrbuwrgubbrbrrrbwrrrgrrwbggwbbgu
+rwbwwuubwrbbuguwgbwubgrburbuubguwwrwgubbrbrrwwgbbuwbbrggb
+gbwuruggwwgrwrwrburwgbwwuubruuuwrgurrbugrguwbggbrbbwb
+ubbuuubrubbbbrbbbwbrruguuwbrgrwrbbubwwrbgbbwbbggu
+rrrbrwurrugrgubwbgbrbrbubuwbwrgubwbwgburrrbrwur
+wrguburuwrrubwuggggugbugrbbwbrgbububwugggbburbubugwu
+wrwrwrrrrbgruggbwgbguubwrgrwbrrwubwbggguurbbu
+gurrbgwugrrurggbgbbwugwbruurgbrwrgubbwrbbbbuw
+brgbrurwgubrrgbgwugwbgubbuwgggbgggwurgwugrrgwggw
+rrrbrwurwubggugbgrbrwuggrbubruggubbrgubgrwbrbgbbuwbrrrg
+wwrrwrwgbgbwrrgbubwbrgwggrwwgwuwugwbgubbbrbbuwrb
+guwbggbwrrwrbruwurugrgruwubrwubwbubbubgrrwwuu
+guwgbgbwubwbgubbwgbwrbrburrgbgrwuwrbugrgbrgb
+rwrubwrrwbwwubrruurbgbwrrgbbwbbbgbrgrbubugwurwbwbbruwrg
+ruwggbrrrgburbgurbbrubbggwwbuuwgrugrugrgwbbrbrrwgugwrgrrb
+wggrbwrbbugurwuggrbwggugrugbbuwbbwggguwbggbwwgrrg
+burrbubugwugguwurrugbbbwgggurrbgugrgbrgb
+wbgburuwubrbbwuuugwwwubgbgrurugbrrbrwrugbuguwg
+bgwubwbrrwwwubgrbruguubuwrgrruwguuwuubbuwgwrgrbgwwrbgbugwwwrw
+rbggrubgbuururgugwgurbrrwgwruuuwbbgrwwugbrbwwgwwbuwr
+ruwubrrrbgrwgbgrwbuwrbggrurrgbgggwugurubwrurbrwbggb
+rgburgwurwbbbuwbwbuurugggbururwgubrrgurrbggbgbbwrruwrgburwgrrr
+brbrrrwwuurrbrubwrbbwbbrwguwbbbgbrrwuburwgubrr
+rwubgwuwbbrwgggubgbgugrwbrgbbwgrbwrrwbgguru
+buuugwbggurrbgrbbwbbguburrbwrrwwbwgbrurrurugrbubrbwrbggr
+ggbrurwgubrruugwuugurbubrrgguurwbrgbgrbubuurwwbgrwu
+gwrbrrgrwurrbrrugbbrwwgwuwuggbwurubrgbrburrrgbuw
+bgwurrbrwwrrwruwbbubbrrwbuwugggrbwgbrwwrwgugbrbrbugwbgubbw
+bbubwuggrgbbubrwbgruugwgururgurbbwguwwbwrrbwwgbgurrububrbrw
+uubgubrurrurggwurggwbgwuuwwubgrrgbgrbbbrbbgwrbbbrbub
+wrbggrbgrbuggurbbrwbrrguurrrrbuwgubwrbuuguwwwbbrgrwubu
+wggugrwbuwugrwrbbubuugwuugwuggrwgubrburbruguuwr
+grbugrgrwrrbgrwgwrrguwbggbgwbbrbwrruguruwrbggrwguwbruwggbggbbgr
+uwggrggbbwuwbwwwbbrgruuubwwgguubwbrgrrbgbbbbgw
+urggbgwwguruugguwuuwrbwwuwwugwburgwubwbgugrgrwrr
+bbbrbubbuwrgrrubbwuuugrrbrwrugruwubrbbwuuugwrrubruuu
+wrruubuurbbwbrgwggwrrwuggwurwbrbrugguubbwggugrbgwburgbuuugwbg
+rwrubwrwwwubgbbrbbgwrubrwbgrugbgwwgrwrbbubrwwbbwb
+bwrurbruwggugrwuggrbgrbgwwrubguburbrwuwrwbruwrgrrgwubbrgubg
+ggwbbgurbubugwurwbuwugrrbrbrggggwrbwbwgburburu
+wwburbrwggbgbwbugrwgbwrgrrugguubbubbbrwuuguuwbrgubwuggg
+uwrguuwgwwbuuwgggbggrwurgwugrrrugbbbwugwbrggwbbgurgwgg
+buwrgrrurugrrugurubgwggrbwrrugurbwrguwwbwgbbwbrr
+brubbgggrruuwgwgguuwuguuwggrrrgwrbbwbwruggrbwgbrrwuurwbwrwbruwr
+rgggurbgrrgwubwwuwwugubuwwwbbrgrguwbggbugrbubrb
+rgwurggubrrgrrrruuuuguurgurwrbruwuwugrrurwugu
+wgguguwrgruwubrwrruubuubgwbbwwbbbrbbbwbbwrruubuurrgrwu
+bwrrrrbgbuguwgrubrbrguuuuuggbbgugbgwbbubruuuuwrg
+bbrbbwrurggwguugwurrugrburuwgwggugbuurbrbrrrgrrubbubggbw
+ggrbwgbruwwguggurrgbwrurbruuurbuguwgggbg
+buuurwgubrruwwguuugwurruwwrguwbggbugbbwgbwuwg
+rwbrbwurgwugrbwrurbrurgrruwrguuwguwbbrggbwrgwrguw
+rrrbuwgwwurgbrbrrrgwuwgbbrruurbubbugwbgrubwggugbug
+rburuwwwbbrgrrurwrgrrruuuwuburrrwrbbubbbububbbrrwgrwwbbwb
+gwwurubrbrgrwgbrwwugbrbbrurrurwwgrrgwbgbgrbgwuuwuburrrw
+rruuuuguugrgbrgbrwwugbrbuwbbrggbuubgrgrrwrgubwrbrbur
+guuwggruwgubbrwwgwuwugurrbggrwbrbbwbggbbrbrrrbrwbggb
+bbbrwrgbbrrggwrbrbrgrwwgwuwuwbbuwgguwbgwgbbwubgbguubb
+bgubrbwrbrggbbrgwrrurugrgubwrbugugbrbgbwur
+rwbwwurbwrrugurgrgburrburgwbuuuuuggubuuwgggbgbubrrgrbruguuw
+grburgggurbuwbbrggbuggwubbbubwrguwrrwbgguggwwgrwuwrbgbwururrgbgrbw
+wubggugrwbbugrgbrgbuguuwbrgggggbgrbubruuuwrbrggbbbguubb
+`
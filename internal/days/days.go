@@ -0,0 +1,239 @@
+package days
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/answer"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day01"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day02"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day03"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day04"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day05"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day06"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day07"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day08"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day09"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day10"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day11"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day12"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day13"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day14"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day15"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day16"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day17"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day18"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day19"
+	"github.com/amoilanen/advent-of-code-2024/internal/render"
+	"github.com/amoilanen/advent-of-code-2024/internal/term"
+)
+
+// Day describes one puzzle: its real input and how to solve both parts of any input
+type Day struct {
+	Number int
+	Input  string
+	Part1  func(input string) answer.Answer
+	Part2  func(input string) answer.Answer
+
+	// Animate records the day's simulation with every cell drawn as scale x scale pixels and reports
+	// whether it got to the end of the simulation rather than stopping because rec was done
+	// Nil for days that are not simulations
+	Animate func(input string, scale int, rec render.Recorder) bool
+
+	// Play prepares the day's simulation for the terminal player; part picks the puzzle part's rules
+	// Nil for days that are not simulations
+	Play func(input string, part int) (term.Simulation, term.Palette)
+}
+
+// Name returns the zero-padded package name of the day, e.g. day01
+func (d Day) Name() string {
+	return fmt.Sprintf("day%02d", d.Number)
+}
+
+// Solve runs both parts on the day's real input
+func (d Day) Solve() (answer.Answer, answer.Answer) {
+	return d.Part1(d.Input), d.Part2(d.Input)
+}
+
+// all lists every solved day in order
+var all = []Day{
+	{
+		Number: 1,
+		Input:  day01.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day01.Part1(day01.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day01.Part2(day01.MustParse(input))) },
+	},
+	{
+		Number: 2,
+		Input:  day02.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day02.Part1(day02.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day02.Part2(day02.MustParse(input))) },
+	},
+	{
+		Number: 3,
+		Input:  day03.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day03.Part1(day03.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day03.Part2(day03.MustParse(input))) },
+	},
+	{
+		Number: 4,
+		Input:  day04.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day04.Part1(day04.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day04.Part2(day04.MustParse(input))) },
+	},
+	{
+		Number: 5,
+		Input:  day05.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day05.Part1(day05.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day05.Part2(day05.MustParse(input))) },
+	},
+	{
+		Number: 6,
+		Input:  day06.DayInput,
+		Part1: func(input string) answer.Answer {
+			grid, guard := day06.MustParse(input)
+			return answer.FromInt(day06.Part1(grid, guard))
+		},
+		Part2: func(input string) answer.Answer {
+			grid, guard := day06.MustParse(input)
+			return answer.FromInt(day06.Part2(grid, guard))
+		},
+		Animate: func(input string, scale int, rec render.Recorder) bool {
+			grid, guard := day06.MustParse(input)
+			return day06.Animate(grid, guard, scale, rec)
+		},
+		Play: func(input string, part int) (term.Simulation, term.Palette) {
+			grid, guard := day06.MustParse(input)
+			return day06.NewPatrol(grid, guard), day06.Palette
+		},
+	},
+	{
+		Number: 7,
+		Input:  day07.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day07.Part1(day07.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day07.Part2(day07.MustParse(input))) },
+	},
+	{
+		Number: 8,
+		Input:  day08.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day08.Part1(day08.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day08.Part2(day08.MustParse(input))) },
+	},
+	{
+		Number: 9,
+		Input:  day09.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day09.Part1(day09.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day09.Part2(day09.MustParse(input))) },
+	},
+	{
+		Number: 10,
+		Input:  day10.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day10.Part1(day10.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day10.Part2(day10.MustParse(input))) },
+	},
+	{
+		Number: 11,
+		Input:  day11.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day11.Part1(day11.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day11.Part2(day11.MustParse(input))) },
+	},
+	{
+		Number: 12,
+		Input:  day12.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day12.Part1(day12.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day12.Part2(day12.MustParse(input))) },
+	},
+	{
+		Number: 13,
+		Input:  day13.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day13.Part1(day13.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day13.Part2(day13.MustParse(input))) },
+	},
+	{
+		Number: 14,
+		Input:  day14.DayInput,
+		Part1: func(input string) answer.Answer {
+			return answer.FromInt(day14.Part1(day14.MustParse(input), day14.Width, day14.Height))
+		},
+		Part2: func(input string) answer.Answer {
+			return answer.FromInt(day14.Part2(day14.MustParse(input), day14.Width, day14.Height))
+		},
+		// Play the robots up to the moment they show the Easter egg
+		Animate: func(input string, scale int, rec render.Recorder) bool {
+			robots := day14.MustParse(input)
+			seconds := day14.Part2(robots, day14.Width, day14.Height)
+			return day14.Animate(robots, day14.Width, day14.Height, seconds, scale, rec)
+		},
+		Play: func(input string, part int) (term.Simulation, term.Palette) {
+			return day14.NewBathroom(day14.MustParse(input), day14.Width, day14.Height), day14.Palette
+		},
+	},
+	{
+		Number: 15,
+		Input:  day15.Input,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day15.Part1(input)) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day15.Part2(input)) },
+		// The wide warehouse of part 2 is the one worth watching
+		Animate: func(input string, scale int, rec render.Recorder) bool {
+			return day15.Animate(input, true, scale, rec)
+		},
+		Play: func(input string, part int) (term.Simulation, term.Palette) {
+			return day15.NewRobot(input, part == 2), day15.Palette
+		},
+	},
+	{
+		Number: 16,
+		Input:  day16.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day16.Part1(day16.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return answer.FromInt(day16.Part2(day16.MustParse(input))) },
+	},
+	{
+		Number: 17,
+		Input:  day17.DayInput,
+		// A program that never halts has no answer; fail like MustParse does on malformed input
+		Part1: func(input string) answer.Answer {
+			output, err := day17.Part1(day17.MustParse(input))
+			if err != nil {
+				panic(fmt.Errorf("day 17 part 1: %w", err))
+			}
+			return answer.FromString(output)
+		},
+		Part2: func(input string) answer.Answer { return answer.FromInt(day17.Part2(day17.MustParse(input))) },
+	},
+	{
+		Number: 18,
+		Input:  day18.DayInput,
+		Part1: func(input string) answer.Answer {
+			return answer.FromInt(day18.Part1(day18.MustParse(input), day18.Size, day18.Fallen))
+		},
+		Part2: func(input string) answer.Answer {
+			return answer.FromString(day18.Part2(day18.MustParse(input), day18.Size))
+		},
+	},
+	{
+		Number: 19,
+		Input:  day19.DayInput,
+		Part1:  func(input string) answer.Answer { return answer.FromInt(day19.Part1(day19.MustParse(input))) },
+		Part2:  func(input string) answer.Answer { return day19.Part2(day19.MustParse(input)) },
+	},
+}
+
+// All returns every solved day in order
+func All() []Day {
+	return all
+}
+
+// Lookup finds a day by number or name; "1", "01", "day1" and "day01" all refer to day 1
+func Lookup(name string) (Day, bool) {
+	number, err := strconv.Atoi(strings.TrimPrefix(name, "day"))
+	if err != nil {
+		return Day{}, false
+	}
+	for _, day := range all {
+		if day.Number == number {
+			return day, true
+		}
+	}
+	return Day{}, false
+}
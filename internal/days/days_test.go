@@ -0,0 +1,125 @@
+package days
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/answer"
+	"github.com/amoilanen/advent-of-code-2024/internal/render"
+)
+
+// loadAnswers reads the verified answers for the real inputs, keyed by day name
+func loadAnswers(t *testing.T) map[string][2]answer.Answer {
+	t.Helper()
+	data, err := os.ReadFile("testdata/answers.json")
+	if err != nil {
+		t.Fatalf("reading answers: %v", err)
+	}
+	answers := map[string][2]answer.Answer{}
+	if err := json.Unmarshal(data, &answers); err != nil {
+		t.Fatalf("decoding answers: %v", err)
+	}
+	return answers
+}
+
+func TestAnswers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("solving every real input takes a few seconds")
+	}
+
+	answers := loadAnswers(t)
+	for _, day := range All() {
+		t.Run(day.Name(), func(t *testing.T) {
+			want, ok := answers[day.Name()]
+			if !ok {
+				t.Fatalf("no verified answers for %s", day.Name())
+			}
+			part1, part2 := day.Solve()
+			if !part1.Equal(want[0]) {
+				t.Errorf("Part1() = %v, want %v", part1, want[0])
+			}
+			if !part2.Equal(want[1]) {
+				t.Errorf("Part2() = %v, want %v", part2, want[1])
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name   string
+		number int
+		ok     bool
+	}{
+		{"1", 1, true},
+		{"01", 1, true},
+		{"day1", 1, true},
+		{"day01", 1, true},
+		{"day15", 15, true},
+		{"99", 0, false},
+		{"dayX", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		day, ok := Lookup(tt.name)
+		if ok != tt.ok || day.Number != tt.number {
+			t.Errorf("Lookup(%q) = day %d, %v, want day %d, %v", tt.name, day.Number, ok, tt.number, tt.ok)
+		}
+	}
+}
+
+func TestDaysAreOrdered(t *testing.T) {
+	for i, day := range All() {
+		if day.Number != i+1 {
+			t.Errorf("All()[%d] is day %d, want day %d", i, day.Number, i+1)
+		}
+		if day.Input == "" || day.Part1 == nil || day.Part2 == nil {
+			t.Errorf("%s is incomplete", day.Name())
+		}
+	}
+}
+
+func TestAnimate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("animating real inputs takes a few seconds")
+	}
+
+	for _, day := range All() {
+		if day.Animate == nil {
+			continue
+		}
+		t.Run(day.Name(), func(t *testing.T) {
+			recorder := render.NewGIFRecorder(render.GIFOptions{MaxFrames: 2})
+			day.Animate(day.Input, 1, recorder)
+			if recorder.Frames() != 2 {
+				t.Errorf("Animate() recorded %d frames, want 2", recorder.Frames())
+			}
+		})
+	}
+}
+
+func TestPlay(t *testing.T) {
+	var playable []int
+	for _, day := range All() {
+		if day.Play == nil {
+			continue
+		}
+		playable = append(playable, day.Number)
+		t.Run(day.Name(), func(t *testing.T) {
+			sim, _ := day.Play(day.Input, 1)
+			if !sim.Step() {
+				t.Error("Step() = false on the first step")
+			}
+			if len(sim.Frame()) == 0 {
+				t.Error("Frame() is empty")
+			}
+		})
+	}
+
+	if want := []int{6, 14, 15}; !slices.Equal(playable, want) {
+		t.Errorf("days with Play = %v, want %v", playable, want)
+	}
+}
@@ -0,0 +1,104 @@
+package gen
+
+import (
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strings"
+)
+
+// Options controls a generator
+type Options struct {
+	Seed int64 // The same seed and size always produce the same input
+	Size int   // What size counts depends on the day, see Describe; zero picks the size of a real input
+}
+
+// Generator builds a random but valid puzzle input of the given size
+type Generator func(rng *rand.Rand, size int) string
+
+type generator struct {
+	generate    Generator
+	defaultSize int
+	size        string // What the size knob sets
+}
+
+var generators = map[int]generator{
+	1:  {lists, 1000, "the number of lines"},
+	2:  {reports, 1000, "the number of reports"},
+	3:  {memory, 700, "the number of instructions"},
+	4:  {wordSearch, 140, "the side of the square grid"},
+	5:  {printQueue, 200, "the number of updates"},
+	6:  {lab, 130, "the side of the square grid"},
+	7:  {equations, 850, "the number of equations"},
+	8:  {antennas, 50, "the side of the square grid"},
+	9:  {diskMap, 10000, "the number of files"},
+	10: {topography, 55, "the side of the square grid"},
+	11: {stones, 8, "the number of stones"},
+	12: {garden, 140, "the side of the square grid"},
+	13: {clawMachines, 320, "the number of machines"},
+	14: {robots, 500, "the number of robots"},
+	15: {warehouse, 50, "the side of the square grid"},
+	16: {reindeerMaze, 141, "the side of the square grid"},
+	18: {fallingBytes, 3450, "the number of bytes"},
+	19: {towels, 400, "the number of designs"},
+}
+
+// Days lists the days that have a generator, in order
+func Days() []int {
+	return slices.Sorted(maps.Keys(generators))
+}
+
+// Describe explains what the size knob sets for a day and what the default is
+func Describe(day int) (string, bool) {
+	g, ok := generators[day]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("size is %s (default %d)", g.size, g.defaultSize), true
+}
+
+// Generate returns a random input for a day
+func Generate(day int, opts Options) (string, error) {
+	g, ok := generators[day]
+	if !ok {
+		return "", fmt.Errorf("no generator for day %d", day)
+	}
+	if opts.Size < 0 {
+		return "", fmt.Errorf("size must not be negative, got %d", opts.Size)
+	}
+
+	size := opts.Size
+	if size == 0 {
+		size = g.defaultSize
+	}
+	return g.generate(newRand(opts.Seed), size), nil
+}
+
+// newRand creates the random source for a seed; PCG output is stable across Go releases
+func newRand(seed int64) *rand.Rand {
+	return rand.New(rand.NewPCG(uint64(seed), 0x2024))
+}
+
+// between returns a random number in [lo, hi]
+func between(rng *rand.Rand, lo, hi int) int {
+	return lo + rng.IntN(hi-lo+1)
+}
+
+// joinLines joins rows of a grid into the text of the input
+func joinLines(rows [][]byte) string {
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		lines[i] = string(row)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// filledGrid creates a side x side grid with every cell set to fill
+func filledGrid(side int, fill byte) [][]byte {
+	rows := make([][]byte, side)
+	for y := range rows {
+		rows[y] = []byte(strings.Repeat(string(fill), side))
+	}
+	return rows
+}
@@ -0,0 +1,91 @@
+package gen
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/days"
+)
+
+// smallSizes keeps the solvers fast while still exercising every generator
+var smallSizes = map[int]int{
+	1: 50, 2: 50, 3: 50, 4: 20, 5: 20, 6: 20, 7: 20, 8: 20,
+	9: 100, 10: 20, 11: 5, 12: 20, 13: 20, 14: 50, 15: 12, 16: 15, 18: 1500, 19: 50,
+}
+
+func TestDays(t *testing.T) {
+	// Day 17 has no generator: its input is a program, which has no size to scale
+	want := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 18, 19}
+	got := Days()
+	if !slices.Equal(got, want) {
+		t.Fatalf("Days() = %v, want %v", got, want)
+	}
+	for _, day := range got {
+		if _, ok := Describe(day); !ok {
+			t.Errorf("Describe(%d) is missing", day)
+		}
+	}
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	for _, day := range Days() {
+		t.Run(strconv.Itoa(day), func(t *testing.T) {
+			opts := Options{Seed: 42, Size: smallSizes[day]}
+			first, err := Generate(day, opts)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			second, _ := Generate(day, opts)
+			if first != second {
+				t.Error("Generate() gave different inputs for the same seed")
+			}
+
+			other, _ := Generate(day, Options{Seed: 43, Size: smallSizes[day]})
+			if other == first {
+				t.Error("Generate() gave the same input for different seeds")
+			}
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		day  int
+		opts Options
+	}{
+		{"unknown day", 26, Options{}},
+		{"day zero", 0, Options{}},
+		{"negative size", 1, Options{Size: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Generate(tt.day, tt.opts); err == nil {
+				t.Errorf("Generate(%d, %+v) error = nil, want an error", tt.day, tt.opts)
+			}
+		})
+	}
+}
+
+func TestGeneratedInputsSolve(t *testing.T) {
+	for _, number := range Days() {
+		day, ok := days.Lookup(strconv.Itoa(number))
+		if !ok {
+			t.Fatalf("day %d is not registered", number)
+		}
+		t.Run(day.Name(), func(t *testing.T) {
+			for seed := range int64(3) {
+				input, err := Generate(number, Options{Seed: seed, Size: smallSizes[number]})
+				if err != nil {
+					t.Fatalf("Generate() error = %v", err)
+				}
+				part1, part2 := day.Part1(input), day.Part2(input)
+				if part1.IsZero() && part2.IsZero() {
+					t.Errorf("seed %d: both parts are zero, the input gives the solver nothing to do:\n%s", seed, input)
+				}
+			}
+		})
+	}
+}
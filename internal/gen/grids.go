@@ -0,0 +1,258 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// Neighbouring cells in the order up, right, down, left, which is also the guard's turning order
+var directions = [4][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+
+// lab generates day 6: obstructions on about one cell in twenty and a guard facing up
+// Day 6 assumes the guard eventually leaves the lab, so starting points that trap the guard are never used.
+// Of size random starting points, the guard gets the one with the longest patrol, as random
+// layouts otherwise tend to let the guard walk straight out.
+func lab(rng *rand.Rand, size int) string {
+	for {
+		rows := filledGrid(size, '.')
+		for _, row := range rows {
+			for x := range row {
+				if rng.IntN(20) == 0 {
+					row[x] = '#'
+				}
+			}
+		}
+
+		bestX, bestY, longest := 0, 0, -1
+		for range size {
+			x, y := rng.IntN(size), rng.IntN(size)
+			if rows[y][x] == '#' {
+				continue
+			}
+			if steps, ok := patrol(rows, x, y); ok && steps > longest {
+				bestX, bestY, longest = x, y, steps
+			}
+		}
+		if longest >= 0 {
+			rows[bestY][bestX] = '^'
+			return joinLines(rows)
+		}
+	}
+}
+
+// patrol counts the moves of a guard starting at (x, y) facing up until it walks off the grid
+// It reports false if the guard gets stuck in a loop instead.
+func patrol(rows [][]byte, x, y int) (int, bool) {
+	type state struct{ x, y, dir int }
+	seen := make(map[state]bool)
+	dir := 0
+	for !seen[state{x, y, dir}] {
+		seen[state{x, y, dir}] = true
+		nx, ny := x+directions[dir][0], y+directions[dir][1]
+		if ny < 0 || ny >= len(rows) || nx < 0 || nx >= len(rows[ny]) {
+			return len(seen), true
+		}
+		if rows[ny][nx] == '#' {
+			dir = (dir + 1) % 4
+			continue
+		}
+		x, y = nx, ny
+	}
+	return 0, false
+}
+
+// antennas generates day 8: groups of three to five antennas sharing a frequency
+// There is about one antenna for every sixty cells; frequencies are digits and letters of either case.
+func antennas(rng *rand.Rand, size int) string {
+	const frequencies = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	rows := filledGrid(size, '.')
+
+	for placed := 0; placed < max(size*size/60, 1); {
+		frequency := frequencies[rng.IntN(len(frequencies))]
+		for range between(rng, 3, 5) {
+			rows[rng.IntN(size)][rng.IntN(size)] = frequency
+			placed++
+		}
+	}
+	return joinLines(rows)
+}
+
+// topography generates day 10: random heights crossed by one hiking trail per grid side
+// Trails start at a 0 and climb one height per step until they reach a 9 or the edge of the map.
+// They only head right or down, so a trail never runs over itself.
+func topography(rng *rand.Rand, size int) string {
+	rows := filledGrid(size, '0')
+	for _, row := range rows {
+		for x := range row {
+			row[x] = byte('0' + rng.IntN(10))
+		}
+	}
+
+	for range size {
+		x, y := rng.IntN(size), rng.IntN(size)
+		rows[y][x] = '0'
+		for height := byte('1'); height <= '9'; height++ {
+			if rng.IntN(2) == 0 {
+				x++
+			} else {
+				y++
+			}
+			if x >= size || y >= size {
+				break
+			}
+			rows[y][x] = height
+		}
+	}
+	return joinLines(rows)
+}
+
+// garden generates day 12: regions of plants grown from one seed per forty cells
+// Regions grow breadth first from all seeds at once in a random order, which gives irregular shapes.
+// With only 26 plants, neighbouring regions often share a plant and merge, like in the real input.
+func garden(rng *rand.Rand, size int) string {
+	const plants = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	rows := filledGrid(size, 0)
+
+	type cell struct{ x, y int }
+	var frontier []cell
+	for range max(size*size/40, 1) {
+		x, y := rng.IntN(size), rng.IntN(size)
+		if rows[y][x] == 0 {
+			rows[y][x] = plants[rng.IntN(len(plants))]
+			frontier = append(frontier, cell{x, y})
+		}
+	}
+
+	for len(frontier) > 0 {
+		rng.Shuffle(len(frontier), func(i, j int) { frontier[i], frontier[j] = frontier[j], frontier[i] })
+		var next []cell
+		for _, c := range frontier {
+			for _, d := range directions {
+				nx, ny := c.x+d[0], c.y+d[1]
+				if nx >= 0 && nx < size && ny >= 0 && ny < size && rows[ny][nx] == 0 {
+					rows[ny][nx] = rows[c.y][c.x]
+					next = append(next, cell{nx, ny})
+				}
+			}
+		}
+		frontier = next
+	}
+	return joinLines(rows)
+}
+
+// warehouse generates day 15: a walled warehouse with boxes on a quarter of the floor and a robot
+// Floor cells the robot cannot reach are walled up, so every box can be pushed by some sequence of moves.
+// The robot then makes eight moves per cell of the warehouse, in lines of 1000 like the real input.
+func warehouse(rng *rand.Rand, size int) string {
+	size = max(size, 3)
+	rows := filledGrid(size, '#')
+	for y := 1; y < size-1; y++ {
+		for x := 1; x < size-1; x++ {
+			switch n := rng.IntN(20); {
+			case n == 0:
+				rows[y][x] = '#'
+			case n < 6:
+				rows[y][x] = 'O'
+			default:
+				rows[y][x] = '.'
+			}
+		}
+	}
+
+	robotX, robotY := between(rng, 1, size-2), between(rng, 1, size-2)
+	rows[robotY][robotX] = '@'
+	reached := reachable(rows, robotX, robotY)
+	for y, row := range rows {
+		for x := range row {
+			if !reached[y][x] {
+				row[x] = '#'
+			}
+		}
+	}
+
+	const moves = "^>v<"
+	const lineLength = 1000
+	var sb strings.Builder
+	for i := range size * size * 8 {
+		if i%lineLength == 0 {
+			sb.WriteByte('\n')
+		}
+		sb.WriteByte(moves[rng.IntN(len(moves))])
+	}
+	return joinLines(rows) + "\n" + sb.String()
+}
+
+// reachable marks the cells connected to (x, y) without crossing a wall
+func reachable(rows [][]byte, x, y int) [][]bool {
+	reached := make([][]bool, len(rows))
+	for i, row := range rows {
+		reached[i] = make([]bool, len(row))
+	}
+
+	reached[y][x] = true
+	stack := [][2]int{{x, y}}
+	for len(stack) > 0 {
+		c := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, d := range directions {
+			nx, ny := c[0]+d[0], c[1]+d[1]
+			if ny >= 0 && ny < len(rows) && nx >= 0 && nx < len(rows[ny]) && rows[ny][nx] != '#' && !reached[ny][nx] {
+				reached[ny][nx] = true
+				stack = append(stack, [2]int{nx, ny})
+			}
+		}
+	}
+	return reached
+}
+
+// reindeerMaze generates day 16: a walled maze with the start in the bottom left and the end in the top right
+// Corridors are carved through the cells at odd coordinates, see carveMaze, and then one wall in ten between two corridors is knocked down so that the maze has loops
+// and the best paths can branch, like in the real input. Even sizes are rounded up to the next odd one.
+func reindeerMaze(rng *rand.Rand, size int) string {
+	size = max(size, 5) | 1
+	rows := filledGrid(size, '#')
+
+	carveMaze(rng, rows, 1, size-2, 1)
+
+	// Walls between two corridors have odd coordinates in one direction and even ones in the other
+	for y := 1; y < size-1; y++ {
+		for x := 1 + y%2; x < size-1; x += 2 {
+			if rows[y][x] == '#' && rng.IntN(10) == 0 {
+				rows[y][x] = '.'
+			}
+		}
+	}
+
+	rows[size-2][1] = 'S'
+	rows[1][size-2] = 'E'
+	return joinLines(rows)
+}
+
+// carveMaze turns a grid of walls into a perfect maze by a randomized depth-first search from (x, y)
+// The search visits every cell an even number of steps from (x, y) that is at least margin cells from the edge
+// and knocks down the wall between each cell and the one it was reached from, so exactly one path joins
+// any two of those cells.
+func carveMaze(rng *rand.Rand, rows [][]byte, x, y, margin int) {
+	type cell struct{ x, y int }
+	height, width := len(rows), len(rows[0])
+	rows[y][x] = '.'
+	stack := []cell{{x, y}}
+	for len(stack) > 0 {
+		c := stack[len(stack)-1]
+		var unvisited []cell
+		for _, d := range directions {
+			nx, ny := c.x+2*d[0], c.y+2*d[1]
+			if nx >= margin && nx < width-margin && ny >= margin && ny < height-margin && rows[ny][nx] == '#' {
+				unvisited = append(unvisited, cell{nx, ny})
+			}
+		}
+		if len(unvisited) == 0 {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		next := unvisited[rng.IntN(len(unvisited))]
+		rows[(c.y+next.y)/2][(c.x+next.x)/2] = '.'
+		rows[next.y][next.x] = '.'
+		stack = append(stack, next)
+	}
+}
@@ -0,0 +1,80 @@
+package gen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day06"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day10"
+)
+
+func TestLabGuardLeaves(t *testing.T) {
+	for seed := range int64(20) {
+		input, _ := Generate(6, Options{Seed: seed, Size: 30})
+		if strings.Count(input, "^") != 1 {
+			t.Fatalf("seed %d: input has %d guards, want 1", seed, strings.Count(input, "^"))
+		}
+
+		// A guard that has not left after visiting every state of the grid is walking in a loop
+		patrol := day06.NewPatrol(day06.MustParse(input))
+		steps := 0
+		for patrol.Step() {
+			if steps++; steps > 4*30*30 {
+				t.Fatalf("seed %d: the guard never leaves the lab:\n%s", seed, input)
+			}
+		}
+	}
+}
+
+func TestTopographyHasTrails(t *testing.T) {
+	input, _ := Generate(10, Options{Seed: 1, Size: 40})
+	if got := day10.Part1(day10.MustParse(input)); got == 0 {
+		t.Errorf("Part1() = 0, want some trailheads:\n%s", input)
+	}
+}
+
+func TestWarehouseBoxesReachable(t *testing.T) {
+	for seed := range int64(20) {
+		input, _ := Generate(15, Options{Seed: seed, Size: 15})
+		grid, moves, _ := strings.Cut(input, "\n\n")
+		rows := strings.Split(grid, "\n")
+
+		if len(rows) != 15 || strings.Count(grid, "@") != 1 {
+			t.Fatalf("seed %d: want a 15 x 15 warehouse with one robot:\n%s", seed, grid)
+		}
+		if got := len(strings.ReplaceAll(moves, "\n", "")); got != 15*15*8 {
+			t.Errorf("seed %d: %d moves, want %d", seed, got, 15*15*8)
+		}
+		for y, row := range rows {
+			for x, cell := range row {
+				border := x == 0 || y == 0 || x == len(row)-1 || y == len(rows)-1
+				if border && cell != '#' {
+					t.Errorf("seed %d: cell (%d, %d) on the border is %q, want a wall", seed, x, y, cell)
+				}
+			}
+		}
+
+		// Walk the floor from the robot; every box has to be found
+		start := strings.Index(grid, "@")
+		width := len(rows[0]) + 1
+		seen := map[int]bool{start: true}
+		queue := []int{start}
+		boxes := 0
+		for len(queue) > 0 {
+			i := queue[0]
+			queue = queue[1:]
+			if grid[i] == 'O' {
+				boxes++
+			}
+			for _, next := range []int{i - 1, i + 1, i - width, i + width} {
+				if next >= 0 && next < len(grid) && grid[next] != '#' && grid[next] != '\n' && !seen[next] {
+					seen[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+		if boxes != strings.Count(grid, "O") {
+			t.Errorf("seed %d: robot reaches %d of %d boxes:\n%s", seed, boxes, strings.Count(grid, "O"), grid)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// lists generates day 1: two columns of five-digit location IDs
+// About a third of the right column repeats IDs of the left one, so the similarity score is not zero
+func lists(rng *rand.Rand, size int) string {
+	left := make([]int, size)
+	for i := range left {
+		left[i] = between(rng, 10000, 99999)
+	}
+
+	var sb strings.Builder
+	for i, id := range left {
+		right := between(rng, 10000, 99999)
+		if rng.IntN(3) == 0 {
+			right = left[rng.IntN(len(left))]
+		}
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		fmt.Fprintf(&sb, "%d   %d", id, right)
+	}
+	return sb.String()
+}
+
+// reports generates day 2: reports of five to eight levels
+// Most reports start out safe and some get one or two levels broken, so both parts have work to do
+func reports(rng *rand.Rand, size int) string {
+	lines := make([]string, size)
+	for i := range lines {
+		levels := make([]int, between(rng, 5, 8))
+		levels[0] = between(rng, 1, 60)
+		direction := 1
+		if rng.IntN(2) == 0 {
+			levels[0] = between(rng, 30, 90)
+			direction = -1
+		}
+		for j := 1; j < len(levels); j++ {
+			levels[j] = levels[j-1] + direction*between(rng, 1, 3)
+		}
+
+		for broken := rng.IntN(3); broken > 0; broken-- {
+			j := rng.IntN(len(levels))
+			levels[j] = max(levels[j]+between(rng, -5, 5), 1)
+		}
+
+		fields := make([]string, len(levels))
+		for j, level := range levels {
+			fields[j] = strconv.Itoa(level)
+		}
+		lines[i] = strings.Join(fields, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// printQueue generates day 5: ordering rules for every pair of 49 pages, then updates
+// The rules follow one hidden order, so every update has exactly one correct ordering.
+// Updates have an odd number of pages, and about half of them are already in order.
+func printQueue(rng *rand.Rand, size int) string {
+	pages := rng.Perm(90)[:49]
+	for i := range pages {
+		pages[i] += 10
+	}
+
+	var rules []string
+	for i := range pages {
+		for j := i + 1; j < len(pages); j++ {
+			rules = append(rules, fmt.Sprintf("%d|%d", pages[i], pages[j]))
+		}
+	}
+	rng.Shuffle(len(rules), func(i, j int) { rules[i], rules[j] = rules[j], rules[i] })
+
+	rank := make(map[int]int, len(pages))
+	for i, page := range pages {
+		rank[page] = i
+	}
+
+	updates := make([]string, size)
+	for i := range updates {
+		update := slices.Clone(pages)
+		rng.Shuffle(len(update), func(a, b int) { update[a], update[b] = update[b], update[a] })
+		update = update[:2*between(rng, 2, 11)+1]
+		if rng.IntN(2) == 0 {
+			slices.SortFunc(update, func(a, b int) int { return rank[a] - rank[b] })
+		}
+
+		fields := make([]string, len(update))
+		for j, page := range update {
+			fields[j] = strconv.Itoa(page)
+		}
+		updates[i] = strings.Join(fields, ",")
+	}
+
+	return strings.Join(rules, "\n") + "\n\n" + strings.Join(updates, "\n")
+}
+
+// equations generates day 7: calibration equations of two to twelve numbers
+// The test value is built from the numbers with random operators, then spoiled for about a third
+// of the equations. Running values stay far below the int range, even when concatenating.
+func equations(rng *rand.Rand, size int) string {
+	const limit = 1_000_000_000_000_000
+
+	lines := make([]string, size)
+	for i := range lines {
+		numbers := make([]int, between(rng, 2, 12))
+		for j := range numbers {
+			numbers[j] = between(rng, 1, 99)
+			if rng.IntN(8) == 0 {
+				numbers[j] = between(rng, 100, 999)
+			}
+		}
+
+		value := numbers[0]
+		for _, n := range numbers[1:] {
+			concatenated := value*pow10(n) + n
+			switch rng.IntN(3) {
+			case 0:
+				value += n
+			case 1:
+				if value*n < limit {
+					value *= n
+				} else {
+					value += n
+				}
+			case 2:
+				if concatenated < limit {
+					value = concatenated
+				} else {
+					value += n
+				}
+			}
+		}
+		if rng.IntN(3) == 0 {
+			value += between(rng, 1, 9)
+		}
+
+		fields := make([]string, len(numbers))
+		for j, n := range numbers {
+			fields[j] = strconv.Itoa(n)
+		}
+		lines[i] = fmt.Sprintf("%d: %s", value, strings.Join(fields, " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// pow10 returns the smallest power of ten above n, so value*pow10(n) + n concatenates the digits
+func pow10(n int) int {
+	p := 10
+	for p <= n {
+		p *= 10
+	}
+	return p
+}
+
+// stones generates day 11: engraved numbers of one to seven random digits
+// Leading zeros are dropped, so single digits including 0 are common
+func stones(rng *rand.Rand, size int) string {
+	fields := make([]string, size)
+	for i := range fields {
+		n := 0
+		for range between(rng, 1, 7) {
+			n = n*10 + rng.IntN(10)
+		}
+		fields[i] = strconv.Itoa(n)
+	}
+	return strings.Join(fields, " ")
+}
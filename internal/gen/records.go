@@ -0,0 +1,132 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day14"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day18"
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
+)
+
+// diskMap generates day 9: alternating file and free space lengths for the given number of files
+// Files take one to nine blocks and gaps zero to nine; the map ends with a file, like the real input.
+func diskMap(rng *rand.Rand, size int) string {
+	digits := make([]byte, 0, 2*size)
+	for i := range size {
+		if i > 0 {
+			digits = append(digits, byte('0'+rng.IntN(10)))
+		}
+		digits = append(digits, byte('0'+between(rng, 1, 9)))
+	}
+	return string(digits)
+}
+
+// clawMachines generates day 13: claw machines whose prize is reached with at most 100 presses of each button
+// The buttons are never parallel. About a quarter of the prizes are moved off the grid of reachable
+// points, which usually leaves those machines without a solution.
+func clawMachines(rng *rand.Rand, size int) string {
+	blocks := make([]string, size)
+	for i := range blocks {
+		var ax, ay, bx, by int
+		for ax*by == ay*bx {
+			ax, ay = between(rng, 10, 99), between(rng, 10, 99)
+			bx, by = between(rng, 10, 99), between(rng, 10, 99)
+		}
+
+		a, b := between(rng, 1, 100), between(rng, 1, 100)
+		px, py := a*ax+b*bx, a*ay+b*by
+		if rng.IntN(4) == 0 {
+			px += between(rng, 1, 9)
+		}
+
+		blocks[i] = fmt.Sprintf("Button A: X+%d, Y+%d\nButton B: X+%d, Y+%d\nPrize: X=%d, Y=%d",
+			ax, ay, bx, by, px, py)
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// robots generates day 14: robots anywhere in the bathroom with velocities of up to 99 tiles per second
+// When there are enough robots, some of them are aimed to draw a framed Christmas tree at a random second,
+// so part 2 has an answer; the rest wander at random.
+func robots(rng *rand.Rand, size int) string {
+	room := vector.Vec2{X: day14.Width, Y: day14.Height}
+	picture := christmasTree()
+	if len(picture) > size {
+		picture = nil
+	}
+	second := between(rng, 1, day14.Width*day14.Height-1)
+	offset := vector.Vec2{X: rng.IntN(day14.Width - 31), Y: rng.IntN(day14.Height - 33)}
+
+	lines := make([]string, size)
+	for i := range lines {
+		position := vector.Vec2{X: rng.IntN(day14.Width), Y: rng.IntN(day14.Height)}
+		velocity := vector.Vec2{X: between(rng, -99, 99), Y: between(rng, -99, 99)}
+		if i < len(picture) {
+			// Start wherever the robot has to be to reach its spot in the picture at that second
+			position = picture[i].Add(offset).Sub(velocity.Scale(second)).Mod(room)
+		}
+		lines[i] = fmt.Sprintf("p=%d,%d v=%d,%d", position.X, position.Y, velocity.X, velocity.Y)
+	}
+	rng.Shuffle(len(lines), func(i, j int) { lines[i], lines[j] = lines[j], lines[i] })
+	return strings.Join(lines, "\n")
+}
+
+// christmasTree returns the tiles of a tree inside a 31 x 33 frame, like the picture of the real input
+func christmasTree() []vector.Vec2 {
+	const width, height = 31, 33
+	var tiles []vector.Vec2
+	for x := range width {
+		tiles = append(tiles, vector.Vec2{X: x, Y: 0}, vector.Vec2{X: x, Y: height - 1})
+	}
+	for y := 1; y < height-1; y++ {
+		tiles = append(tiles, vector.Vec2{X: 0, Y: y}, vector.Vec2{X: width - 1, Y: y})
+	}
+
+	// Three tiers of branches, each wider than the one above, and a trunk
+	center := width / 2
+	y := 2
+	for tier := range 3 {
+		for row := range 5 + 2*tier {
+			for x := center - row - 2*tier; x <= center+row+2*tier; x++ {
+				tiles = append(tiles, vector.Vec2{X: x, Y: y})
+			}
+			y++
+		}
+	}
+	for ; y < height-2; y++ {
+		for x := center - 1; x <= center+1; x++ {
+			tiles = append(tiles, vector.Vec2{X: x, Y: y})
+		}
+	}
+	return tiles
+}
+
+// fallingBytes generates day 18: bytes falling onto the real memory space in the order that the real input has
+// A perfect maze is carved into the memory space, see carveMaze, and its walls fall first in a random order,
+// which leaves exactly one path from corner to corner. The cells of the corridors except the two corners follow
+// in a random order, so the first of them on that path cuts the exit off. Size is capped at the number of cells
+// the bytes can fall onto.
+func fallingBytes(rng *rand.Rand, size int) string {
+	rows := filledGrid(day18.Size, '#')
+	carveMaze(rng, rows, 0, 0, 0)
+
+	start, exit := vector.Vec2{}, vector.Vec2{X: day18.Size - 1, Y: day18.Size - 1}
+	var walls, corridors []vector.Vec2
+	for y, row := range rows {
+		for x, cell := range row {
+			switch pos := (vector.Vec2{X: x, Y: y}); {
+			case cell == '#':
+				walls = append(walls, pos)
+			case pos != start && pos != exit:
+				corridors = append(corridors, pos)
+			}
+		}
+	}
+	rng.Shuffle(len(walls), func(i, j int) { walls[i], walls[j] = walls[j], walls[i] })
+	rng.Shuffle(len(corridors), func(i, j int) { corridors[i], corridors[j] = corridors[j], corridors[i] })
+
+	bytes := append(walls, corridors...)
+	return day18.Format(bytes[:min(size, len(bytes))])
+}
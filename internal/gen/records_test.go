@@ -0,0 +1,72 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day13"
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day14"
+)
+
+func TestDiskMap(t *testing.T) {
+	input, _ := Generate(9, Options{Seed: 1, Size: 500})
+	if len(input) != 2*500-1 {
+		t.Fatalf("len(input) = %d, want %d", len(input), 2*500-1)
+	}
+	for i, ch := range input {
+		if ch < '0' || ch > '9' {
+			t.Fatalf("input[%d] = %q, want a digit", i, ch)
+		}
+		if i%2 == 0 && ch == '0' {
+			t.Errorf("file %d is empty", i/2)
+		}
+	}
+}
+
+func TestClawMachinesHaveSolutions(t *testing.T) {
+	input, _ := Generate(13, Options{Seed: 1, Size: 200})
+	machines := day13.MustParse(input)
+	if len(machines) != 200 {
+		t.Fatalf("MustParse() found %d machines, want 200", len(machines))
+	}
+
+	// About three quarters of the prizes are reachable within 100 presses
+	solved := 0
+	for _, machine := range machines {
+		if day13.SolveMachine(machine).Valid {
+			solved++
+		}
+	}
+	if solved < 120 || solved == len(machines) {
+		t.Errorf("%d of %d machines have a solution, want about three quarters", solved, len(machines))
+	}
+}
+
+func TestRobotsDrawTree(t *testing.T) {
+	if testing.Short() {
+		t.Skip("searching for the tree takes up to a few seconds")
+	}
+
+	tests := []struct {
+		name   string
+		size   int
+		isTree bool
+	}{
+		{"enough robots", 500, true},
+		{"too few robots for the picture", 100, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, _ := Generate(14, Options{Seed: 1, Size: tt.size})
+			robots := day14.MustParse(input)
+			if len(robots) != tt.size {
+				t.Fatalf("MustParse() found %d robots, want %d", len(robots), tt.size)
+			}
+
+			second := day14.Part2(robots, day14.Width, day14.Height)
+			if (second > 0) != tt.isTree {
+				t.Errorf("Part2() = %d, want a tree: %v", second, tt.isTree)
+			}
+		})
+	}
+}
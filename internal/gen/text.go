@@ -0,0 +1,109 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/days/day19"
+)
+
+// memory generates day 3: corrupted memory with mul, do and don't instructions between junk
+// The junk includes near misses such as "mul(4*" and "mul ( 2 , 4 )" that must not be picked up.
+// The text is split into lines of about a hundred instructions, like the real input.
+func memory(rng *rand.Rand, size int) string {
+	junk := []string{
+		"(", ")", "[", "]", "{", "}", "<", ">", ",", ";", ":", "'", "+", "-", "*", "/", "%", "&", "$", "#", "@",
+		"!", "?", "~", "^", " ", "what()", "from()", "how()", "who()", "why()", "when()", "select()", "where()",
+	}
+	nearMisses := []string{"mul(4*", "mul(6,9!", "?(12,34)", "mul ( 2 , 4 )", "mul[3,7]", "mul(1234,5)", "do_not_", "don't", "do("}
+
+	var sb strings.Builder
+	for i := 0; i < size; i++ {
+		if i > 0 && i%100 == 0 {
+			sb.WriteByte('\n')
+		}
+		for range rng.IntN(6) {
+			sb.WriteString(junk[rng.IntN(len(junk))])
+		}
+		if rng.IntN(5) == 0 {
+			sb.WriteString(nearMisses[rng.IntN(len(nearMisses))])
+		}
+
+		switch n := rng.IntN(10); {
+		case n == 0:
+			sb.WriteString("do()")
+		case n == 1:
+			sb.WriteString("don't()")
+		default:
+			fmt.Fprintf(&sb, "mul(%d,%d)", between(rng, 1, 999), between(rng, 1, 999))
+		}
+	}
+	return sb.String()
+}
+
+// wordSearch generates day 4: a square of the letters X, M, A and S
+// Extra XMAS words in all eight directions, about one for every fifty cells, make matches plentiful
+func wordSearch(rng *rand.Rand, size int) string {
+	const letters = "XMAS"
+	rows := filledGrid(size, '.')
+	for _, row := range rows {
+		for x := range row {
+			row[x] = letters[rng.IntN(len(letters))]
+		}
+	}
+
+	for range size * size / 50 {
+		dx, dy := between(rng, -1, 1), between(rng, -1, 1)
+		if dx == 0 && dy == 0 {
+			continue
+		}
+		x, y := rng.IntN(size), rng.IntN(size)
+		endX, endY := x+3*dx, y+3*dy
+		if endX < 0 || endX >= size || endY < 0 || endY >= size {
+			continue
+		}
+		for i := range len(letters) {
+			rows[y+i*dy][x+i*dx] = letters[i]
+		}
+	}
+	return joinLines(rows)
+}
+
+// towels generates day 19: 450 towel patterns of 1 to 8 stripes and designs of 40 to 60 stripes
+// One color has no single-stripe pattern and ends no pattern, like the real input. The designs are put
+// together from the patterns, but one in four ends with that color, which makes it impossible.
+func towels(rng *rand.Rand, size int) string {
+	rare := day19.Colors[rng.IntN(len(day19.Colors))]
+	stripes := func(n int) string {
+		s := make([]byte, n)
+		for i := range s {
+			s[i] = day19.Colors[rng.IntN(len(day19.Colors))]
+		}
+		return string(s)
+	}
+
+	seen := map[string]bool{}
+	var patterns []string
+	for len(patterns) < 450 {
+		pattern := stripes(between(rng, 1, 8))
+		if !seen[pattern] && pattern[len(pattern)-1] != rare {
+			seen[pattern] = true
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	designs := make([]string, size)
+	for i := range designs {
+		length := between(rng, 40, 60)
+		var sb strings.Builder
+		for sb.Len() < length {
+			sb.WriteString(patterns[rng.IntN(len(patterns))])
+		}
+		if rng.IntN(4) == 0 {
+			sb.WriteByte(rare)
+		}
+		designs[i] = sb.String()
+	}
+	return day19.Onsen{Patterns: patterns, Designs: designs}.String()
+}
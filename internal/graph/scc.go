@@ -0,0 +1,58 @@
+package graph
+
+import "github.com/amoilanen/advent-of-code-2024/internal/utils"
+
+// StronglyConnectedComponents partitions the nodes into strongly connected components
+// using Tarjan's algorithm
+// Components are returned in reverse topological order: no component has an edge
+// to a component that appears after it
+// Nodes reachable through successors but missing from nodes are visited as well
+// Time complexity: O(V + E)
+// Space complexity: O(V)
+func StronglyConnectedComponents[N comparable](nodes []N, successors func(N) []N) [][]N {
+	index := make(map[N]int)
+	lowLink := make(map[N]int)
+	onStack := make(map[N]bool)
+	var stack []N
+	var components [][]N
+
+	var strongConnect func(node N)
+	strongConnect = func(node N) {
+		index[node] = len(index)
+		lowLink[node] = index[node]
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, next := range successors(node) {
+			if _, visited := index[next]; !visited {
+				strongConnect(next)
+				lowLink[node] = utils.Min(lowLink[node], lowLink[next])
+			} else if onStack[next] {
+				lowLink[node] = utils.Min(lowLink[node], index[next])
+			}
+		}
+
+		// node is the root of a component, pop it off the stack
+		if lowLink[node] == index[node] {
+			var component []N
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				component = append(component, top)
+				if top == node {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	for _, node := range nodes {
+		if _, visited := index[node]; !visited {
+			strongConnect(node)
+		}
+	}
+
+	return components
+}
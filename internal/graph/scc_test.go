@@ -0,0 +1,38 @@
+package graph
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	// Components: {1, 2, 3}, {4, 5}, {6}
+	edges := map[int][]int{
+		1: {2},
+		2: {3},
+		3: {1, 4},
+		4: {5},
+		5: {4, 6},
+	}
+	components := StronglyConnectedComponents([]int{1, 2, 3, 4, 5, 6}, func(n int) []int { return edges[n] })
+
+	for _, component := range components {
+		sort.Ints(component)
+	}
+	want := [][]int{{6}, {4, 5}, {1, 2, 3}}
+	if !reflect.DeepEqual(components, want) {
+		t.Errorf("StronglyConnectedComponents() = %v, want %v", components, want)
+	}
+}
+
+func TestStronglyConnectedComponentsAcyclic(t *testing.T) {
+	edges := map[string][]string{"a": {"b", "c"}, "b": {"c"}}
+	components := StronglyConnectedComponents([]string{"a"}, func(n string) []string { return edges[n] })
+
+	// Every node is its own component and successors are reached even if not listed
+	want := [][]string{{"c"}, {"b"}, {"a"}}
+	if !reflect.DeepEqual(components, want) {
+		t.Errorf("StronglyConnectedComponents() = %v, want %v", components, want)
+	}
+}
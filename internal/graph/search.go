@@ -0,0 +1,137 @@
+package graph
+
+// Traversal holds the outcome of an unweighted graph traversal
+// Order lists nodes in the order they were visited, Depth maps every reached
+// node to its depth in the traversal tree (the edge count from start for BFS)
+type Traversal[N comparable] struct {
+	Start  N
+	Order  []N
+	Depth  map[N]int
+	parent map[N]N
+}
+
+func newTraversal[N comparable](start N) *Traversal[N] {
+	return &Traversal[N]{
+		Start:  start,
+		Depth:  map[N]int{start: 0},
+		parent: make(map[N]N),
+	}
+}
+
+// Reached reports whether the node was reached from the start node
+func (t *Traversal[N]) Reached(node N) bool {
+	_, ok := t.Depth[node]
+	return ok
+}
+
+// PathTo reconstructs the path from the start node to target (both inclusive)
+// Returns nil if target was not reached
+func (t *Traversal[N]) PathTo(target N) []N {
+	if !t.Reached(target) {
+		return nil
+	}
+	return reconstructPath(t.parent, t.Start, target)
+}
+
+// reconstructPath walks the parent links back from target to start
+func reconstructPath[N comparable](parent map[N]N, start, target N) []N {
+	path := []N{target}
+	for current := target; current != start; {
+		current = parent[current]
+		path = append(path, current)
+	}
+
+	// Reverse so the path runs from start to target
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// BFS performs a breadth-first traversal from start
+// neighbors returns the nodes adjacent to a node; it is called once per reached node
+// Time complexity: O(V + E)
+// Space complexity: O(V)
+func BFS[N comparable](start N, neighbors func(N) []N) *Traversal[N] {
+	t := newTraversal(start)
+	bfs(t, neighbors, nil)
+	return t
+}
+
+// BFSTo performs a breadth-first search from start and stops at the first node
+// for which goal returns true
+// Returns the shortest path (by edge count) to that node and whether one was found
+func BFSTo[N comparable](start N, goal func(N) bool, neighbors func(N) []N) ([]N, bool) {
+	t := newTraversal(start)
+	target, found := bfs(t, neighbors, goal)
+	if !found {
+		return nil, false
+	}
+	return t.PathTo(target), true
+}
+
+// bfs runs the traversal loop, stopping early when goal is non-nil and matches
+func bfs[N comparable](t *Traversal[N], neighbors func(N) []N, goal func(N) bool) (N, bool) {
+	queue := []N{t.Start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		t.Order = append(t.Order, current)
+
+		if goal != nil && goal(current) {
+			return current, true
+		}
+
+		for _, next := range neighbors(current) {
+			if _, seen := t.Depth[next]; seen {
+				continue
+			}
+			t.Depth[next] = t.Depth[current] + 1
+			t.parent[next] = current
+			queue = append(queue, next)
+		}
+	}
+
+	var zero N
+	return zero, false
+}
+
+// DFS performs an iterative depth-first traversal from start
+// Neighbors are explored in the order they are returned, so the visit order
+// matches the natural recursive formulation
+// Time complexity: O(V + E)
+// Space complexity: O(V)
+func DFS[N comparable](start N, neighbors func(N) []N) *Traversal[N] {
+	t := newTraversal(start)
+
+	// Each frame remembers the node and its not-yet-explored neighbors
+	type frame struct {
+		node    N
+		pending []N
+	}
+
+	t.Order = append(t.Order, start)
+	stack := []frame{{node: start, pending: neighbors(start)}}
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if len(top.pending) == 0 {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		next := top.pending[0]
+		top.pending = top.pending[1:]
+		if _, seen := t.Depth[next]; seen {
+			continue
+		}
+
+		t.Depth[next] = t.Depth[top.node] + 1
+		t.parent[next] = top.node
+		t.Order = append(t.Order, next)
+		stack = append(stack, frame{node: next, pending: neighbors(next)})
+	}
+
+	return t
+}
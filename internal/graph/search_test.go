@@ -0,0 +1,116 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+// cell is a grid coordinate used by the tests
+type cell struct {
+	Row, Col int
+}
+
+// gridNeighbors returns 4-directional neighbors on a map where '#' blocks movement
+func gridNeighbors(lines []string) func(cell) []cell {
+	return func(c cell) []cell {
+		var result []cell
+		for _, d := range []cell{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+			next := cell{c.Row + d.Row, c.Col + d.Col}
+			if next.Row < 0 || next.Row >= len(lines) || next.Col < 0 || next.Col >= len(lines[0]) {
+				continue
+			}
+			if lines[next.Row][next.Col] != '#' {
+				result = append(result, next)
+			}
+		}
+		return result
+	}
+}
+
+var maze = []string{
+	"..#....",
+	".##.##.",
+	"....#..",
+	"#.#...#",
+	"..#.#..",
+}
+
+func TestBFS(t *testing.T) {
+	traversal := BFS(cell{0, 0}, gridNeighbors(maze))
+
+	tests := []struct {
+		name      string
+		target    cell
+		wantDepth int
+		reached   bool
+	}{
+		{"start", cell{0, 0}, 0, true},
+		{"straight down", cell{2, 0}, 2, true},
+		{"around the walls", cell{0, 6}, 10, true},
+		{"far corner", cell{4, 6}, 10, true},
+		{"wall", cell{0, 2}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := traversal.Reached(tt.target); got != tt.reached {
+				t.Fatalf("Reached(%v) = %v, want %v", tt.target, got, tt.reached)
+			}
+			if !tt.reached {
+				if path := traversal.PathTo(tt.target); path != nil {
+					t.Errorf("PathTo(%v) = %v, want nil", tt.target, path)
+				}
+				return
+			}
+			if got := traversal.Depth[tt.target]; got != tt.wantDepth {
+				t.Errorf("Depth[%v] = %d, want %d", tt.target, got, tt.wantDepth)
+			}
+			path := traversal.PathTo(tt.target)
+			if len(path) != tt.wantDepth+1 || path[0] != (cell{0, 0}) || path[len(path)-1] != tt.target {
+				t.Errorf("PathTo(%v) = %v, want %d steps from start to target", tt.target, path, tt.wantDepth)
+			}
+		})
+	}
+}
+
+func TestBFSVisitsInDepthOrder(t *testing.T) {
+	traversal := BFS(cell{0, 0}, gridNeighbors(maze))
+	for i := 1; i < len(traversal.Order); i++ {
+		if traversal.Depth[traversal.Order[i]] < traversal.Depth[traversal.Order[i-1]] {
+			t.Fatalf("Order is not sorted by depth at index %d: %v", i, traversal.Order)
+		}
+	}
+}
+
+func TestBFSTo(t *testing.T) {
+	path, found := BFSTo(cell{0, 0}, func(c cell) bool { return c == cell{4, 0} }, gridNeighbors(maze))
+	want := []cell{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {3, 1}, {4, 1}, {4, 0}}
+	if !found || !reflect.DeepEqual(path, want) {
+		t.Errorf("BFSTo() = %v, %v; want %v, true", path, found, want)
+	}
+
+	_, found = BFSTo(cell{0, 0}, func(c cell) bool { return c == cell{0, 2} }, gridNeighbors(maze))
+	if found {
+		t.Error("BFSTo() found a path to a wall")
+	}
+}
+
+func TestDFS(t *testing.T) {
+	// A small tree: 1 -> 2, 3; 2 -> 4; 3 -> 4, 5
+	edges := map[int][]int{1: {2, 3}, 2: {4}, 3: {4, 5}}
+	traversal := DFS(1, func(n int) []int { return edges[n] })
+
+	wantOrder := []int{1, 2, 4, 3, 5}
+	if !reflect.DeepEqual(traversal.Order, wantOrder) {
+		t.Errorf("DFS().Order = %v, want %v", traversal.Order, wantOrder)
+	}
+
+	wantPath := []int{1, 2, 4}
+	if got := traversal.PathTo(4); !reflect.DeepEqual(got, wantPath) {
+		t.Errorf("PathTo(4) = %v, want %v", got, wantPath)
+	}
+
+	if traversal.Reached(6) {
+		t.Error("Reached(6) = true for a node outside the graph")
+	}
+}
@@ -0,0 +1,158 @@
+package graph
+
+import (
+	"github.com/amoilanen/advent-of-code-2024/internal/collections"
+	"github.com/amoilanen/advent-of-code-2024/internal/pqueue"
+)
+
+// Edge is a weighted connection to a neighboring node
+type Edge[N comparable] struct {
+	To   N
+	Cost int
+}
+
+// Distances holds the outcome of a single-source weighted shortest-path search
+// Dist maps every reached node to the cost of the cheapest path from Start
+type Distances[N comparable] struct {
+	Start   N
+	Dist    map[N]int
+	parents map[N][]N
+}
+
+// Reached reports whether the node was reached from the start node
+func (d *Distances[N]) Reached(node N) bool {
+	_, ok := d.Dist[node]
+	return ok
+}
+
+// Predecessors returns every node that precedes node on some cheapest path
+func (d *Distances[N]) Predecessors(node N) []N {
+	return d.parents[node]
+}
+
+// PathTo reconstructs one cheapest path from the start node to target (both inclusive)
+// Returns nil if target was not reached
+func (d *Distances[N]) PathTo(target N) []N {
+	if !d.Reached(target) {
+		return nil
+	}
+
+	parent := make(map[N]N)
+	for current := target; current != d.Start; {
+		previous := d.parents[current][0]
+		parent[current] = previous
+		current = previous
+	}
+	return reconstructPath(parent, d.Start, target)
+}
+
+// OnShortestPaths returns every node lying on at least one cheapest path
+// from the start node to any of the targets
+// Unreached targets are ignored
+func (d *Distances[N]) OnShortestPaths(targets ...N) collections.Set[N] {
+	onPath := collections.NewSet[N]()
+	stack := make([]N, 0, len(targets))
+	for _, target := range targets {
+		if d.Reached(target) && !onPath.Contains(target) {
+			onPath.Add(target)
+			stack = append(stack, target)
+		}
+	}
+
+	// Walk every predecessor link backwards
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, previous := range d.parents[current] {
+			if !onPath.Contains(previous) {
+				onPath.Add(previous)
+				stack = append(stack, previous)
+			}
+		}
+	}
+
+	return onPath
+}
+
+// Dijkstra computes the cheapest path cost from start to every reachable node
+// Edge costs must be non-negative
+// Time complexity: O((V + E) log V)
+// Space complexity: O(V)
+func Dijkstra[N comparable](start N, neighbors func(N) []Edge[N]) *Distances[N] {
+	d := &Distances[N]{
+		Start:   start,
+		Dist:    map[N]int{start: 0},
+		parents: make(map[N][]N),
+	}
+
+	queue := pqueue.New[N]()
+	queued := map[N]*pqueue.Item[N]{start: queue.Push(start, 0)}
+
+	for queue.Len() > 0 {
+		current, cost := queue.Pop()
+
+		for _, edge := range neighbors(current) {
+			nextCost := cost + edge.Cost
+			best, seen := d.Dist[edge.To]
+			switch {
+			case !seen:
+				d.Dist[edge.To] = nextCost
+				d.parents[edge.To] = []N{current}
+				queued[edge.To] = queue.Push(edge.To, nextCost)
+			case nextCost < best:
+				d.Dist[edge.To] = nextCost
+				d.parents[edge.To] = []N{current}
+				queue.Update(queued[edge.To], nextCost)
+			case nextCost == best:
+				// Equally cheap alternative, remember it for path enumeration
+				d.parents[edge.To] = append(d.parents[edge.To], current)
+			}
+		}
+	}
+
+	return d
+}
+
+// ShortestPath finds the cheapest path from start to the first node for which goal returns true
+// Returns the path, its cost and whether the goal was reached
+func ShortestPath[N comparable](start N, goal func(N) bool, neighbors func(N) []Edge[N]) ([]N, int, bool) {
+	return AStar(start, goal, neighbors, func(N) int { return 0 })
+}
+
+// AStar finds the cheapest path from start to the first node for which goal returns true,
+// expanding nodes in order of cost so far plus heuristic estimate
+// The heuristic must be consistent (never decrease by more than an edge's cost along that edge),
+// otherwise the path may not be optimal
+// Returns the path, its cost and whether the goal was reached
+func AStar[N comparable](start N, goal func(N) bool, neighbors func(N) []Edge[N], heuristic func(N) int) ([]N, int, bool) {
+	dist := map[N]int{start: 0}
+	parent := make(map[N]N)
+
+	queue := pqueue.New[N]()
+	queued := map[N]*pqueue.Item[N]{start: queue.Push(start, heuristic(start))}
+
+	for queue.Len() > 0 {
+		current, _ := queue.Pop()
+		if goal(current) {
+			return reconstructPath(parent, start, current), dist[current], true
+		}
+
+		for _, edge := range neighbors(current) {
+			cost := dist[current] + edge.Cost
+			if best, seen := dist[edge.To]; seen && cost >= best {
+				continue
+			}
+			dist[edge.To] = cost
+			parent[edge.To] = current
+
+			priority := cost + heuristic(edge.To)
+			if item, ok := queued[edge.To]; ok && item.Queued() {
+				queue.Update(item, priority)
+			} else {
+				queued[edge.To] = queue.Push(edge.To, priority)
+			}
+		}
+	}
+
+	return nil, 0, false
+}
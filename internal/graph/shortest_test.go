@@ -0,0 +1,107 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/collections"
+)
+
+// weightedGraph is a small directed graph used by the shortest-path tests
+//
+//	A --1--> B --2--> D
+//	A --4--> C --1--> D
+//	B --5--> C        D --3--> E
+var weightedGraph = map[string][]Edge[string]{
+	"A": {{To: "B", Cost: 1}, {To: "C", Cost: 4}},
+	"B": {{To: "C", Cost: 5}, {To: "D", Cost: 2}},
+	"C": {{To: "D", Cost: 1}},
+	"D": {{To: "E", Cost: 3}},
+}
+
+func weightedNeighbors(n string) []Edge[string] {
+	return weightedGraph[n]
+}
+
+func TestDijkstra(t *testing.T) {
+	distances := Dijkstra("A", weightedNeighbors)
+
+	want := map[string]int{"A": 0, "B": 1, "C": 4, "D": 3, "E": 6}
+	if !reflect.DeepEqual(distances.Dist, want) {
+		t.Errorf("Dijkstra().Dist = %v, want %v", distances.Dist, want)
+	}
+
+	wantPath := []string{"A", "B", "D", "E"}
+	if got := distances.PathTo("E"); !reflect.DeepEqual(got, wantPath) {
+		t.Errorf("PathTo(E) = %v, want %v", got, wantPath)
+	}
+
+	if got := distances.PathTo("Z"); got != nil {
+		t.Errorf("PathTo(Z) = %v, want nil", got)
+	}
+}
+
+func TestDijkstraOnShortestPaths(t *testing.T) {
+	// Two equally cheap routes from S to T: S-L-T and S-R-T; S-X-T is more expensive
+	edges := map[string][]Edge[string]{
+		"S": {{To: "L", Cost: 1}, {To: "R", Cost: 2}, {To: "X", Cost: 1}},
+		"L": {{To: "T", Cost: 2}},
+		"R": {{To: "T", Cost: 1}},
+		"X": {{To: "T", Cost: 5}},
+	}
+	distances := Dijkstra("S", func(n string) []Edge[string] { return edges[n] })
+
+	got := distances.OnShortestPaths("T")
+	want := collections.NewSet("S", "L", "R", "T")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("OnShortestPaths(T) = %v, want %v", got, want)
+	}
+
+	if preds := distances.Predecessors("T"); len(preds) != 2 {
+		t.Errorf("Predecessors(T) = %v, want two nodes", preds)
+	}
+}
+
+func TestShortestPath(t *testing.T) {
+	path, cost, found := ShortestPath("A", func(n string) bool { return n == "D" }, weightedNeighbors)
+	if !found || cost != 3 || !reflect.DeepEqual(path, []string{"A", "B", "D"}) {
+		t.Errorf("ShortestPath(A, D) = %v, %d, %v; want [A B D], 3, true", path, cost, found)
+	}
+
+	_, _, found = ShortestPath("E", func(n string) bool { return n == "A" }, weightedNeighbors)
+	if found {
+		t.Error("ShortestPath(E, A) found a path against edge direction")
+	}
+}
+
+func TestAStar(t *testing.T) {
+	target := cell{4, 6}
+	neighbors := func(c cell) []Edge[cell] {
+		var edges []Edge[cell]
+		for _, next := range gridNeighbors(maze)(c) {
+			edges = append(edges, Edge[cell]{To: next, Cost: 1})
+		}
+		return edges
+	}
+	manhattan := func(c cell) int {
+		dr, dc := target.Row-c.Row, target.Col-c.Col
+		if dr < 0 {
+			dr = -dr
+		}
+		if dc < 0 {
+			dc = -dc
+		}
+		return dr + dc
+	}
+
+	path, cost, found := AStar(cell{0, 0}, func(c cell) bool { return c == target }, neighbors, manhattan)
+	if !found || cost != 10 || len(path) != 11 {
+		t.Errorf("AStar() = %v, %d, %v; want an 11-node path of cost 10", path, cost, found)
+	}
+
+	// The heuristic must not change the optimal cost
+	_, plainCost, _ := ShortestPath(cell{0, 0}, func(c cell) bool { return c == target }, neighbors)
+	if plainCost != cost {
+		t.Errorf("ShortestPath() cost = %d, AStar() cost = %d", plainCost, cost)
+	}
+}
@@ -0,0 +1,70 @@
+package graph
+
+import "fmt"
+
+// CycleError is returned by TopologicalSort when the graph contains a cycle
+// Remaining lists the nodes that could not be ordered; every cycle lies within them
+type CycleError[N comparable] struct {
+	Remaining []N
+}
+
+func (e *CycleError[N]) Error() string {
+	return fmt.Sprintf("graph contains a cycle among %d nodes: %v", len(e.Remaining), e.Remaining)
+}
+
+// TopologicalSort orders nodes so that every node comes before its successors (Kahn's algorithm)
+// Only edges between the given nodes are considered; successors outside the set are ignored
+// Ties are broken by the order of nodes, which makes the result deterministic
+// Returns a *CycleError if no such order exists
+// Time complexity: O(V + E)
+// Space complexity: O(V)
+func TopologicalSort[N comparable](nodes []N, successors func(N) []N) ([]N, error) {
+	inDegree := make(map[N]int, len(nodes))
+	for _, node := range nodes {
+		inDegree[node] = 0
+	}
+	for _, node := range nodes {
+		for _, next := range successors(node) {
+			if _, inGraph := inDegree[next]; inGraph {
+				inDegree[next]++
+			}
+		}
+	}
+
+	// Start with every node that has no incoming edges
+	queue := make([]N, 0, len(nodes))
+	for _, node := range nodes {
+		if inDegree[node] == 0 {
+			queue = append(queue, node)
+		}
+	}
+
+	order := make([]N, 0, len(nodes))
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		order = append(order, current)
+
+		for _, next := range successors(current) {
+			if _, inGraph := inDegree[next]; !inGraph {
+				continue
+			}
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	if len(order) < len(nodes) {
+		remaining := make([]N, 0, len(nodes)-len(order))
+		for _, node := range nodes {
+			if inDegree[node] > 0 {
+				remaining = append(remaining, node)
+			}
+		}
+		return order, &CycleError[N]{Remaining: remaining}
+	}
+
+	return order, nil
+}
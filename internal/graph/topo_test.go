@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	edges := map[int][]int{5: {11}, 7: {11, 8}, 3: {8, 10}, 11: {2, 9, 10}, 8: {9}}
+	successors := func(n int) []int { return edges[n] }
+
+	tests := []struct {
+		name  string
+		nodes []int
+		want  []int
+	}{
+		{
+			name:  "full graph",
+			nodes: []int{5, 7, 3, 11, 8, 2, 9, 10},
+			want:  []int{5, 7, 3, 11, 8, 2, 10, 9},
+		},
+		{
+			name:  "induced subgraph ignores outside nodes",
+			nodes: []int{9, 8, 3},
+			want:  []int{3, 8, 9},
+		},
+		{
+			name:  "no edges keeps input order",
+			nodes: []int{2, 9, 10},
+			want:  []int{2, 9, 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TopologicalSort(tt.nodes, successors)
+			if err != nil {
+				t.Fatalf("TopologicalSort() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopologicalSort() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	// 1 -> 2 -> 3 -> 2, 3 -> 4
+	edges := map[int][]int{1: {2}, 2: {3}, 3: {2, 4}}
+	_, err := TopologicalSort([]int{1, 2, 3, 4}, func(n int) []int { return edges[n] })
+
+	var cycleErr *CycleError[int]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("TopologicalSort() error = %v, want *CycleError", err)
+	}
+	if want := []int{2, 3, 4}; !reflect.DeepEqual(cycleErr.Remaining, want) {
+		t.Errorf("CycleError.Remaining = %v, want %v", cycleErr.Remaining, want)
+	}
+}
@@ -0,0 +1,127 @@
+package heapstat
+
+import (
+	"io"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// Peak tracks the largest heap seen between Start and Report
+// Reading the heap size stops the world, so Sample only looks at it on every Every-th call;
+// call Sample from the hot loop of the code under test and Force at points known to be expensive.
+type Peak struct {
+	Every int // Sample reads the heap size on every Every-th call; 1 if zero
+
+	base  uint64
+	max   uint64
+	calls int
+}
+
+// Start collects garbage and records the current heap size as the baseline
+func Start(every int) *Peak {
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return &Peak{Every: every, base: stats.HeapAlloc, max: stats.HeapAlloc}
+}
+
+// Sample updates the peak on every Every-th call
+func (p *Peak) Sample() {
+	p.calls++
+	if p.calls >= max(p.Every, 1) {
+		p.Force()
+	}
+}
+
+// Force updates the peak with the current heap size
+func (p *Peak) Force() {
+	p.calls = 0
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	p.max = max(p.max, stats.HeapAlloc)
+}
+
+// Bytes returns how far the heap grew above the baseline at its peak
+func (p *Peak) Bytes() uint64 {
+	return p.max - p.base
+}
+
+// Report samples the heap once more and adds the peak as the peak-heap-B metric of a benchmark
+func (p *Peak) Report(b *testing.B) {
+	p.Force()
+	b.ReportMetric(float64(p.Bytes()), "peak-heap-B")
+}
+
+// Watch runs f while another goroutine samples the heap every interval and returns the peak
+// Unlike Sample this needs no hooks in f, at the price of missing peaks shorter than interval.
+func Watch(f func(), interval time.Duration) *Peak {
+	peak := Start(1)
+	done, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				peak.Force()
+			}
+		}
+	}()
+
+	f()
+	close(done)
+	<-stopped
+	peak.Force()
+	return peak
+}
+
+// Reader wraps r so that every Read samples the heap
+// Code that consumes a reader is then measured without changing it.
+func (p *Peak) Reader(r io.Reader) io.Reader {
+	return &sampler{r: r, peak: p}
+}
+
+type sampler struct {
+	r    io.Reader
+	peak *Peak
+}
+
+func (s *sampler) Read(p []byte) (int, error) {
+	s.peak.Sample()
+	return s.r.Read(p)
+}
+
+// Repeat returns a reader producing block n times without ever holding the whole text in memory
+func Repeat(block string, n int) io.Reader {
+	return &repeater{block: block, left: n}
+}
+
+type repeater struct {
+	block  string
+	left   int // Copies of block still to be read, including the current one
+	offset int // Bytes of the current copy already read
+}
+
+func (r *repeater) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	n := 0
+	for n < len(p) && r.left > 0 && len(r.block) > 0 {
+		copied := copy(p[n:], r.block[r.offset:])
+		n += copied
+		r.offset += copied
+		if r.offset == len(r.block) {
+			r.offset = 0
+			r.left--
+		}
+	}
+	if n == 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
@@ -0,0 +1,82 @@
+package heapstat
+
+import (
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+func TestRepeat(t *testing.T) {
+	tests := []struct {
+		name  string
+		block string
+		n     int
+	}{
+		{"several copies", "ab\n", 5},
+		{"single copy", "hello", 1},
+		{"no copies", "hello", 0},
+		{"empty block", "", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := strings.Repeat(tt.block, tt.n)
+			if err := iotest.TestReader(Repeat(tt.block, tt.n), []byte(want)); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRepeatSmallReads(t *testing.T) {
+	got, err := io.ReadAll(iotest.OneByteReader(Repeat("xyz", 4)))
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(got) != "xyzxyzxyzxyz" {
+		t.Errorf("ReadAll() = %q, want %q", got, "xyzxyzxyzxyz")
+	}
+}
+
+var sink []byte
+
+func TestPeak(t *testing.T) {
+	peak := Start(1)
+	sink = make([]byte, 8<<20)
+	peak.Sample()
+	sink = nil
+
+	if peak.Bytes() < 8<<20 {
+		t.Errorf("Bytes() = %d, want at least %d", peak.Bytes(), 8<<20)
+	}
+}
+
+func TestPeakReader(t *testing.T) {
+	peak := Start(1)
+	data, err := io.ReadAll(peak.Reader(Repeat("0123456789", 1<<20)))
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+
+	// ReadAll keeps all 10 MiB and samples the heap before each read
+	if peak.Bytes() < uint64(len(data))/2 {
+		t.Errorf("Bytes() = %d, want at least %d", peak.Bytes(), len(data)/2)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	peak := Watch(func() {
+		sink = make([]byte, 8<<20)
+		time.Sleep(20 * time.Millisecond)
+		// Collected before Watch returns, so only the sampling goroutine sees the peak
+		sink = nil
+		runtime.GC()
+	}, time.Millisecond)
+
+	if peak.Bytes() < 8<<20 {
+		t.Errorf("Bytes() = %d, want at least %d", peak.Bytes(), 8<<20)
+	}
+}
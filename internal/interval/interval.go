@@ -0,0 +1,48 @@
+package interval
+
+import "fmt"
+
+// Interval is the half-open integer range [Start, End)
+// An interval with End <= Start is empty
+type Interval struct {
+	Start int
+	End   int
+}
+
+// New creates the interval [start, start+length)
+func New(start, length int) Interval {
+	return Interval{Start: start, End: start + length}
+}
+
+// Len returns the number of integers covered by the interval
+func (iv Interval) Len() int {
+	if iv.End <= iv.Start {
+		return 0
+	}
+	return iv.End - iv.Start
+}
+
+// Empty reports whether the interval covers no integers
+func (iv Interval) Empty() bool {
+	return iv.End <= iv.Start
+}
+
+// Contains reports whether x lies inside the interval
+func (iv Interval) Contains(x int) bool {
+	return iv.Start <= x && x < iv.End
+}
+
+// Overlaps reports whether the two intervals share at least one integer
+func (iv Interval) Overlaps(other Interval) bool {
+	return !iv.Empty() && !other.Empty() && iv.Start < other.End && other.Start < iv.End
+}
+
+// Intersect returns the common part of the two intervals, which may be empty
+func (iv Interval) Intersect(other Interval) Interval {
+	return Interval{Start: max(iv.Start, other.Start), End: min(iv.End, other.End)}
+}
+
+// String formats the interval as [Start, End)
+func (iv Interval) String() string {
+	return fmt.Sprintf("[%d, %d)", iv.Start, iv.End)
+}
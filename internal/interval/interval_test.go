@@ -0,0 +1,52 @@
+package interval
+
+import "testing"
+
+func TestInterval(t *testing.T) {
+	iv := New(2, 3)
+
+	if iv != (Interval{Start: 2, End: 5}) {
+		t.Errorf("New(2, 3) = %v, want [2, 5)", iv)
+	}
+	if iv.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", iv.Len())
+	}
+	if !iv.Contains(2) || !iv.Contains(4) || iv.Contains(5) || iv.Contains(1) {
+		t.Errorf("Contains() gave wrong membership for %v", iv)
+	}
+	if (Interval{Start: 5, End: 5}).Len() != 0 || !(Interval{Start: 6, End: 3}).Empty() {
+		t.Errorf("degenerate intervals should be empty")
+	}
+	if iv.String() != "[2, 5)" {
+		t.Errorf("String() = %q, want %q", iv.String(), "[2, 5)")
+	}
+}
+
+func TestIntervalOverlaps(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      Interval
+		overlaps  bool
+		intersect Interval
+	}{
+		{"disjoint", Interval{0, 2}, Interval{3, 5}, false, Interval{3, 2}},
+		{"touching", Interval{0, 3}, Interval{3, 5}, false, Interval{3, 3}},
+		{"partial", Interval{0, 4}, Interval{3, 5}, true, Interval{3, 4}},
+		{"nested", Interval{0, 10}, Interval{3, 5}, true, Interval{3, 5}},
+		{"empty", Interval{4, 4}, Interval{0, 10}, false, Interval{4, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Overlaps(tt.b); got != tt.overlaps {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.overlaps)
+			}
+			if got := tt.b.Overlaps(tt.a); got != tt.overlaps {
+				t.Errorf("Overlaps() is not symmetric")
+			}
+			if got := tt.a.Intersect(tt.b); got != tt.intersect {
+				t.Errorf("Intersect() = %v, want %v", got, tt.intersect)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package interval
+
+// Set is a set of integers stored as disjoint, non-adjacent intervals
+// Overlapping or touching intervals are merged on insertion, so [0, 3) and [3, 5) become [0, 5)
+//
+// The intervals are kept in a treap ordered by start, where every node also tracks
+// the longest interval in its subtree. This makes Insert, Remove, Find and FirstFit
+// O(log n) expected, where n is the number of stored intervals.
+//
+// The zero value is an empty set ready to use
+type Set struct {
+	root *node
+	seed uint64
+}
+
+type node struct {
+	iv          Interval
+	priority    uint64
+	left, right *node
+	maxLen      int // Longest interval in this subtree
+	count       int // Number of intervals in this subtree
+	size        int // Number of integers covered by this subtree
+}
+
+// NewSet creates a set containing the union of the given intervals
+func NewSet(intervals ...Interval) *Set {
+	s := &Set{}
+	for _, iv := range intervals {
+		s.Insert(iv)
+	}
+	return s
+}
+
+// Len returns the number of disjoint intervals in the set
+func (s *Set) Len() int {
+	return count(s.root)
+}
+
+// Size returns the number of integers covered by the set
+func (s *Set) Size() int {
+	if s.root == nil {
+		return 0
+	}
+	return s.root.size
+}
+
+// MaxLen returns the length of the longest interval in the set, or 0 if the set is empty
+func (s *Set) MaxLen() int {
+	return maxLen(s.root)
+}
+
+// Insert adds all integers of iv to the set, merging it with overlapping and adjacent intervals
+// Time complexity: O(log n) expected, plus O(k) for k intervals absorbed by the merge
+func (s *Set) Insert(iv Interval) {
+	if iv.Empty() {
+		return
+	}
+
+	// Intervals starting before iv; the last of them may touch iv
+	left, rest := split(s.root, iv.Start)
+	if prev := last(left); prev != nil && prev.End >= iv.Start {
+		left, _ = split(left, prev.Start)
+		iv.Start = prev.Start
+		iv.End = max(iv.End, prev.End)
+	}
+
+	// Intervals starting inside or right at the end of iv are absorbed
+	absorbed, right := split(rest, iv.End+1)
+	if lastAbsorbed := last(absorbed); lastAbsorbed != nil {
+		iv.End = max(iv.End, lastAbsorbed.End)
+	}
+
+	s.root = merge(merge(left, s.newNode(iv)), right)
+}
+
+// Remove deletes all integers of iv from the set, splitting intervals that only partly overlap it
+// Time complexity: O(log n) expected, plus O(k) for k intervals removed entirely
+func (s *Set) Remove(iv Interval) {
+	if iv.Empty() {
+		return
+	}
+
+	left, rest := split(s.root, iv.Start)
+	removed, right := split(rest, iv.End)
+
+	// The interval right before iv may stick into or past it
+	if prev := last(left); prev != nil && prev.End > iv.Start {
+		left, _ = split(left, prev.Start)
+		left = merge(left, s.newNode(Interval{Start: prev.Start, End: iv.Start}))
+		if prev.End > iv.End {
+			right = merge(s.newNode(Interval{Start: iv.End, End: prev.End}), right)
+		}
+	}
+
+	// The last removed interval may continue past the end of iv
+	if lastRemoved := last(removed); lastRemoved != nil && lastRemoved.End > iv.End {
+		right = merge(s.newNode(Interval{Start: iv.End, End: lastRemoved.End}), right)
+	}
+
+	s.root = merge(left, right)
+}
+
+// Contains reports whether x is in the set
+func (s *Set) Contains(x int) bool {
+	_, ok := s.Find(x)
+	return ok
+}
+
+// Find returns the interval of the set containing x
+// Time complexity: O(log n) expected
+func (s *Set) Find(x int) (Interval, bool) {
+	var candidate *node
+	for n := s.root; n != nil; {
+		if n.iv.Start <= x {
+			candidate = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	if candidate != nil && candidate.iv.Contains(x) {
+		return candidate.iv, true
+	}
+	return Interval{}, false
+}
+
+// FirstFit returns the leftmost interval of the set that is at least length long
+// This is the classic first-fit allocation query: where is the first free span that fits?
+// Time complexity: O(log n) expected
+func (s *Set) FirstFit(length int) (Interval, bool) {
+	n := s.root
+	for n != nil && n.maxLen >= length {
+		if maxLen(n.left) >= length {
+			n = n.left
+		} else if n.iv.Len() >= length {
+			return n.iv, true
+		} else {
+			n = n.right
+		}
+	}
+	return Interval{}, false
+}
+
+// Intervals returns the intervals of the set in increasing order
+func (s *Set) Intervals() []Interval {
+	result := make([]Interval, 0, s.Len())
+	s.Each(func(iv Interval) bool {
+		result = append(result, iv)
+		return true
+	})
+	return result
+}
+
+// Each calls fn for every interval in increasing order until fn returns false
+func (s *Set) Each(fn func(Interval) bool) {
+	walk(s.root, fn)
+}
+
+// Gaps returns the maximal intervals inside bounds that are not covered by the set, in increasing order
+func (s *Set) Gaps(bounds Interval) []Interval {
+	gaps := []Interval{}
+	pos := bounds.Start
+	s.Each(func(iv Interval) bool {
+		if iv.Start >= bounds.End {
+			return false
+		}
+		if iv.Start > pos {
+			gaps = append(gaps, Interval{Start: pos, End: iv.Start})
+		}
+		pos = max(pos, iv.End)
+		return true
+	})
+	if pos < bounds.End {
+		gaps = append(gaps, Interval{Start: pos, End: bounds.End})
+	}
+	return gaps
+}
+
+// Union returns a new set containing the integers of both sets
+func (s *Set) Union(other *Set) *Set {
+	result := s.Clone()
+	other.Each(func(iv Interval) bool {
+		result.Insert(iv)
+		return true
+	})
+	return result
+}
+
+// Difference returns a new set with the integers of s that are not in other
+func (s *Set) Difference(other *Set) *Set {
+	result := s.Clone()
+	other.Each(func(iv Interval) bool {
+		result.Remove(iv)
+		return true
+	})
+	return result
+}
+
+// Clone returns an independent copy of the set
+func (s *Set) Clone() *Set {
+	return NewSet(s.Intervals()...)
+}
+
+// newNode creates a leaf with a pseudo-random priority
+// A fixed xorshift sequence keeps the tree shape, and therefore performance, reproducible
+func (s *Set) newNode(iv Interval) *node {
+	if s.seed == 0 {
+		s.seed = 0x9E3779B97F4A7C15
+	}
+	s.seed ^= s.seed << 13
+	s.seed ^= s.seed >> 7
+	s.seed ^= s.seed << 17
+	n := &node{iv: iv, priority: s.seed}
+	update(n)
+	return n
+}
+
+func maxLen(n *node) int {
+	if n == nil {
+		return 0
+	}
+	return n.maxLen
+}
+
+func count(n *node) int {
+	if n == nil {
+		return 0
+	}
+	return n.count
+}
+
+func size(n *node) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// update recomputes the subtree aggregates of n from its children
+func update(n *node) {
+	n.maxLen = max(n.iv.Len(), maxLen(n.left), maxLen(n.right))
+	n.count = 1 + count(n.left) + count(n.right)
+	n.size = n.iv.Len() + size(n.left) + size(n.right)
+}
+
+// split divides the tree into intervals starting before key and intervals starting at or after key
+func split(n *node, key int) (*node, *node) {
+	if n == nil {
+		return nil, nil
+	}
+	if n.iv.Start < key {
+		l, r := split(n.right, key)
+		n.right = l
+		update(n)
+		return n, r
+	}
+	l, r := split(n.left, key)
+	n.left = r
+	update(n)
+	return l, n
+}
+
+// merge joins two trees where every interval of l starts before every interval of r
+func merge(l, r *node) *node {
+	if l == nil {
+		return r
+	}
+	if r == nil {
+		return l
+	}
+	if l.priority > r.priority {
+		l.right = merge(l.right, r)
+		update(l)
+		return l
+	}
+	r.left = merge(l, r.left)
+	update(r)
+	return r
+}
+
+// last returns the rightmost interval of the tree, or nil for an empty tree
+func last(n *node) *Interval {
+	if n == nil {
+		return nil
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	iv := n.iv
+	return &iv
+}
+
+// walk visits the tree in order and reports whether the traversal should continue
+func walk(n *node, fn func(Interval) bool) bool {
+	if n == nil {
+		return true
+	}
+	return walk(n.left, fn) && fn(n.iv) && walk(n.right, fn)
+}
@@ -0,0 +1,185 @@
+package interval
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestSetInsertMerges(t *testing.T) {
+	tests := []struct {
+		name   string
+		insert []Interval
+		want   []Interval
+	}{
+		{"empty", nil, []Interval{}},
+		{"disjoint", []Interval{{5, 7}, {0, 2}}, []Interval{{0, 2}, {5, 7}}},
+		{"adjacent", []Interval{{0, 3}, {3, 5}}, []Interval{{0, 5}}},
+		{"adjacent from the left", []Interval{{3, 5}, {0, 3}}, []Interval{{0, 5}}},
+		{"overlapping", []Interval{{0, 4}, {2, 6}}, []Interval{{0, 6}}},
+		{"swallows several", []Interval{{1, 2}, {4, 5}, {7, 8}, {0, 10}}, []Interval{{0, 10}}},
+		{"bridges a gap", []Interval{{0, 2}, {5, 7}, {2, 5}}, []Interval{{0, 7}}},
+		{"already covered", []Interval{{0, 10}, {3, 4}}, []Interval{{0, 10}}},
+		{"empty interval ignored", []Interval{{3, 3}}, []Interval{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSet(tt.insert...)
+			if got := s.Intervals(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intervals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetRemove(t *testing.T) {
+	tests := []struct {
+		name   string
+		remove Interval
+		want   []Interval
+	}{
+		{"nothing", Interval{10, 12}, []Interval{{0, 5}, {8, 10}, {15, 20}}},
+		{"whole interval", Interval{8, 10}, []Interval{{0, 5}, {15, 20}}},
+		{"prefix", Interval{0, 2}, []Interval{{2, 5}, {8, 10}, {15, 20}}},
+		{"suffix", Interval{3, 6}, []Interval{{0, 3}, {8, 10}, {15, 20}}},
+		{"middle splits", Interval{16, 18}, []Interval{{0, 5}, {8, 10}, {15, 16}, {18, 20}}},
+		{"spanning several", Interval{4, 17}, []Interval{{0, 4}, {17, 20}}},
+		{"everything", Interval{-5, 25}, []Interval{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSet(Interval{0, 5}, Interval{8, 10}, Interval{15, 20})
+			s.Remove(tt.remove)
+			if got := s.Intervals(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intervals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetQueries(t *testing.T) {
+	s := NewSet(Interval{0, 2}, Interval{4, 5}, Interval{7, 11}, Interval{14, 17})
+
+	if s.Len() != 4 || s.Size() != 10 || s.MaxLen() != 4 {
+		t.Errorf("Len(), Size(), MaxLen() = %d, %d, %d, want 4, 10, 4", s.Len(), s.Size(), s.MaxLen())
+	}
+
+	if iv, ok := s.Find(9); !ok || iv != (Interval{7, 11}) {
+		t.Errorf("Find(9) = %v, %v, want [7, 11), true", iv, ok)
+	}
+	if _, ok := s.Find(11); ok {
+		t.Errorf("Find(11) should miss the half-open end")
+	}
+	if s.Contains(3) || !s.Contains(0) {
+		t.Errorf("Contains() gave wrong membership")
+	}
+
+	fits := []struct {
+		length int
+		want   Interval
+		ok     bool
+	}{
+		{1, Interval{0, 2}, true},
+		{2, Interval{0, 2}, true},
+		{3, Interval{7, 11}, true},
+		{4, Interval{7, 11}, true},
+		{5, Interval{}, false},
+	}
+	for _, tt := range fits {
+		if got, ok := s.FirstFit(tt.length); got != tt.want || ok != tt.ok {
+			t.Errorf("FirstFit(%d) = %v, %v, want %v, %v", tt.length, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	gaps := s.Gaps(Interval{-1, 16})
+	wantGaps := []Interval{{-1, 0}, {2, 4}, {5, 7}, {11, 14}}
+	if !reflect.DeepEqual(gaps, wantGaps) {
+		t.Errorf("Gaps() = %v, want %v", gaps, wantGaps)
+	}
+	if gaps := s.Gaps(Interval{7, 11}); len(gaps) != 0 {
+		t.Errorf("Gaps() inside a covered interval = %v, want none", gaps)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := NewSet(Interval{0, 5}, Interval{10, 15})
+	b := NewSet(Interval{3, 12})
+
+	if got, want := a.Union(b).Intervals(), []Interval{{0, 15}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+	if got, want := a.Difference(b).Intervals(), []Interval{{0, 3}, {12, 15}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Difference() = %v, want %v", got, want)
+	}
+
+	// Operands must be left untouched
+	if got, want := a.Intervals(), []Interval{{0, 5}, {10, 15}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("a modified to %v", got)
+	}
+}
+
+// TestSetMatchesBitmap checks random inserts and removes against a plain boolean slice
+func TestSetMatchesBitmap(t *testing.T) {
+	const width = 200
+	rng := rand.New(rand.NewSource(1))
+	s := NewSet()
+	covered := make([]bool, width)
+
+	for step := 0; step < 5000; step++ {
+		start := rng.Intn(width)
+		iv := Interval{Start: start, End: min(width, start+1+rng.Intn(20))}
+		insert := rng.Intn(3) != 0
+		if insert {
+			s.Insert(iv)
+		} else {
+			s.Remove(iv)
+		}
+		for x := iv.Start; x < iv.End; x++ {
+			covered[x] = insert
+		}
+
+		want := bitmapIntervals(covered)
+		if got := s.Intervals(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("step %d: Intervals() = %v, want %v", step, got, want)
+		}
+		if got, want := s.MaxLen(), longest(want); got != want {
+			t.Fatalf("step %d: MaxLen() = %d, want %d", step, got, want)
+		}
+	}
+}
+
+func bitmapIntervals(covered []bool) []Interval {
+	result := []Interval{}
+	for x := 0; x < len(covered); x++ {
+		if !covered[x] {
+			continue
+		}
+		start := x
+		for x < len(covered) && covered[x] {
+			x++
+		}
+		result = append(result, Interval{Start: start, End: x})
+	}
+	return result
+}
+
+func longest(intervals []Interval) int {
+	best := 0
+	for _, iv := range intervals {
+		best = max(best, iv.Len())
+	}
+	return best
+}
+
+func BenchmarkSetFirstFit(b *testing.B) {
+	s := NewSet()
+	for i := 0; i < 10000; i++ {
+		s.Insert(New(i*10, 1+i%9))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.FirstFit(9)
+	}
+}
@@ -0,0 +1,158 @@
+package memo
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Func is a function definition that may call itself through recurse
+// Calls made through recurse are memoized as well
+type Func[K comparable, V any] func(recurse func(K) V, key K) V
+
+// Stats reports cache effectiveness
+type Stats struct {
+	Hits      int
+	Misses    int
+	Evictions int
+	Size      int
+}
+
+// Memo caches the results of a (possibly recursive) function
+// It is safe for concurrent use; the function itself is evaluated outside the lock,
+// so concurrent misses on the same key may evaluate it more than once
+// The function must be deterministic for caching to be correct
+type Memo[K comparable, V any] struct {
+	fn       Func[K, V]
+	recurse  func(K) V // Get, bound once so that recursive calls do not allocate a method value each
+	capacity int       // Maximum number of cached entries, 0 for unbounded
+
+	mu sync.Mutex
+	// An unbounded memo keeps its values in a plain map; only a bounded one pays for the recency list
+	values  map[K]V
+	entries map[K]*list.Element
+	recency *list.List // Front is the most recently used entry
+	stats   Stats
+}
+
+// entry is a cached key/value pair kept in the recency list
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// New creates an unbounded memo of fn
+func New[K comparable, V any](fn Func[K, V]) *Memo[K, V] {
+	return NewLRU(0, fn)
+}
+
+// NewLRU creates a memo of fn that keeps at most capacity entries,
+// evicting the least recently used one when full
+// A capacity of 0 or less means unbounded
+func NewLRU[K comparable, V any](capacity int, fn Func[K, V]) *Memo[K, V] {
+	m := &Memo[K, V]{fn: fn, capacity: max(capacity, 0)}
+	m.recurse = m.Get
+	m.clear()
+	return m
+}
+
+// clear makes the cache empty; the caller holds the lock or has the memo to itself
+func (m *Memo[K, V]) clear() {
+	if m.capacity == 0 {
+		m.values = make(map[K]V)
+		return
+	}
+	m.entries = make(map[K]*list.Element)
+	m.recency = list.New()
+}
+
+// Get returns fn(key), computing and caching it on a miss
+func (m *Memo[K, V]) Get(key K) V {
+	if value, ok := m.lookup(key); ok {
+		return value
+	}
+
+	value := m.fn(m.recurse, key)
+	m.store(key, value)
+	return value
+}
+
+// lookup returns the cached value for key and records a hit or miss
+func (m *Memo[K, V]) lookup(key K) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.values != nil {
+		if value, ok := m.values[key]; ok {
+			m.stats.Hits++
+			return value, true
+		}
+	} else if element, ok := m.entries[key]; ok {
+		m.stats.Hits++
+		m.recency.MoveToFront(element)
+		return element.Value.(*entry[K, V]).value, true
+	}
+
+	m.stats.Misses++
+	var zero V
+	return zero, false
+}
+
+// store caches the value for key, evicting the least recently used entry if over capacity
+func (m *Memo[K, V]) store(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.values != nil {
+		m.values[key] = value
+		return
+	}
+
+	// Another goroutine may have stored the key while we were computing
+	if element, ok := m.entries[key]; ok {
+		m.recency.MoveToFront(element)
+		return
+	}
+
+	m.entries[key] = m.recency.PushFront(&entry[K, V]{key: key, value: value})
+
+	if m.capacity > 0 && m.recency.Len() > m.capacity {
+		oldest := m.recency.Back()
+		m.recency.Remove(oldest)
+		delete(m.entries, oldest.Value.(*entry[K, V]).key)
+		m.stats.Evictions++
+	}
+}
+
+// Stats returns a snapshot of the cache statistics
+func (m *Memo[K, V]) Stats() Stats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := m.stats
+	stats.Size = m.size()
+	return stats
+}
+
+// Len returns the number of cached entries
+func (m *Memo[K, V]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.size()
+}
+
+// size returns the number of cached entries; the caller holds the lock
+func (m *Memo[K, V]) size() int {
+	if m.values != nil {
+		return len(m.values)
+	}
+	return len(m.entries)
+}
+
+// Reset drops every cached entry and clears the statistics
+func (m *Memo[K, V]) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.clear()
+	m.stats = Stats{}
+}
@@ -0,0 +1,119 @@
+package memo
+
+import (
+	"sync"
+	"testing"
+)
+
+// fibonacci is a recursive definition used throughout the tests
+func fibonacci(recurse func(int) int, n int) int {
+	if n < 2 {
+		return n
+	}
+	return recurse(n-1) + recurse(n-2)
+}
+
+func TestMemoRecursive(t *testing.T) {
+	fib := New(fibonacci)
+
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{0, 0},
+		{1, 1},
+		{10, 55},
+		{90, 2880067194370816120},
+	}
+
+	for _, tt := range tests {
+		if got := fib.Get(tt.n); got != tt.want {
+			t.Errorf("fib(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestMemoStats(t *testing.T) {
+	calls := 0
+	fib := New(func(recurse func(int) int, n int) int {
+		calls++
+		return fibonacci(recurse, n)
+	})
+
+	fib.Get(30)
+	if calls != 31 {
+		t.Errorf("fib(30) evaluated the function %d times, want 31", calls)
+	}
+
+	stats := fib.Stats()
+	if stats.Misses != 31 || stats.Size != 31 || stats.Evictions != 0 {
+		t.Errorf("Stats() = %+v, want 31 misses and 31 entries", stats)
+	}
+	// fib(n) for n >= 2 hits on fib(n-2) after computing fib(n-1)
+	if stats.Hits != 28 {
+		t.Errorf("Stats().Hits = %d, want 28", stats.Hits)
+	}
+
+	fib.Get(30)
+	if fib.Stats().Hits != 29 || calls != 31 {
+		t.Errorf("repeated Get() was not served from the cache: %+v", fib.Stats())
+	}
+
+	fib.Reset()
+	if fib.Len() != 0 || fib.Stats() != (Stats{}) {
+		t.Errorf("Reset() left state behind: %+v", fib.Stats())
+	}
+}
+
+func TestMemoLRU(t *testing.T) {
+	calls := map[int]int{}
+	square := NewLRU(2, func(_ func(int) int, n int) int {
+		calls[n]++
+		return n * n
+	})
+
+	square.Get(1)
+	square.Get(2)
+	square.Get(1) // 1 becomes most recently used
+	square.Get(3) // evicts 2
+	square.Get(1) // still cached
+	square.Get(2) // recomputed
+
+	if calls[1] != 1 || calls[2] != 2 || calls[3] != 1 {
+		t.Errorf("evaluations = %v, want 1:1 2:2 3:1", calls)
+	}
+	if stats := square.Stats(); stats.Size != 2 || stats.Evictions != 2 {
+		t.Errorf("Stats() = %+v, want size 2 with 2 evictions", stats)
+	}
+}
+
+func TestMemoBoundedRecursion(t *testing.T) {
+	// A tiny cache still produces correct results, only slower
+	fib := NewLRU(3, fibonacci)
+	if got := fib.Get(40); got != 102334155 {
+		t.Errorf("fib(40) = %d, want 102334155", got)
+	}
+	if fib.Len() > 3 {
+		t.Errorf("Len() = %d, want at most 3", fib.Len())
+	}
+}
+
+func TestMemoConcurrent(t *testing.T) {
+	fib := New(fibonacci)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(offset int) {
+			defer wg.Done()
+			for n := 0; n < 80; n++ {
+				fib.Get((n + offset*10) % 80)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if got := fib.Get(79); got != 14472334024676221 {
+		t.Errorf("fib(79) = %d, want 14472334024676221", got)
+	}
+}
@@ -0,0 +1,98 @@
+package pqueue
+
+import "container/heap"
+
+// Item is a handle to a value stored in a PriorityQueue
+// Keep it to change the value's priority later with Update
+type Item[T any] struct {
+	Value    T
+	priority int
+	index    int // Position in the heap, -1 once popped
+}
+
+// Priority returns the current priority of the item
+func (it *Item[T]) Priority() int {
+	return it.priority
+}
+
+// Queued reports whether the item is still waiting in its queue
+func (it *Item[T]) Queued() bool {
+	return it.index >= 0
+}
+
+// PriorityQueue is a min-priority queue: Pop returns the value with the lowest priority
+// Values with equal priority are returned in unspecified order
+type PriorityQueue[T any] struct {
+	items itemHeap[T]
+}
+
+// New creates an empty priority queue
+func New[T any]() *PriorityQueue[T] {
+	return &PriorityQueue[T]{}
+}
+
+// Len returns the number of queued values
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.items)
+}
+
+// Push adds a value with the given priority and returns its handle
+// Time complexity: O(log n)
+func (pq *PriorityQueue[T]) Push(value T, priority int) *Item[T] {
+	item := &Item[T]{Value: value, priority: priority}
+	heap.Push(&pq.items, item)
+	return item
+}
+
+// Pop removes and returns the value with the lowest priority together with that priority
+// Panics if the queue is empty
+// Time complexity: O(log n)
+func (pq *PriorityQueue[T]) Pop() (T, int) {
+	item := heap.Pop(&pq.items).(*Item[T])
+	return item.Value, item.priority
+}
+
+// Peek returns the value with the lowest priority without removing it
+// Panics if the queue is empty
+func (pq *PriorityQueue[T]) Peek() (T, int) {
+	item := pq.items[0]
+	return item.Value, item.priority
+}
+
+// Update changes the priority of a queued item (decrease-key or increase-key)
+// Does nothing if the item has already been popped
+// Time complexity: O(log n)
+func (pq *PriorityQueue[T]) Update(item *Item[T], priority int) {
+	if !item.Queued() {
+		return
+	}
+	item.priority = priority
+	heap.Fix(&pq.items, item.index)
+}
+
+// itemHeap implements heap.Interface over item handles
+type itemHeap[T any] []*Item[T]
+
+func (h itemHeap[T]) Len() int           { return len(h) }
+func (h itemHeap[T]) Less(i, j int) bool { return h[i].priority < h[j].priority }
+
+func (h itemHeap[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *itemHeap[T]) Push(x any) {
+	item := x.(*Item[T])
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *itemHeap[T]) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	item.index = -1
+	*h = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,126 @@
+package pqueue
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestPriorityQueueOrder(t *testing.T) {
+	pq := New[string]()
+	pq.Push("c", 3)
+	pq.Push("a", 1)
+	pq.Push("d", 4)
+	pq.Push("b", 2)
+
+	if value, priority := pq.Peek(); value != "a" || priority != 1 {
+		t.Errorf("Peek() = %q, %d; want \"a\", 1", value, priority)
+	}
+
+	var got []string
+	for pq.Len() > 0 {
+		value, _ := pq.Pop()
+		got = append(got, value)
+	}
+	if want := []string{"a", "b", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop() order = %v, want %v", got, want)
+	}
+}
+
+func TestPriorityQueueUpdate(t *testing.T) {
+	pq := New[string]()
+	pq.Push("a", 10)
+	b := pq.Push("b", 20)
+	c := pq.Push("c", 30)
+
+	// Decrease-key moves c to the front, increase-key moves b to the back
+	pq.Update(c, 5)
+	pq.Update(b, 40)
+
+	if c.Priority() != 5 || !c.Queued() {
+		t.Errorf("item c: Priority() = %d, Queued() = %v; want 5, true", c.Priority(), c.Queued())
+	}
+
+	var got []string
+	for pq.Len() > 0 {
+		value, _ := pq.Pop()
+		got = append(got, value)
+	}
+	if want := []string{"c", "a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop() order = %v, want %v", got, want)
+	}
+
+	// Updating a popped item is a no-op
+	if b.Queued() {
+		t.Error("Queued() = true for a popped item")
+	}
+	pq.Update(b, 1)
+	if pq.Len() != 0 {
+		t.Errorf("Update() of a popped item changed the queue, Len() = %d", pq.Len())
+	}
+}
+
+func TestPriorityQueueRandomized(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	pq := New[int]()
+	var items []*Item[int]
+	for i := 0; i < 1000; i++ {
+		items = append(items, pq.Push(i, rng.Intn(10000)))
+	}
+	for _, item := range items[:300] {
+		pq.Update(item, rng.Intn(10000))
+	}
+
+	previous := -1
+	for pq.Len() > 0 {
+		_, priority := pq.Pop()
+		if priority < previous {
+			t.Fatalf("Pop() returned priority %d after %d", priority, previous)
+		}
+		previous = priority
+	}
+}
+
+// benchmarkPriorities is a fixed workload shared by the queue benchmarks
+func benchmarkPriorities(n int) []int {
+	rng := rand.New(rand.NewSource(42))
+	priorities := make([]int, n)
+	for i := range priorities {
+		priorities[i] = rng.Intn(1_000_000)
+	}
+	return priorities
+}
+
+// BenchmarkPriorityQueue pushes n values and pops them all
+func BenchmarkPriorityQueue(b *testing.B) {
+	priorities := benchmarkPriorities(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pq := New[int]()
+		for value, priority := range priorities {
+			pq.Push(value, priority)
+		}
+		for pq.Len() > 0 {
+			pq.Pop()
+		}
+	}
+}
+
+// BenchmarkSortedSliceQueue is the naive baseline: keep a slice sorted by insertion
+func BenchmarkSortedSliceQueue(b *testing.B) {
+	priorities := benchmarkPriorities(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var queue []int
+		for _, priority := range priorities {
+			at := sort.SearchInts(queue, priority)
+			queue = append(queue, 0)
+			copy(queue[at+1:], queue[at:])
+			queue[at] = priority
+		}
+		for len(queue) > 0 {
+			queue = queue[1:]
+		}
+	}
+}
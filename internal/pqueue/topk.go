@@ -0,0 +1,80 @@
+package pqueue
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// TopK keeps the k largest values pushed into it
+// Internally a min-heap of at most k values: the smallest kept value sits at
+// the root and is evicted when a larger one arrives
+type TopK[T any] struct {
+	k      int
+	values boundedHeap[T]
+}
+
+// NewTopK creates a TopK that keeps the k largest values according to compare
+// compare returns a negative number when a < b, zero when equal and a positive number when a > b
+func NewTopK[T any](k int, compare func(a, b T) int) *TopK[T] {
+	return &TopK[T]{
+		k:      k,
+		values: boundedHeap[T]{compare: compare},
+	}
+}
+
+// Push offers a value; it is kept only if it is among the k largest so far
+// Time complexity: O(log k)
+func (t *TopK[T]) Push(value T) {
+	if t.k <= 0 {
+		return
+	}
+	if t.values.Len() < t.k {
+		heap.Push(&t.values, value)
+		return
+	}
+
+	// Replace the smallest kept value if the new one is larger
+	if t.values.compare(value, t.values.items[0]) > 0 {
+		t.values.items[0] = value
+		heap.Fix(&t.values, 0)
+	}
+}
+
+// Len returns the number of kept values
+func (t *TopK[T]) Len() int {
+	return t.values.Len()
+}
+
+// Min returns the smallest kept value, which is the threshold for entry
+// Panics if no value has been kept
+func (t *TopK[T]) Min() T {
+	return t.values.items[0]
+}
+
+// Values returns the kept values from largest to smallest
+func (t *TopK[T]) Values() []T {
+	values := make([]T, len(t.values.items))
+	copy(values, t.values.items)
+	slices.SortFunc(values, func(a, b T) int {
+		return t.values.compare(b, a)
+	})
+	return values
+}
+
+// boundedHeap is a min-heap of values ordered by compare
+type boundedHeap[T any] struct {
+	items   []T
+	compare func(a, b T) int
+}
+
+func (h boundedHeap[T]) Len() int           { return len(h.items) }
+func (h boundedHeap[T]) Less(i, j int) bool { return h.compare(h.items[i], h.items[j]) < 0 }
+func (h boundedHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *boundedHeap[T]) Push(x any) { h.items = append(h.items, x.(T)) }
+
+func (h *boundedHeap[T]) Pop() any {
+	item := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return item
+}
@@ -0,0 +1,77 @@
+package pqueue
+
+import (
+	"cmp"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestTopK(t *testing.T) {
+	tests := []struct {
+		name   string
+		k      int
+		values []int
+		want   []int
+	}{
+		{"keeps largest", 3, []int{5, 1, 9, 3, 7, 2, 8}, []int{9, 8, 7}},
+		{"fewer values than k", 5, []int{2, 1}, []int{2, 1}},
+		{"duplicates", 2, []int{4, 4, 1, 4}, []int{4, 4}},
+		{"zero k", 0, []int{1, 2, 3}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			top := NewTopK(tt.k, cmp.Compare[int])
+			for _, v := range tt.values {
+				top.Push(v)
+			}
+			if got := top.Values(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Values() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopKCustomOrder(t *testing.T) {
+	type region struct {
+		name string
+		area int
+	}
+	top := NewTopK(2, func(a, b region) int { return cmp.Compare(a.area, b.area) })
+	for _, r := range []region{{"A", 4}, {"B", 12}, {"C", 1}, {"D", 9}} {
+		top.Push(r)
+	}
+
+	if top.Min().name != "D" {
+		t.Errorf("Min() = %v, want region D", top.Min())
+	}
+	if got := top.Values(); got[0].name != "B" || got[1].name != "D" {
+		t.Errorf("Values() = %v, want B then D", got)
+	}
+}
+
+// BenchmarkTopK keeps the 10 largest of 10000 values
+func BenchmarkTopK(b *testing.B) {
+	values := benchmarkPriorities(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		top := NewTopK(10, cmp.Compare[int])
+		for _, v := range values {
+			top.Push(v)
+		}
+		top.Values()
+	}
+}
+
+// BenchmarkTopKSortedSlice is the naive baseline: sort everything and take the head
+func BenchmarkTopKSortedSlice(b *testing.B) {
+	values := benchmarkPriorities(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sorted := make([]int, len(values))
+		copy(sorted, values)
+		sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+		_ = sorted[:10]
+	}
+}
@@ -0,0 +1,72 @@
+package prop
+
+import "math/rand/v2"
+
+// Int generates integers between lo and hi inclusive that shrink towards the one closest to zero
+func Int(lo, hi int) Gen[int] {
+	target := min(max(0, lo), hi)
+	return Gen[int]{
+		Generate: func(rng *rand.Rand, size int) int {
+			return lo + rng.IntN(hi-lo+1)
+		},
+		Shrink: func(value int) []int {
+			return ShrinkInt(value, target)
+		},
+	}
+}
+
+// ShrinkInt returns values between target and value, from target itself to the neighbour of value
+// Trying the large steps first finds a small counterexample in a logarithmic number of steps.
+func ShrinkInt(value, target int) []int {
+	var smaller []int
+	for distance := value - target; distance != 0; distance /= 2 {
+		smaller = append(smaller, value-distance)
+	}
+	return smaller
+}
+
+// SliceOf generates slices of up to size elements that shrink by dropping and then by shrinking elements
+func SliceOf[E any](elem Gen[E]) Gen[[]E] {
+	return Gen[[]E]{
+		Generate: func(rng *rand.Rand, size int) []E {
+			values := make([]E, rng.IntN(size+1))
+			for i := range values {
+				values[i] = elem.Generate(rng, size)
+			}
+			return values
+		},
+		Shrink: func(values []E) [][]E {
+			return ShrinkSlice(values, elem.Shrink)
+		},
+	}
+}
+
+// ShrinkSlice returns shorter versions of values and then versions with one element shrunk by elem
+// The halves come first, then every slice with one element removed; elem may be nil.
+func ShrinkSlice[E any](values []E, elem func(E) []E) [][]E {
+	var smaller [][]E
+	if n := len(values); n > 1 {
+		smaller = append(smaller, append([]E(nil), values[:n/2]...), append([]E(nil), values[n/2:]...))
+	}
+	for i := range values {
+		smaller = append(smaller, without(values, i))
+	}
+	if elem == nil {
+		return smaller
+	}
+	for i, value := range values {
+		for _, simpler := range elem(value) {
+			changed := append([]E(nil), values...)
+			changed[i] = simpler
+			smaller = append(smaller, changed)
+		}
+	}
+	return smaller
+}
+
+// without returns a copy of values with the element at i removed
+func without[E any](values []E, i int) []E {
+	removed := make([]E, 0, len(values)-1)
+	removed = append(removed, values[:i]...)
+	return append(removed, values[i+1:]...)
+}
@@ -0,0 +1,120 @@
+package prop
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"testing"
+)
+
+// Gen generates random values of T and proposes smaller versions of a value that broke a property
+type Gen[T any] struct {
+	// Generate returns a random value; size grows over the runs of a check, so early values are small
+	Generate func(rng *rand.Rand, size int) T
+	// Shrink returns values simpler than value, most promising first; nil means values do not shrink
+	Shrink func(value T) []T
+	// Format writes a value in a failure message; nil means %+v
+	Format func(value T) string
+}
+
+// Options tune a check; zero fields take the defaults
+type Options struct {
+	Runs       int    // Values to try, 200 by default
+	MaxSize    int    // Size passed to Generate in the last run, 30 by default
+	Seed       uint64 // Seed of the generator, derived from the test name by default so every run is the same
+	MaxShrinks int    // Shrinking steps to take at most, 1000 by default
+}
+
+// withDefaults fills in the zero fields of opts
+func (opts Options) withDefaults(name string) Options {
+	if opts.Runs <= 0 {
+		opts.Runs = 200
+	}
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = 30
+	}
+	if opts.Seed == 0 {
+		h := fnv.New64a()
+		h.Write([]byte(name))
+		opts.Seed = h.Sum64()
+	}
+	if opts.MaxShrinks <= 0 {
+		opts.MaxShrinks = 1000
+	}
+	return opts
+}
+
+// Failure describes a value that broke a property
+type Failure[T any] struct {
+	Original T     // The value that broke the property first
+	Value    T     // The smallest value found by shrinking Original that still breaks it
+	Err      error // What the property reported for Value
+	Run      int   // Run in which Original was generated, counting from 1
+	Shrinks  int   // Shrinking steps from Original to Value
+	Seed     uint64
+}
+
+// Find tries the property on generated values and returns the first failure, shrunk, or nil if all pass
+// A panic in the property counts as a failure, so a crash shrinks like any other broken property.
+func Find[T any](name string, opts Options, gen Gen[T], property func(T) error) *Failure[T] {
+	opts = opts.withDefaults(name)
+	rng := rand.New(rand.NewPCG(opts.Seed, opts.Seed>>32|1))
+
+	for run := 1; run <= opts.Runs; run++ {
+		size := 1 + (run-1)*opts.MaxSize/opts.Runs
+		value := gen.Generate(rng, size)
+		err := holds(property, value)
+		if err == nil {
+			continue
+		}
+
+		failure := &Failure[T]{Original: value, Value: value, Err: err, Run: run, Seed: opts.Seed}
+		for gen.Shrink != nil && failure.Shrinks < opts.MaxShrinks {
+			shrunk := false
+			for _, candidate := range gen.Shrink(failure.Value) {
+				if err := holds(property, candidate); err != nil {
+					failure.Value, failure.Err = candidate, err
+					failure.Shrinks++
+					shrunk = true
+					break
+				}
+			}
+			if !shrunk {
+				break
+			}
+		}
+		return failure
+	}
+	return nil
+}
+
+// holds runs the property on value, turning a panic into an error
+func holds[T any](property func(T) error, value T) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return property(value)
+}
+
+// Check fails the test with a minimal counterexample when the property does not hold for a generated value
+func Check[T any](t testing.TB, gen Gen[T], property func(T) error) {
+	t.Helper()
+	CheckWith(t, Options{}, gen, property)
+}
+
+// CheckWith is like Check with options other than the defaults
+func CheckWith[T any](t testing.TB, opts Options, gen Gen[T], property func(T) error) {
+	t.Helper()
+	failure := Find(t.Name(), opts, gen, property)
+	if failure == nil {
+		return
+	}
+	format := gen.Format
+	if format == nil {
+		format = func(value T) string { return fmt.Sprintf("%+v", value) }
+	}
+	t.Fatalf("property failed in run %d (seed %d), shrunk %d times to:\n%s\n%v",
+		failure.Run, failure.Seed, failure.Shrinks, format(failure.Value), failure.Err)
+}
@@ -0,0 +1,125 @@
+package prop
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestShrinkInt(t *testing.T) {
+	tests := []struct {
+		value  int
+		target int
+		want   []int
+	}{
+		{0, 0, nil},
+		{1, 0, []int{0}},
+		{100, 0, []int{0, 50, 75, 88, 94, 97, 99}},
+		{-10, 0, []int{0, -5, -8, -9}},
+		{7, 5, []int{5, 6}},
+	}
+
+	for _, tt := range tests {
+		if got := ShrinkInt(tt.value, tt.target); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ShrinkInt(%d, %d) = %v, want %v", tt.value, tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestShrinkSlice(t *testing.T) {
+	got := ShrinkSlice([]int{1, 2, 3}, nil)
+	want := [][]int{{1}, {2, 3}, {2, 3}, {1, 3}, {1, 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ShrinkSlice() = %v, want %v", got, want)
+	}
+}
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name     string
+		property func([]int) error
+		want     []int // nil when the property holds
+	}{
+		{
+			name:     "holds",
+			property: func([]int) error { return nil },
+		},
+		{
+			name: "sum below 50",
+			property: func(values []int) error {
+				sum := 0
+				for _, value := range values {
+					sum += value
+				}
+				if sum >= 50 {
+					return fmt.Errorf("sum %d", sum)
+				}
+				return nil
+			},
+			want: []int{50},
+		},
+		{
+			name: "no element above 10",
+			property: func(values []int) error {
+				for _, value := range values {
+					if value > 10 {
+						return errors.New("too large")
+					}
+				}
+				return nil
+			},
+			want: []int{11},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failure := Find(tt.name, Options{}, SliceOf(Int(0, 100)), tt.property)
+			switch {
+			case tt.want == nil && failure != nil:
+				t.Errorf("Find() = %+v, want no failure", failure)
+			case tt.want != nil && failure == nil:
+				t.Errorf("Find() = nil, want a failure shrunk to %v", tt.want)
+			case tt.want != nil && !reflect.DeepEqual(failure.Value, tt.want):
+				t.Errorf("Find() shrunk %v to %v, want %v", failure.Original, failure.Value, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindRecoversPanics(t *testing.T) {
+	failure := Find("panics", Options{}, SliceOf(Int(-5, 5)), func(values []int) error {
+		if len(values) >= 3 {
+			panic("three values")
+		}
+		return nil
+	})
+	if failure == nil {
+		t.Fatal("Find() = nil, want the panic as a failure")
+	}
+	if want := []int{0, 0, 0}; !reflect.DeepEqual(failure.Value, want) {
+		t.Errorf("Find() shrunk to %v, want %v", failure.Value, want)
+	}
+	if !strings.Contains(failure.Err.Error(), "panic") {
+		t.Errorf("Find() error = %v, want it to mention the panic", failure.Err)
+	}
+}
+
+func TestFindIsDeterministic(t *testing.T) {
+	property := func(value int) error {
+		if value >= 500 {
+			return errors.New("too large")
+		}
+		return nil
+	}
+	first := Find("same name", Options{}, Int(0, 1_000_000), property)
+	second := Find("same name", Options{}, Int(0, 1_000_000), property)
+	if first == nil || second == nil || first.Original != second.Original || first.Value != second.Value {
+		t.Errorf("Find() = %+v and %+v, want the same failure twice", first, second)
+	}
+	if first != nil && first.Value != 500 {
+		t.Errorf("Find() shrunk to %d, want 500", first.Value)
+	}
+}
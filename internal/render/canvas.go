@@ -0,0 +1,154 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
+)
+
+// Canvas is an image of a grid where every cell is drawn as a Scale x Scale square
+// Cell coordinates follow vector.Vec2: X is the column and Y is the row
+type Canvas struct {
+	Width  int // Grid width in cells
+	Height int // Grid height in cells
+	Scale  int // Side of a cell in pixels
+	img    *image.RGBA
+}
+
+// NewCanvas creates a black canvas for a width x height grid
+// Scales below 1 are treated as 1
+func NewCanvas(width, height, scale int) *Canvas {
+	scale = max(scale, 1)
+	img := image.NewRGBA(image.Rect(0, 0, width*scale, height*scale))
+	for i := 3; i < len(img.Pix); i += 4 {
+		img.Pix[i] = 0xff
+	}
+	return &Canvas{Width: width, Height: height, Scale: scale, img: img}
+}
+
+// Grid draws a grid of values with the given palette
+// at returns the value of the cell in column x and row y
+func Grid[T comparable](width, height, scale int, at func(x, y int) T, palette Palette[T]) *Canvas {
+	c := NewCanvas(width, height, scale)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			c.Fill(vector.Vec2{X: x, Y: y}, palette.Color(at(x, y)))
+		}
+	}
+	return c
+}
+
+// Runes draws a text grid such as a puzzle input; short lines are padded with the default color
+func Runes(lines []string, scale int, palette Palette[rune]) *Canvas {
+	rows := make([][]rune, len(lines))
+	width := 0
+	for i, line := range lines {
+		rows[i] = []rune(line)
+		width = max(width, len(rows[i]))
+	}
+
+	return Grid(width, len(rows), scale, func(x, y int) rune {
+		if x < len(rows[y]) {
+			return rows[y][x]
+		}
+		return 0
+	}, palette)
+}
+
+// Image returns the underlying image; changes to it show up on the canvas
+func (c *Canvas) Image() *image.RGBA {
+	return c.img
+}
+
+// Fill paints a whole cell; cells outside the grid are ignored
+func (c *Canvas) Fill(cell vector.Vec2, col color.Color) {
+	c.blendRect(cell.X*c.Scale, cell.Y*c.Scale, c.Scale, c.Scale, col, true)
+}
+
+// Highlight draws col over the given cells, blending with what is already there
+// Use a translucent color such as color.NRGBA{255, 0, 0, 128} to keep the cells underneath visible
+func (c *Canvas) Highlight(cells []vector.Vec2, col color.Color) {
+	for _, cell := range cells {
+		c.blendRect(cell.X*c.Scale, cell.Y*c.Scale, c.Scale, c.Scale, col, false)
+	}
+}
+
+// Path draws a line through the centers of consecutive cells
+// The line is about a third of a cell thick, so the cells themselves stay recognisable
+func (c *Canvas) Path(cells []vector.Vec2, col color.Color) {
+	thickness := max(1, c.Scale/3)
+	center := func(cell vector.Vec2) (int, int) {
+		return cell.X*c.Scale + c.Scale/2, cell.Y*c.Scale + c.Scale/2
+	}
+	dot := func(px, py int) {
+		c.blendRect(px-thickness/2, py-thickness/2, thickness, thickness, col, true)
+	}
+
+	if len(cells) == 1 {
+		dot(center(cells[0]))
+	}
+	for i := 1; i < len(cells); i++ {
+		x0, y0 := center(cells[i-1])
+		x1, y1 := center(cells[i])
+		steps := max(abs(float64(x1-x0)), abs(float64(y1-y0)))
+		for s := 0.0; s <= steps; s++ {
+			t := 0.0
+			if steps > 0 {
+				t = s / steps
+			}
+			dot(x0+int(t*float64(x1-x0)), y0+int(t*float64(y1-y0)))
+		}
+	}
+}
+
+// blendRect paints a pixel rectangle clipped to the image
+// With replace set the color overwrites the pixels, otherwise it is alpha-blended over them
+func (c *Canvas) blendRect(x, y, w, h int, col color.Color, replace bool) {
+	bounds := c.img.Bounds()
+	r, g, b, a := col.RGBA() // Alpha-premultiplied, 16 bits per channel
+	for py := max(y, bounds.Min.Y); py < min(y+h, bounds.Max.Y); py++ {
+		for px := max(x, bounds.Min.X); px < min(x+w, bounds.Max.X); px++ {
+			i := c.img.PixOffset(px, py)
+			pix := c.img.Pix[i : i+4 : i+4]
+			if replace || a == 0xffff {
+				pix[0], pix[1], pix[2], pix[3] = uint8(r>>8), uint8(g>>8), uint8(b>>8), uint8(a>>8)
+				continue
+			}
+			// Porter-Duff "over" with premultiplied colors
+			keep := 0xffff - a
+			pix[0] = uint8((r + uint32(pix[0])*0x101*keep/0xffff) >> 8)
+			pix[1] = uint8((g + uint32(pix[1])*0x101*keep/0xffff) >> 8)
+			pix[2] = uint8((b + uint32(pix[2])*0x101*keep/0xffff) >> 8)
+			pix[3] = uint8((a + uint32(pix[3])*0x101*keep/0xffff) >> 8)
+		}
+	}
+}
+
+// WritePNG encodes the canvas as a PNG image
+func (c *Canvas) WritePNG(w io.Writer) error {
+	return png.Encode(w, c.img)
+}
+
+// WritePPM encodes the canvas as a binary PPM (P6) image, which most viewers and converters read
+// PPM has no alpha channel, so translucent pixels are written as if over black
+func (c *Canvas) WritePPM(w io.Writer) error {
+	bounds := c.img.Bounds()
+	out := bufio.NewWriter(w)
+	if _, err := fmt.Fprintf(out, "P6\n%d %d\n255\n", bounds.Dx(), bounds.Dy()); err != nil {
+		return err
+	}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row := c.img.Pix[c.img.PixOffset(bounds.Min.X, y) : c.img.PixOffset(bounds.Max.X-1, y)+4]
+		for i := 0; i < len(row); i += 4 {
+			if _, err := out.Write(row[i : i+3]); err != nil {
+				return err
+			}
+		}
+	}
+	return out.Flush()
+}
@@ -0,0 +1,107 @@
+package render
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
+)
+
+var (
+	red   = color.RGBA{255, 0, 0, 255}
+	white = color.RGBA{255, 255, 255, 255}
+	black = color.RGBA{0, 0, 0, 255}
+)
+
+func testPalette() Palette[rune] {
+	return Palette[rune]{Colors: map[rune]color.Color{'#': white, '@': red}}
+}
+
+func TestRunes(t *testing.T) {
+	c := Runes([]string{"#.", "@", ".#"}, 2, testPalette())
+
+	if got := c.Image().Bounds().Size(); got.X != 4 || got.Y != 6 {
+		t.Fatalf("image size = %v, want 4x6", got)
+	}
+
+	tests := []struct {
+		x, y int
+		want color.RGBA
+	}{
+		{0, 0, white}, {1, 1, white}, // Cell (0, 0) covers pixels 0..1
+		{2, 0, black}, // '.' is not in the palette
+		{0, 2, red}, {1, 3, red},
+		{2, 2, black}, // Padding of the short line
+		{3, 5, white},
+	}
+	for _, tt := range tests {
+		if got := c.Image().RGBAAt(tt.x, tt.y); got != tt.want {
+			t.Errorf("pixel (%d, %d) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	c := NewCanvas(2, 1, 1)
+	c.Fill(vector.Vec2{X: 0, Y: 0}, white)
+	c.Highlight([]vector.Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 5, Y: 5}}, color.NRGBA{255, 0, 0, 128})
+
+	// Half red over white keeps full red and roughly half green and blue
+	if got := c.Image().RGBAAt(0, 0); got.R != 255 || got.G < 120 || got.G > 135 || got.A != 255 {
+		t.Errorf("blend over white = %v, want about {255 127 127 255}", got)
+	}
+	if got := c.Image().RGBAAt(1, 0); got.R < 120 || got.R > 135 || got.G != 0 || got.A != 255 {
+		t.Errorf("blend over black = %v, want about {128 0 0 255}", got)
+	}
+}
+
+func TestPath(t *testing.T) {
+	c := NewCanvas(3, 3, 3)
+	c.Path([]vector.Vec2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}}, red)
+
+	// Centers of cells along the path are painted, corners of cells stay untouched
+	for _, p := range [][2]int{{1, 1}, {4, 1}, {7, 1}, {7, 4}, {7, 7}} {
+		if got := c.Image().RGBAAt(p[0], p[1]); got != red {
+			t.Errorf("pixel %v = %v, want red", p, got)
+		}
+	}
+	for _, p := range [][2]int{{0, 0}, {1, 4}, {4, 4}, {8, 8}} {
+		if got := c.Image().RGBAAt(p[0], p[1]); got != black {
+			t.Errorf("pixel %v = %v, want black", p, got)
+		}
+	}
+}
+
+func TestWritePNG(t *testing.T) {
+	c := Runes([]string{"#@"}, 3, testPalette())
+	var buf bytes.Buffer
+	if err := c.WritePNG(&buf); err != nil {
+		t.Fatalf("WritePNG() error = %v", err)
+	}
+
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("decoding PNG: %v", err)
+	}
+	if got := img.Bounds().Size(); got.X != 6 || got.Y != 3 {
+		t.Errorf("decoded size = %v, want 6x3", got)
+	}
+	if got := color.RGBAModel.Convert(img.At(4, 1)); got != red {
+		t.Errorf("decoded pixel = %v, want red", got)
+	}
+}
+
+func TestWritePPM(t *testing.T) {
+	c := Runes([]string{"#@"}, 1, testPalette())
+	var buf bytes.Buffer
+	if err := c.WritePPM(&buf); err != nil {
+		t.Fatalf("WritePPM() error = %v", err)
+	}
+
+	want := append([]byte("P6\n2 1\n255\n"), 255, 255, 255, 255, 0, 0)
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("WritePPM() = %q, want %q", buf.Bytes(), want)
+	}
+}
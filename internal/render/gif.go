@@ -0,0 +1,128 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"io"
+)
+
+// Recorder receives the frames of a step-by-step simulation
+// Simulations call Record once per step; draw is only called for steps that are kept,
+// so skipped steps cost nothing to render.
+type Recorder interface {
+	Record(draw func() *Canvas)
+	// Done reports that a step the recorder would have kept was dropped because no further frames
+	// will be kept, so the simulation may stop early; a simulation that ends first was recorded whole
+	Done() bool
+}
+
+// Discard is a Recorder that keeps nothing
+var Discard Recorder = discard{}
+
+type discard struct{}
+
+func (discard) Record(func() *Canvas) {}
+func (discard) Done() bool            { return true }
+
+// GIFOptions controls which simulation steps become frames and how fast they play
+type GIFOptions struct {
+	FPS       int // Frames per second, 10 if not set
+	Stride    int // Keep every Stride-th step, starting with the first; 1 if not set
+	MaxFrames int // Stop after this many frames; 0 means no limit
+}
+
+// GIFRecorder collects frames into an animated GIF
+// image/gif only writes whole animations, so every frame stays in memory until Encode. A frame takes about
+// a byte per pixel, so long simulations need MaxFrames or Stride to keep the recording small.
+type GIFRecorder struct {
+	options GIFOptions
+	steps   int
+	dropped bool // A step that Stride keeps came after MaxFrames frames
+	anim    gif.GIF
+}
+
+// NewGIFRecorder creates an empty recorder
+func NewGIFRecorder(options GIFOptions) *GIFRecorder {
+	if options.FPS <= 0 {
+		options.FPS = 10
+	}
+	if options.Stride <= 0 {
+		options.Stride = 1
+	}
+	return &GIFRecorder{options: options}
+}
+
+// Record keeps the frame of every Stride-th step until MaxFrames frames are recorded
+func (r *GIFRecorder) Record(draw func() *Canvas) {
+	step := r.steps
+	r.steps++
+	if step%r.options.Stride != 0 || r.dropped {
+		return
+	}
+	if r.options.MaxFrames > 0 && len(r.anim.Image) >= r.options.MaxFrames {
+		r.dropped = true
+		return
+	}
+
+	// GIF delays are in hundredths of a second
+	r.anim.Image = append(r.anim.Image, paletted(draw().Image()))
+	r.anim.Delay = append(r.anim.Delay, max(1, 100/r.options.FPS))
+}
+
+// Done reports whether a step that Stride keeps was dropped because MaxFrames frames were already recorded
+func (r *GIFRecorder) Done() bool {
+	return r.dropped
+}
+
+// Frames returns the number of recorded frames
+func (r *GIFRecorder) Frames() int {
+	return len(r.anim.Image)
+}
+
+// Encode writes the animation, looping forever
+func (r *GIFRecorder) Encode(w io.Writer) error {
+	return gif.EncodeAll(w, &r.anim)
+}
+
+// paletted converts an image to the 256-color form GIF needs
+// Grid renderings usually have only a handful of colors, which are kept exactly;
+// images with more colors fall back to the nearest web-safe color.
+func paletted(img *image.RGBA) *image.Paletted {
+	bounds := img.Bounds()
+	index := map[color.RGBA]uint8{}
+	colors := color.Palette{}
+	exact := true
+
+	for y := bounds.Min.Y; y < bounds.Max.Y && exact; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := img.RGBAAt(x, y)
+			if _, ok := index[c]; ok {
+				continue
+			}
+			if len(colors) == 256 {
+				exact = false
+				break
+			}
+			index[c] = uint8(len(colors))
+			colors = append(colors, c)
+		}
+	}
+
+	if !exact {
+		colors = palette.WebSafe
+	}
+	out := image.NewPaletted(bounds, colors)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := img.RGBAAt(x, y)
+			if exact {
+				out.SetColorIndex(x, y, index[c])
+			} else {
+				out.SetColorIndex(x, y, uint8(colors.Index(c)))
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,133 @@
+package render
+
+import (
+	"bytes"
+	"image/color"
+	"image/gif"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
+)
+
+// recordSteps simulates a dot walking right along a 10-cell strip, one cell per step
+func recordSteps(r Recorder, steps int) int {
+	drawn := 0
+	for step := 0; step < steps && !r.Done(); step++ {
+		r.Record(func() *Canvas {
+			drawn++
+			c := NewCanvas(10, 1, 2)
+			c.Fill(vector.Vec2{X: step % 10, Y: 0}, red)
+			return c
+		})
+	}
+	return drawn
+}
+
+func TestGIFRecorderOptions(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   GIFOptions
+		steps     int
+		wantFrame int
+		wantDelay int
+	}{
+		{"defaults", GIFOptions{}, 7, 7, 10},
+		{"stride", GIFOptions{Stride: 3}, 7, 3, 10}, // Steps 0, 3 and 6
+		{"max frames", GIFOptions{MaxFrames: 4}, 7, 4, 10},
+		{"stride and max frames", GIFOptions{Stride: 2, MaxFrames: 2}, 7, 2, 10},
+		{"frame rate", GIFOptions{FPS: 25}, 3, 3, 4},
+		{"very high frame rate", GIFOptions{FPS: 1000}, 1, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewGIFRecorder(tt.options)
+			drawn := recordSteps(r, tt.steps)
+
+			if r.Frames() != tt.wantFrame || drawn != tt.wantFrame {
+				t.Errorf("Frames() = %d, drawn %d, want %d", r.Frames(), drawn, tt.wantFrame)
+			}
+
+			var buf bytes.Buffer
+			if err := r.Encode(&buf); err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			anim, err := gif.DecodeAll(&buf)
+			if err != nil {
+				t.Fatalf("decoding GIF: %v", err)
+			}
+			if len(anim.Image) != tt.wantFrame || anim.Delay[0] != tt.wantDelay {
+				t.Errorf("decoded %d frames with delay %d, want %d frames with delay %d",
+					len(anim.Image), anim.Delay[0], tt.wantFrame, tt.wantDelay)
+			}
+		})
+	}
+}
+
+func TestGIFRecorderDone(t *testing.T) {
+	tests := []struct {
+		name    string
+		options GIFOptions
+		steps   int
+		want    bool
+	}{
+		{"no limit", GIFOptions{}, 7, false},
+		{"steps fit exactly", GIFOptions{MaxFrames: 7}, 7, false},
+		{"one step too many", GIFOptions{MaxFrames: 7}, 8, true},
+		// Steps 0, 3 and 6 are kept; steps 7 and 8 would not be kept anyway
+		{"stride leaves nothing out", GIFOptions{Stride: 3, MaxFrames: 3}, 9, false},
+		{"stride drops step 9", GIFOptions{Stride: 3, MaxFrames: 3}, 10, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewGIFRecorder(tt.options)
+			recordSteps(r, tt.steps)
+			if got := r.Done(); got != tt.want {
+				t.Errorf("Done() = %v after %d steps, want %v", got, tt.steps, tt.want)
+			}
+		})
+	}
+}
+
+func TestGIFKeepsExactColors(t *testing.T) {
+	r := NewGIFRecorder(GIFOptions{})
+	r.Record(func() *Canvas {
+		c := NewCanvas(2, 1, 1)
+		c.Fill(vector.Vec2{X: 0, Y: 0}, color.RGBA{R: 12, G: 34, B: 56, A: 255})
+		return c
+	})
+
+	var buf bytes.Buffer
+	if err := r.Encode(&buf); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	anim, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("decoding GIF: %v", err)
+	}
+	if got := color.RGBAModel.Convert(anim.Image[0].At(0, 0)); got != (color.RGBA{R: 12, G: 34, B: 56, A: 255}) {
+		t.Errorf("pixel = %v, want {12 34 56 255}", got)
+	}
+}
+
+func TestPalettedFallback(t *testing.T) {
+	// 300 shades do not fit into a GIF palette
+	c := NewCanvas(300, 1, 1)
+	for x := 0; x < 300; x++ {
+		c.Fill(vector.Vec2{X: x, Y: 0}, color.RGBA{R: uint8(x), G: uint8(x / 256), A: 255})
+	}
+	img := paletted(c.Image())
+	if len(img.Palette) > 256 {
+		t.Errorf("palette has %d colors", len(img.Palette))
+	}
+	if got := color.RGBAModel.Convert(img.At(299, 0)).(color.RGBA); got.R < 0x20 || got.R > 0x40 {
+		t.Errorf("pixel 299 = %v, want a dark red close to {43 1 0}", got)
+	}
+}
+
+func TestDiscard(t *testing.T) {
+	if drawn := recordSteps(Discard, 5); drawn != 0 {
+		t.Errorf("Discard drew %d frames", drawn)
+	}
+}
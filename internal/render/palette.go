@@ -0,0 +1,69 @@
+package render
+
+import "image/color"
+
+// Palette maps cell values to colors
+// Values without an entry are drawn in Default, or black if Default is nil
+type Palette[T comparable] struct {
+	Colors  map[T]color.Color
+	Default color.Color
+}
+
+// Color returns the color for a cell value
+func (p Palette[T]) Color(value T) color.Color {
+	if c, ok := p.Colors[value]; ok {
+		return c
+	}
+	if p.Default != nil {
+		return p.Default
+	}
+	return color.Black
+}
+
+// Distinct returns a color for index i such that neighbouring indices look clearly different
+// Useful to tell regions or components apart when there are too many to list in a palette
+// The hue advances by the golden angle for every index, so colors never repeat exactly.
+func Distinct(i int) color.Color {
+	const goldenAngle = 137.50776405003785
+	hue := float64(i) * goldenAngle
+	hue -= 360 * float64(int(hue/360))
+	return hsv(hue, 0.55, 0.9)
+}
+
+// hsv converts a hue in degrees and saturation and value in [0, 1] to an opaque RGBA color
+func hsv(hue, saturation, value float64) color.RGBA {
+	chroma := value * saturation
+	sector := hue / 60
+	x := chroma * (1 - abs(sector-2*float64(int(sector/2))-1))
+
+	var r, g, b float64
+	switch int(sector) {
+	case 0:
+		r, g, b = chroma, x, 0
+	case 1:
+		r, g, b = x, chroma, 0
+	case 2:
+		r, g, b = 0, chroma, x
+	case 3:
+		r, g, b = 0, x, chroma
+	case 4:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+
+	m := value - chroma
+	return color.RGBA{
+		R: uint8((r + m) * 255),
+		G: uint8((g + m) * 255),
+		B: uint8((b + m) * 255),
+		A: 255,
+	}
+}
+
+func abs(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
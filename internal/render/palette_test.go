@@ -0,0 +1,35 @@
+package render
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestPaletteColor(t *testing.T) {
+	p := Palette[int]{Colors: map[int]color.Color{1: red}}
+	if got := p.Color(1); got != red {
+		t.Errorf("Color(1) = %v, want red", got)
+	}
+	if got := p.Color(2); got != color.Black {
+		t.Errorf("Color(2) without default = %v, want black", got)
+	}
+
+	p.Default = white
+	if got := p.Color(2); got != white {
+		t.Errorf("Color(2) with default = %v, want white", got)
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	seen := map[color.Color]int{}
+	for i := 0; i < 50; i++ {
+		c := Distinct(i)
+		if _, _, _, a := c.RGBA(); a != 0xffff {
+			t.Errorf("Distinct(%d) = %v is not opaque", i, c)
+		}
+		if j, ok := seen[c]; ok {
+			t.Errorf("Distinct(%d) = Distinct(%d) = %v", i, j, c)
+		}
+		seen[c] = i
+	}
+}
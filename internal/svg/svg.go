@@ -0,0 +1,188 @@
+package svg
+
+import (
+	"fmt"
+	"html"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
+)
+
+// Style describes how a shape is painted; colors are any SVG color such as "#ff8800" or "red"
+type Style struct {
+	Fill        string  // Fill color; not filled if empty, except labels which default to black
+	Stroke      string  // Outline color; no outline if empty
+	StrokeWidth float64 // Outline width in pixels; 1 if zero
+	Opacity     float64 // Between 0 and 1; zero means fully opaque
+}
+
+// Document is an SVG drawing of a width x height grid where every cell is CellSize pixels wide
+// Shapes are written in the order they were added, so later shapes are drawn on top.
+// Output is fully deterministic: the same calls always produce byte-identical files.
+type Document struct {
+	Width      int
+	Height     int
+	CellSize   int
+	Background string // Fill of the grid area; transparent if empty
+
+	elements []string
+	legend   []legendEntry
+}
+
+type legendEntry struct {
+	label string
+	style Style
+}
+
+const (
+	legendRow    = 18 // Height of one legend entry in pixels
+	legendSwatch = 12 // Side of the color sample in front of a legend entry
+)
+
+// New creates an empty document for a width x height grid
+func New(width, height, cellSize int) *Document {
+	return &Document{Width: width, Height: height, CellSize: max(cellSize, 1)}
+}
+
+// Cell draws a square over one grid cell
+func (d *Document) Cell(cell vector.Vec2, style Style) {
+	d.elements = append(d.elements, fmt.Sprintf(`<rect x="%d" y="%d" width="%d" height="%d"%s/>`,
+		cell.X*d.CellSize, cell.Y*d.CellSize, d.CellSize, d.CellSize, style.attributes()))
+}
+
+// Cells draws Cell for every given cell
+func (d *Document) Cells(cells []vector.Vec2, style Style) {
+	for _, cell := range cells {
+		d.Cell(cell, style)
+	}
+}
+
+// Circle draws a circle in the middle of a cell; radius is a fraction of the cell size
+func (d *Document) Circle(cell vector.Vec2, radius float64, style Style) {
+	cx, cy := d.center(cell)
+	d.elements = append(d.elements, fmt.Sprintf(`<circle cx="%s" cy="%s" r="%s"%s/>`,
+		number(cx), number(cy), number(radius*float64(d.CellSize)), style.attributes()))
+}
+
+// Polyline draws an open line through the centers of the given cells
+func (d *Document) Polyline(cells []vector.Vec2, style Style) {
+	points := make([]string, len(cells))
+	for i, cell := range cells {
+		x, y := d.center(cell)
+		points[i] = number(x) + "," + number(y)
+	}
+	d.elements = append(d.elements, fmt.Sprintf(`<polyline points="%s"%s/>`,
+		strings.Join(points, " "), style.attributes()))
+}
+
+// Polygon draws a closed shape through grid corners
+// Corner (x, y) is the top-left corner of cell (x, y), so the outline of cell (0, 0)
+// is the polygon (0, 0), (1, 0), (1, 1), (0, 1)
+func (d *Document) Polygon(corners []vector.Vec2, style Style) {
+	points := make([]string, len(corners))
+	for i, corner := range corners {
+		points[i] = strconv.Itoa(corner.X*d.CellSize) + "," + strconv.Itoa(corner.Y*d.CellSize)
+	}
+	d.elements = append(d.elements, fmt.Sprintf(`<polygon points="%s"%s/>`,
+		strings.Join(points, " "), style.attributes()))
+}
+
+// Label writes text centered in a cell; the style's Fill is the text color
+func (d *Document) Label(cell vector.Vec2, text string, style Style) {
+	x, y := d.center(cell)
+	if style.Fill == "" {
+		style.Fill = "black"
+	}
+	d.elements = append(d.elements, fmt.Sprintf(
+		`<text x="%s" y="%s" font-family="monospace" font-size="%s" text-anchor="middle" dominant-baseline="central"%s>%s</text>`,
+		number(x), number(y), number(0.7*float64(d.CellSize)), style.attributes(), html.EscapeString(text)))
+}
+
+// Legend adds an entry explaining a style to the legend below the grid
+func (d *Document) Legend(label string, style Style) {
+	d.legend = append(d.legend, legendEntry{label: label, style: style})
+}
+
+// String returns the SVG source of the document
+func (d *Document) String() string {
+	var sb strings.Builder
+	width := d.Width * d.CellSize
+	gridHeight := d.Height * d.CellSize
+	height := gridHeight
+	if len(d.legend) > 0 {
+		height += legendRow/2 + len(d.legend)*legendRow
+	}
+
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n",
+		width, height, width, height)
+	if d.Background != "" {
+		fmt.Fprintf(&sb, `  <rect x="0" y="0" width="%d" height="%d" fill="%s"/>`+"\n", width, gridHeight, d.Background)
+	}
+	for _, element := range d.elements {
+		sb.WriteString("  " + element + "\n")
+	}
+
+	for i, entry := range d.legend {
+		top := gridHeight + legendRow/2 + i*legendRow
+		swatch := entry.style
+		swatch.StrokeWidth = min(swatch.StrokeWidth, 2) // Thick lines would hide the swatch
+		if swatch.Fill == "" {
+			swatch.Fill = swatch.Stroke
+		}
+		fmt.Fprintf(&sb, `  <rect x="2" y="%d" width="%d" height="%d"%s/>`+"\n",
+			top+(legendRow-legendSwatch)/2, legendSwatch, legendSwatch, swatch.attributes())
+		fmt.Fprintf(&sb, `  <text x="%d" y="%d" font-family="sans-serif" font-size="12" dominant-baseline="central">%s</text>`+"\n",
+			legendSwatch+8, top+legendRow/2, html.EscapeString(entry.label))
+	}
+
+	sb.WriteString("</svg>\n")
+	return sb.String()
+}
+
+// WriteTo writes the SVG source of the document
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, d.String())
+	return int64(n), err
+}
+
+// Hex formats a color as #rrggbb, dropping any transparency
+func Hex(c color.Color) string {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return fmt.Sprintf("#%02x%02x%02x", n.R, n.G, n.B)
+}
+
+// center returns the pixel coordinates of the middle of a cell
+func (d *Document) center(cell vector.Vec2) (float64, float64) {
+	half := float64(d.CellSize) / 2
+	return float64(cell.X*d.CellSize) + half, float64(cell.Y*d.CellSize) + half
+}
+
+// attributes renders the style as SVG presentation attributes with a leading space
+func (s Style) attributes() string {
+	var sb strings.Builder
+	fill := s.Fill
+	if fill == "" {
+		fill = "none"
+	}
+	sb.WriteString(` fill="` + fill + `"`)
+	if s.Stroke != "" {
+		width := s.StrokeWidth
+		if width == 0 {
+			width = 1
+		}
+		sb.WriteString(` stroke="` + s.Stroke + `" stroke-width="` + number(width) + `" stroke-linejoin="round"`)
+	}
+	if s.Opacity > 0 && s.Opacity < 1 {
+		sb.WriteString(` opacity="` + number(s.Opacity) + `"`)
+	}
+	return sb.String()
+}
+
+// number formats a coordinate rounded to two decimals, so output does not depend on float noise
+func number(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
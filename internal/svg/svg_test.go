@@ -0,0 +1,70 @@
+package svg
+
+import (
+	"bytes"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/golden"
+	"github.com/amoilanen/advent-of-code-2024/internal/vector"
+)
+
+func TestDocument(t *testing.T) {
+	doc := New(4, 3, 10)
+	doc.Background = "#ffffff"
+	wall := Style{Fill: "#444444"}
+	path := Style{Stroke: "#dd2222", StrokeWidth: 2.5}
+	outline := Style{Stroke: "#2255cc", Opacity: 0.5}
+
+	doc.Cells([]vector.Vec2{{X: 0, Y: 0}, {X: 3, Y: 2}}, wall)
+	doc.Polyline([]vector.Vec2{{X: 0, Y: 1}, {X: 2, Y: 1}, {X: 2, Y: 2}}, path)
+	doc.Polygon([]vector.Vec2{{X: 1, Y: 0}, {X: 3, Y: 0}, {X: 3, Y: 1}, {X: 1, Y: 1}}, outline)
+	doc.Circle(vector.Vec2{X: 1, Y: 2}, 0.3, Style{Fill: "green"})
+	doc.Label(vector.Vec2{X: 3, Y: 0}, "<A>", Style{})
+	doc.Legend("wall", wall)
+	doc.Legend("path & more", path)
+
+	var buf bytes.Buffer
+	if _, err := doc.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+	if buf.String() != doc.String() {
+		t.Errorf("WriteTo() and String() differ")
+	}
+	golden.Compare(t, "testdata/document.svg", buf.Bytes())
+}
+
+func TestEmptyDocument(t *testing.T) {
+	got := New(2, 1, 5).String()
+	want := "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"10\" height=\"5\" viewBox=\"0 0 10 5\">\n</svg>\n"
+	if got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestLegendExtendsHeight(t *testing.T) {
+	doc := New(2, 2, 5)
+	doc.Legend("a", Style{Fill: "red"})
+	doc.Legend("b", Style{Fill: "blue"})
+	if !strings.Contains(doc.String(), `height="55"`) {
+		t.Errorf("legend of two entries should add 45 pixels:\n%s", doc)
+	}
+}
+
+func TestHex(t *testing.T) {
+	tests := []struct {
+		color color.Color
+		want  string
+	}{
+		{color.Black, "#000000"},
+		{color.RGBA{R: 255, G: 136, B: 1, A: 255}, "#ff8801"},
+		{color.NRGBA{R: 200, G: 100, B: 50, A: 10}, "#c86432"},
+	}
+
+	for _, tt := range tests {
+		if got := Hex(tt.color); got != tt.want {
+			t.Errorf("Hex(%v) = %s, want %s", tt.color, got, tt.want)
+		}
+	}
+}
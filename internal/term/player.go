@@ -0,0 +1,205 @@
+package term
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Simulation is a step-by-step process that can be shown as a text grid
+type Simulation interface {
+	// Step advances the simulation by one step and reports false once it has finished
+	Step() bool
+	// Frame returns the current state as lines of text
+	Frame() []string
+	// Status describes the current state in a single line, e.g. "second 42"
+	Status() string
+}
+
+// Seeker is a Simulation that can jump straight to a given step
+type Seeker interface {
+	Simulation
+	Seek(step int)
+}
+
+// Help lists the keyboard controls of the player
+const Help = "space play/pause  n step  +/- speed  <number>g jump  q quit"
+
+// Player shows a simulation on a Screen and reacts to keyboard controls
+//
+// Controls:
+//   - space toggles between playing and paused
+//   - n (or .) advances a single step while paused
+//   - + and - double or halve the speed
+//   - digits followed by g (or enter) jump to that step, for simulations that implement Seeker
+//   - q quits
+type Player struct {
+	sim      Simulation
+	screen   *Screen
+	fps      int
+	paused   bool
+	finished bool
+	quit     bool
+	number   string // Digits typed so far for a jump
+	message  string // Feedback on the last command, shown in the status line
+}
+
+// NewPlayer creates a paused player showing sim at fps frames per second
+func NewPlayer(sim Simulation, screen *Screen, fps int) *Player {
+	return &Player{sim: sim, screen: screen, fps: max(fps, 1), paused: true}
+}
+
+// Run draws the simulation and plays it until the user quits
+// keys is usually the terminal in raw mode. When keys runs out, the simulation plays to the end
+// on its own, so piping a script of keys or /dev/null also works.
+// Keys are read on a goroutine that stops with Run, but a read in progress cannot be interrupted:
+// the goroutine only returns once keys delivers one more key or fails, e.g. because the caller closed it.
+func (p *Player) Run(keys io.Reader) error {
+	pressed := make(chan byte, 1)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		buf := make([]byte, 1)
+		for {
+			n, err := keys.Read(buf)
+			if n == 1 {
+				select {
+				case pressed <- buf[0]:
+				case <-done:
+					return
+				}
+			} else if err != nil {
+				close(pressed)
+				return
+			}
+		}
+	}()
+
+	defer p.screen.Close()
+	if err := p.draw(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(p.interval())
+	defer ticker.Stop()
+	fps := p.fps
+
+	for !p.quit {
+		select {
+		case key, ok := <-pressed:
+			if !ok {
+				// Nobody is at the keyboard: play to the end and stop there
+				pressed = nil
+				p.paused = false
+				p.message = ""
+				continue
+			}
+			p.Press(key)
+		case <-ticker.C:
+			p.Tick()
+			if pressed == nil && p.finished {
+				p.quit = true
+			}
+		}
+
+		if p.fps != fps {
+			fps = p.fps
+			ticker.Reset(p.interval())
+		}
+		if err := p.draw(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Press handles one key
+func (p *Player) Press(key byte) {
+	p.message = ""
+	switch {
+	case key >= '0' && key <= '9':
+		p.number += string(key)
+		p.message = "jump to " + p.number
+		return
+	case key == 'g' || key == '\r' || key == '\n':
+		p.jump()
+	case key == ' ':
+		p.paused = !p.paused
+	case key == 'n' || key == '.':
+		p.paused = true
+		p.advance()
+	case key == '+':
+		p.fps = min(p.fps*2, 1000)
+	case key == '-':
+		p.fps = max(p.fps/2, 1)
+	case key == 'q' || key == 3: // 3 is ctrl-c, which raw mode delivers as a key
+		p.quit = true
+	}
+	p.number = ""
+}
+
+// Tick advances the simulation by one step unless it is paused or finished
+func (p *Player) Tick() {
+	if !p.paused {
+		p.advance()
+	}
+}
+
+// Finished reports whether the simulation has run to its end
+func (p *Player) Finished() bool {
+	return p.finished
+}
+
+func (p *Player) advance() {
+	if p.finished {
+		return
+	}
+	if !p.sim.Step() {
+		p.finished = true
+		p.paused = true
+	}
+}
+
+func (p *Player) jump() {
+	if p.number == "" {
+		return
+	}
+	seeker, ok := p.sim.(Seeker)
+	if !ok {
+		p.message = "this simulation cannot jump"
+		return
+	}
+	step, err := strconv.Atoi(p.number)
+	if err != nil {
+		p.message = "invalid step " + p.number
+		return
+	}
+	seeker.Seek(step)
+	p.finished = false
+	p.paused = true
+}
+
+func (p *Player) interval() time.Duration {
+	return time.Second / time.Duration(p.fps)
+}
+
+// status builds the line shown under the grid
+func (p *Player) status() string {
+	state := "playing"
+	switch {
+	case p.finished:
+		state = "finished"
+	case p.paused:
+		state = "paused"
+	}
+	line := fmt.Sprintf("%s | %s | %d fps | %s", p.sim.Status(), state, p.fps, Help)
+	if p.message != "" {
+		line += " | " + p.message
+	}
+	return line
+}
+
+func (p *Player) draw() error {
+	return p.screen.Draw(p.sim.Frame(), p.status())
+}
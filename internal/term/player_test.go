@@ -0,0 +1,168 @@
+package term
+
+import (
+	"io"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// counter counts up to limit, one per step
+type counter struct {
+	value int
+	limit int
+}
+
+func (c *counter) Step() bool {
+	if c.value >= c.limit {
+		return false
+	}
+	c.value++
+	return true
+}
+
+func (c *counter) Frame() []string { return []string{strconv.Itoa(c.value)} }
+func (c *counter) Status() string  { return "value " + strconv.Itoa(c.value) }
+
+// seekableCounter can jump to any value
+type seekableCounter struct{ counter }
+
+func (c *seekableCounter) Seek(step int) { c.value = step }
+
+func newTestPlayer(sim Simulation) *Player {
+	return NewPlayer(sim, NewScreen(io.Discard, nil), 10)
+}
+
+func TestPlayerControls(t *testing.T) {
+	sim := &counter{limit: 3}
+	p := newTestPlayer(sim)
+
+	// Starts paused
+	p.Tick()
+	if sim.value != 0 {
+		t.Fatalf("paused player stepped to %d", sim.value)
+	}
+
+	p.Press('n')
+	if sim.value != 1 {
+		t.Errorf("n stepped to %d, want 1", sim.value)
+	}
+
+	p.Press(' ')
+	p.Tick()
+	p.Tick()
+	if sim.value != 3 || p.Finished() {
+		t.Errorf("after playing value = %d, finished = %v, want 3, false", sim.value, p.Finished())
+	}
+	p.Tick()
+	if !p.Finished() || !strings.Contains(p.status(), "finished") {
+		t.Errorf("player should be finished, status %q", p.status())
+	}
+
+	p.Press('+')
+	p.Press('+')
+	if p.fps != 40 {
+		t.Errorf("fps after ++ = %d, want 40", p.fps)
+	}
+	p.Press('-')
+	if p.fps != 20 {
+		t.Errorf("fps after - = %d, want 20", p.fps)
+	}
+
+	p.Press('q')
+	if !p.quit {
+		t.Errorf("q should quit")
+	}
+}
+
+func TestPlayerJump(t *testing.T) {
+	sim := &seekableCounter{counter{limit: 100}}
+	p := newTestPlayer(sim)
+
+	for _, key := range []byte("42g") {
+		p.Press(key)
+	}
+	if sim.value != 42 {
+		t.Errorf("jumped to %d, want 42", sim.value)
+	}
+
+	// Jumping back after the end resumes the simulation
+	for _, key := range []byte("100\r") {
+		p.Press(key)
+	}
+	p.Press('n')
+	if !p.Finished() {
+		t.Fatalf("stepping past the limit should finish")
+	}
+	for _, key := range []byte("7g") {
+		p.Press(key)
+	}
+	if sim.value != 7 || p.Finished() {
+		t.Errorf("after jump value = %d, finished = %v, want 7, false", sim.value, p.Finished())
+	}
+
+	plain := newTestPlayer(&counter{limit: 5})
+	for _, key := range []byte("3g") {
+		plain.Press(key)
+	}
+	if !strings.Contains(plain.status(), "cannot jump") {
+		t.Errorf("status = %q, want a note that jumping is not supported", plain.status())
+	}
+}
+
+func TestPlayerRun(t *testing.T) {
+	tests := []struct {
+		name string
+		keys string
+		want int
+	}{
+		{"quit right away", "q", 0},
+		{"step twice and quit", "nnq", 2},
+		{"no keyboard plays to the end", "", 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sim := &counter{limit: 5}
+			var out strings.Builder
+			p := NewPlayer(sim, NewScreen(&out, nil), 1000)
+			if err := p.Run(strings.NewReader(tt.keys)); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if sim.value != tt.want {
+				t.Errorf("value after Run() = %d, want %d", sim.value, tt.want)
+			}
+			if !strings.HasSuffix(out.String(), showCursor) {
+				t.Errorf("Run() should restore the cursor")
+			}
+		})
+	}
+}
+
+// endlessKeys types q and then x forever, like a keyboard nobody lets go of
+type endlessKeys struct{ reads int }
+
+func (k *endlessKeys) Read(buf []byte) (int, error) {
+	buf[0] = 'x'
+	if k.reads == 0 {
+		buf[0] = 'q'
+	}
+	k.reads++
+	return 1, nil
+}
+
+func TestPlayerRunStopsReadingKeys(t *testing.T) {
+	before := runtime.NumGoroutine()
+	if err := newTestPlayer(&counter{limit: 5}).Run(&endlessKeys{}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	// The reader goroutine is left with a key nobody takes and has to notice that Run has returned
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines after Run(), want %d", runtime.NumGoroutine(), before)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package term
+
+// MakeRaw is not supported on this platform: keys arrive after enter is pressed
+func MakeRaw() (restore func(), err error) {
+	return func() {}, nil
+}
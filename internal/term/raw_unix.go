@@ -0,0 +1,31 @@
+//go:build unix
+
+package term
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// MakeRaw switches the terminal on stdin to raw mode, so single key presses arrive immediately
+// without echo, and returns a function that restores the previous settings
+// It uses stty, which keeps the module free of platform-specific syscall code.
+// When stdin is not a terminal nothing changes and the returned function does nothing.
+func MakeRaw() (restore func(), err error) {
+	saved, err := stty("-g")
+	if err != nil {
+		return func() {}, nil
+	}
+	if _, err := stty("raw", "-echo"); err != nil {
+		return func() {}, err
+	}
+	return func() { stty(strings.TrimSpace(saved)) }, nil
+}
+
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return string(out), err
+}
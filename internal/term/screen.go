@@ -0,0 +1,84 @@
+package term
+
+import (
+	"bufio"
+	"io"
+)
+
+// Style is the parameter list of an ANSI SGR escape sequence, such as "1;31" for bold red
+type Style string
+
+const (
+	Plain   Style = ""
+	Bold    Style = "1"
+	Red     Style = "31"
+	Green   Style = "32"
+	Yellow  Style = "33"
+	Blue    Style = "34"
+	Magenta Style = "35"
+	Cyan    Style = "36"
+	Gray    Style = "90"
+)
+
+// Palette maps cell runes to styles; runes without an entry are drawn plain
+type Palette map[rune]Style
+
+const (
+	clearScreen = "\x1b[2J"
+	cursorHome  = "\x1b[H"
+	clearLine   = "\x1b[K" // From the cursor to the end of the line
+	clearBelow  = "\x1b[J" // From the cursor to the end of the screen
+	hideCursor  = "\x1b[?25l"
+	showCursor  = "\x1b[?25h"
+	reset       = "\x1b[0m"
+)
+
+// Screen redraws a grid of runes in place on an ANSI terminal
+type Screen struct {
+	out     *bufio.Writer
+	palette Palette
+	started bool
+}
+
+// NewScreen creates a screen writing to out
+func NewScreen(out io.Writer, palette Palette) *Screen {
+	return &Screen{out: bufio.NewWriter(out), palette: palette}
+}
+
+// Draw replaces the previous frame with the given lines followed by a status line
+// The first frame clears the terminal; later frames overwrite it from the top left corner,
+// which avoids the flicker of clearing the screen on every frame.
+func (s *Screen) Draw(lines []string, status string) error {
+	if !s.started {
+		s.out.WriteString(hideCursor + clearScreen)
+		s.started = true
+	}
+	s.out.WriteString(cursorHome)
+
+	for _, line := range lines {
+		current := Plain
+		for _, r := range line {
+			if style := s.palette[r]; style != current {
+				s.out.WriteString(reset)
+				if style != Plain {
+					s.out.WriteString("\x1b[" + string(style) + "m")
+				}
+				current = style
+			}
+			s.out.WriteRune(r)
+		}
+		if current != Plain {
+			s.out.WriteString(reset)
+		}
+		s.out.WriteString(clearLine + "\r\n")
+	}
+	s.out.WriteString(status + clearLine + "\r\n" + clearBelow)
+
+	return s.out.Flush()
+}
+
+// Close shows the cursor again; the last frame stays on the terminal
+func (s *Screen) Close() error {
+	s.out.WriteString(reset + showCursor)
+	return s.out.Flush()
+}
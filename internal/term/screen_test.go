@@ -0,0 +1,35 @@
+package term
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestScreenDraw(t *testing.T) {
+	var buf bytes.Buffer
+	screen := NewScreen(&buf, Palette{'#': Gray, '@': Bold + ";" + Green})
+
+	if err := screen.Draw([]string{"#.@", ".."}, "step 1"); err != nil {
+		t.Fatalf("Draw() error = %v", err)
+	}
+	want := "\x1b[?25l\x1b[2J\x1b[H" +
+		"\x1b[0m\x1b[90m#\x1b[0m.\x1b[0m\x1b[1;32m@\x1b[0m\x1b[K\r\n" +
+		"..\x1b[K\r\n" +
+		"step 1\x1b[K\r\n\x1b[J"
+	if got := buf.String(); got != want {
+		t.Errorf("first Draw() = %q, want %q", got, want)
+	}
+
+	// Later frames only move the cursor home instead of clearing the screen
+	buf.Reset()
+	screen.Draw([]string{"."}, "step 2")
+	if got, want := buf.String(), "\x1b[H.\x1b[K\r\nstep 2\x1b[K\r\n\x1b[J"; got != want {
+		t.Errorf("second Draw() = %q, want %q", got, want)
+	}
+
+	buf.Reset()
+	screen.Close()
+	if got, want := buf.String(), "\x1b[0m\x1b[?25h"; got != want {
+		t.Errorf("Close() = %q, want %q", got, want)
+	}
+}
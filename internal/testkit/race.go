@@ -0,0 +1,6 @@
+//go:build race
+
+package testkit
+
+// raceEnabled is set when the race detector instruments the build, which makes every step allocate more
+const raceEnabled = true
@@ -0,0 +1,316 @@
+package testkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/answer"
+	"github.com/amoilanen/advent-of-code-2024/internal/heapstat"
+)
+
+// AnswersPath is where the verified answers for the real inputs are, relative to a day's package directory
+var AnswersPath = filepath.Join("..", "testdata", "answers.json")
+
+// Case is an input and the answer one part of the puzzle gives for it
+type Case struct {
+	Name  string
+	Input string
+	Part  int    // 1 or 2
+	Want  string // The answer as it would be typed into the puzzle page
+}
+
+// Malformed is an input that Parse has to reject with an error
+type Malformed struct {
+	Name  string
+	Input string
+}
+
+// Puzzle declares how a day is solved and what it is tested with; T is what Parse turns the input into
+// Examples that need other parameters than the real input, such as a smaller grid, stay ordinary tests.
+type Puzzle[T any] struct {
+	Day       int    // Picks the verified answers of the real input from AnswersPath
+	Input     string // The real input
+	Parse     func(input string) (T, error)
+	Part1     func(T) answer.Answer
+	Part2     func(T) answer.Answer
+	Cases     []Case
+	Malformed []Malformed
+	Seeds     []string // More inputs to start fuzzing from, besides those of Cases and Malformed
+	Budgets   Budgets  // Memory parsing and solving the real input may use
+}
+
+// Int adapts a part that returns an int to a Puzzle part
+func Int[T any](part func(T) int) func(T) answer.Answer {
+	return func(parsed T) answer.Answer {
+		return answer.FromInt(part(parsed))
+	}
+}
+
+// Text adapts a part that returns text, such as a comma-joined list, to a Puzzle part
+func Text[T any](part func(T) string) func(T) answer.Answer {
+	return func(parsed T) answer.Answer {
+		return answer.FromString(part(parsed))
+	}
+}
+
+// part returns the solution of part 1 or 2
+func (p Puzzle[T]) part(n int) func(T) answer.Answer {
+	if n == 1 {
+		return p.Part1
+	}
+	return p.Part2
+}
+
+// Budget caps the memory one run of a step on the real input may use
+// A zero budget is not checked; otherwise every limit is, so a step that must not allocate has no Allocs
+// and Bytes but some PeakBytes for the few hundred bytes measuring takes itself. Set the limits with some
+// headroom above what Test logs with -v, so that only real regressions fail.
+// Budgets only hold for builds without instrumentation: the race detector allocates on its own,
+// so Test skips them with -race.
+type Budget struct {
+	Allocs    float64 // Heap allocations, as testing.AllocsPerRun counts them
+	Bytes     uint64  // Bytes allocated in total, as runtime.MemStats counts them
+	PeakBytes uint64  // How far the heap grows above where it started at its peak
+}
+
+// Budgets holds the budget of each step
+type Budgets struct {
+	Parse, Part1, Part2 Budget
+}
+
+// Usage is the memory one run of a step used
+type Usage struct {
+	Allocs    float64
+	Bytes     uint64
+	PeakBytes uint64
+}
+
+// String writes the usage like a Budget literal, to paste into a day's budgets
+func (u Usage) String() string {
+	return fmt.Sprintf("{Allocs: %.0f, Bytes: %d, PeakBytes: %d}", u.Allocs, u.Bytes, u.PeakBytes)
+}
+
+// Measure runs f three times: twice for testing.AllocsPerRun and once to read the bytes and the peak heap
+func Measure(f func()) Usage {
+	usage := Usage{Allocs: testing.AllocsPerRun(1, f)}
+
+	var stats runtime.MemStats
+	peak := heapstat.Watch(func() {
+		runtime.ReadMemStats(&stats)
+		before := stats.TotalAlloc
+		f()
+		runtime.ReadMemStats(&stats)
+		usage.Bytes = stats.TotalAlloc - before
+	}, time.Millisecond)
+	usage.PeakBytes = peak.Bytes()
+	return usage
+}
+
+// Exceeded describes every limit of the budget that usage goes over
+func (b Budget) Exceeded(usage Usage) []string {
+	var over []string
+	if usage.Allocs > b.Allocs {
+		over = append(over, fmt.Sprintf("%.0f allocations, budget %.0f", usage.Allocs, b.Allocs))
+	}
+	if usage.Bytes > b.Bytes {
+		over = append(over, fmt.Sprintf("%d bytes allocated, budget %d", usage.Bytes, b.Bytes))
+	}
+	if usage.PeakBytes > b.PeakBytes {
+		over = append(over, fmt.Sprintf("heap peaked %d bytes higher, budget %d", usage.PeakBytes, b.PeakBytes))
+	}
+	return over
+}
+
+// Test runs every case and malformed input as a subtest, then checks both parts and the budgets on the real input
+// The real input is skipped with -short, like the answers check of the days package.
+func (p Puzzle[T]) Test(t *testing.T) {
+	t.Helper()
+
+	for part := 1; part <= 2; part++ {
+		t.Run(fmt.Sprintf("Part%d", part), func(t *testing.T) {
+			for _, c := range p.Cases {
+				if c.Part != part {
+					continue
+				}
+				t.Run(c.Name, func(t *testing.T) {
+					parsed, err := p.Parse(c.Input)
+					if err != nil {
+						t.Fatalf("Parse(%q) error = %v", c.Input, err)
+					}
+					if got := p.part(part)(parsed); got.String() != c.Want {
+						t.Errorf("Part%d() = %v, want %s", part, got, c.Want)
+					}
+				})
+			}
+		})
+	}
+
+	t.Run("Malformed", func(t *testing.T) {
+		for _, m := range p.Malformed {
+			t.Run(m.Name, func(t *testing.T) {
+				if _, err := p.Parse(m.Input); err == nil {
+					t.Errorf("Parse(%q) error = nil, want an error", m.Input)
+				}
+			})
+		}
+	})
+
+	t.Run("RealInput", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("solving the real input can take a few seconds")
+		}
+		want, err := loadAnswers(p.Day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed, err := p.Parse(p.Input)
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		for part := 1; part <= 2; part++ {
+			if got := p.part(part)(parsed); !got.Equal(want[part-1]) {
+				t.Errorf("Part%d() = %v, want %v", part, got, want[part-1])
+			}
+		}
+	})
+
+	t.Run("Budgets", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("measuring runs every step on the real input three times")
+		}
+		if raceEnabled {
+			t.Skip("the race detector's instrumentation allocates more than the budgets allow")
+		}
+		p.testBudgets(t)
+	})
+}
+
+// testBudgets measures every step on the real input that has a budget and fails the ones over it
+func (p Puzzle[T]) testBudgets(t *testing.T) {
+	parsed, err := p.Parse(p.Input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	steps := []struct {
+		name   string
+		budget Budget
+		run    func()
+	}{
+		{"Parse", p.Budgets.Parse, func() { p.Parse(p.Input) }},
+		{"Part1", p.Budgets.Part1, func() { p.Part1(parsed) }},
+		{"Part2", p.Budgets.Part2, func() { p.Part2(parsed) }},
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			if step.budget == (Budget{}) {
+				t.Skip("no budget")
+			}
+			usage := Measure(step.run)
+			t.Logf("usage %v", usage)
+			for _, over := range step.budget.Exceeded(usage) {
+				t.Error(over)
+			}
+		})
+	}
+}
+
+// loadAnswers reads the verified answers of a day's real input
+func loadAnswers(day int) ([2]answer.Answer, error) {
+	data, err := os.ReadFile(AnswersPath)
+	if err != nil {
+		return [2]answer.Answer{}, fmt.Errorf("reading answers: %w", err)
+	}
+	answers := map[string][2]answer.Answer{}
+	if err := json.Unmarshal(data, &answers); err != nil {
+		return [2]answer.Answer{}, fmt.Errorf("decoding answers: %w", err)
+	}
+	name := fmt.Sprintf("day%02d", day)
+	want, ok := answers[name]
+	if !ok {
+		return [2]answer.Answer{}, fmt.Errorf("no verified answers for %s in %s", name, AnswersPath)
+	}
+	return want, nil
+}
+
+// Benchmark measures Parse, Part1 and Part2 on the real input as sub-benchmarks
+// The parts are timed on input parsed once up front, so they exclude parsing.
+func (p Puzzle[T]) Benchmark(b *testing.B) {
+	b.Run("Parse", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			p.Parse(p.Input)
+		}
+	})
+
+	parsed, err := p.Parse(p.Input)
+	if err != nil {
+		b.Fatalf("Parse() error = %v", err)
+	}
+	for part := 1; part <= 2; part++ {
+		b.Run(fmt.Sprintf("Part%d", part), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				p.part(part)(parsed)
+			}
+		})
+	}
+}
+
+// Fuzz seeds f with the seeds and the inputs of every case and malformed input and fuzzes Parse with it
+// Parse must never panic; check, if not nil, gets every input Parse accepts, e.g. to test a round trip.
+func (p Puzzle[T]) Fuzz(f *testing.F, check func(t *testing.T, parsed T)) {
+	seeds := []string{""}
+	for _, c := range p.Cases {
+		seeds = append(seeds, c.Input)
+	}
+	for _, m := range p.Malformed {
+		seeds = append(seeds, m.Input)
+	}
+	seen := make(map[string]bool)
+	for _, seed := range append(seeds, p.Seeds...) {
+		if !seen[seed] {
+			seen[seed] = true
+			f.Add(seed)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		parsed, err := p.Parse(input)
+		if err != nil || check == nil {
+			return
+		}
+		check(t, parsed)
+	})
+}
+
+// RoundTrip returns a Fuzz check that parsing what format writes gives back the same value
+func (p Puzzle[T]) RoundTrip(format func(T) string) func(t *testing.T, parsed T) {
+	return func(t *testing.T, parsed T) {
+		t.Helper()
+		written := format(parsed)
+		again, err := p.Parse(written)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", written, err)
+		}
+		if !reflect.DeepEqual(again, parsed) {
+			t.Errorf("Parse(%q) = %v, want %v", written, again, parsed)
+		}
+	}
+}
+
+// Joined returns a format for RoundTrip that writes each item with String and joins them with sep
+func Joined[E fmt.Stringer](sep string) func(items []E) string {
+	return func(items []E) string {
+		written := make([]string, len(items))
+		for i, item := range items {
+			written[i] = item.String()
+		}
+		return strings.Join(written, sep)
+	}
+}
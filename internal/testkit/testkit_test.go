@@ -0,0 +1,164 @@
+package testkit
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/answer"
+)
+
+// numbers is a toy day: the input is a line of numbers, part 1 sums and part 2 multiplies them
+type numbers []int
+
+func (n numbers) String() string {
+	fields := make([]string, len(n))
+	for i, value := range n {
+		fields[i] = strconv.Itoa(value)
+	}
+	return strings.Join(fields, " ")
+}
+
+func parseNumbers(input string) (numbers, error) {
+	var parsed numbers
+	for _, field := range strings.Fields(input) {
+		value, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, value)
+	}
+	return parsed, nil
+}
+
+func sum(n numbers) int {
+	total := 0
+	for _, value := range n {
+		total += value
+	}
+	return total
+}
+
+func product(n numbers) int {
+	total := 1
+	for _, value := range n {
+		total *= value
+	}
+	return total
+}
+
+var toy = Puzzle[numbers]{
+	Day:   99,
+	Input: "1 2 3 4",
+	Parse: parseNumbers,
+	Part1: Int(sum),
+	Part2: Int(product),
+	Cases: []Case{
+		{Name: "example", Input: "1 2 3", Part: 1, Want: "6"},
+		{Name: "example", Input: "1 2 3", Part: 2, Want: "6"},
+		{Name: "empty", Input: "", Part: 2, Want: "1"},
+	},
+	Malformed: []Malformed{
+		{Name: "word", Input: "1 two 3"},
+	},
+	Budgets: Budgets{
+		Parse: Budget{Allocs: 10, Bytes: 1 << 10, PeakBytes: 16 << 10},
+		Part1: Budget{PeakBytes: 16 << 10}, // Does not allocate
+	},
+}
+
+// useAnswers points AnswersPath at a file with the given content for the rest of the test
+func useAnswers(t *testing.T, content string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "answers.json")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	saved := AnswersPath
+	AnswersPath = path
+	t.Cleanup(func() { AnswersPath = saved })
+}
+
+func TestPuzzle(t *testing.T) {
+	useAnswers(t, `{"day99": [10, 24]}`)
+	toy.Test(t)
+}
+
+func TestLoadAnswers(t *testing.T) {
+	useAnswers(t, `{"day99": [10, "big"]}`)
+
+	got, err := loadAnswers(99)
+	if err != nil {
+		t.Fatalf("loadAnswers(99) error = %v", err)
+	}
+	if want := [2]answer.Answer{answer.FromInt(10), answer.FromString("big")}; !got[0].Equal(want[0]) || !got[1].Equal(want[1]) {
+		t.Errorf("loadAnswers(99) = %v, want %v", got, want)
+	}
+
+	if _, err := loadAnswers(98); err == nil {
+		t.Error("loadAnswers(98) error = nil for a day without answers")
+	}
+}
+
+func TestText(t *testing.T) {
+	part := Text(numbers.String)
+	if got := part(numbers{1, 2}); !got.Equal(answer.FromString("1 2")) {
+		t.Errorf("Text() = %#v, want %#v", got, answer.FromString("1 2"))
+	}
+}
+
+func TestJoined(t *testing.T) {
+	format := Joined[numbers]("\n")
+	if got, want := format([]numbers{{1, 2}, {3}}), "1 2\n3"; got != want {
+		t.Errorf("Joined() = %q, want %q", got, want)
+	}
+}
+
+// sink keeps what TestMeasure allocates on the heap
+var sink []byte
+
+func TestMeasure(t *testing.T) {
+	usage := Measure(func() { sink = make([]byte, 1<<20) })
+	if usage.Allocs != 1 {
+		t.Errorf("Measure() Allocs = %v, want 1", usage.Allocs)
+	}
+	if usage.Bytes < 1<<20 || usage.Bytes > 2<<20 {
+		t.Errorf("Measure() Bytes = %d, want about %d", usage.Bytes, 1<<20)
+	}
+	if usage.PeakBytes < 1<<20 {
+		t.Errorf("Measure() PeakBytes = %d, want at least %d", usage.PeakBytes, 1<<20)
+	}
+}
+
+func TestBudgetExceeded(t *testing.T) {
+	budget := Budget{Allocs: 10, Bytes: 100, PeakBytes: 1000}
+	tests := []struct {
+		name  string
+		usage Usage
+		want  int
+	}{
+		{"within", Usage{Allocs: 10, Bytes: 100, PeakBytes: 1000}, 0},
+		{"allocs", Usage{Allocs: 11, Bytes: 100, PeakBytes: 1000}, 1},
+		{"everything", Usage{Allocs: 11, Bytes: 101, PeakBytes: 1001}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := budget.Exceeded(tt.usage); len(got) != tt.want {
+				t.Errorf("Exceeded(%v) = %q, want %d limits", tt.usage, got, tt.want)
+			}
+		})
+	}
+}
+
+// FuzzParse runs the toy day through Fuzz, which the seeds alone already exercise in a plain go test
+func FuzzParse(f *testing.F) {
+	toy.Fuzz(f, toy.RoundTrip(numbers.String))
+}
+
+// BenchmarkPuzzle runs the toy day through Benchmark
+func BenchmarkPuzzle(b *testing.B) {
+	toy.Benchmark(b)
+}
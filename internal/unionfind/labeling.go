@@ -0,0 +1,71 @@
+package unionfind
+
+// Labeling assigns every cell of a grid to a connected component
+// Component IDs are dense, 0..Count()-1, numbered in row-major order of the
+// first cell of each component
+type Labeling struct {
+	Rows   int
+	Cols   int
+	Labels []int // Component ID per cell, row-major
+	Sizes  []int // Number of cells per component ID
+}
+
+// At returns the component ID of the cell at (row, col)
+func (l *Labeling) At(row, col int) int {
+	return l.Labels[row*l.Cols+col]
+}
+
+// Count returns the number of components
+func (l *Labeling) Count() int {
+	return len(l.Sizes)
+}
+
+// LabelGrid labels the 4-connected components of a rows x cols grid
+// Two orthogonally adjacent cells belong to the same component when same returns
+// true for them; the predicate is expected to be symmetric
+//
+// Algorithm (two-pass labeling):
+// 1. Scan cells in row-major order, uniting each cell with its left and upper
+// neighbors when same holds
+// 2. Scan again and replace each set representative with a dense component ID
+//
+// Time complexity: O(rows * cols * α(rows * cols))
+// Space complexity: O(rows * cols)
+func LabelGrid(rows, cols int, same func(row1, col1, row2, col2 int) bool) *Labeling {
+	uf := New(rows * cols)
+
+	// First pass: merge equivalent neighbors
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if c > 0 && same(r, c-1, r, c) {
+				uf.Union(r*cols+c-1, r*cols+c)
+			}
+			if r > 0 && same(r-1, c, r, c) {
+				uf.Union((r-1)*cols+c, r*cols+c)
+			}
+		}
+	}
+
+	// Second pass: assign dense IDs in order of first appearance
+	labeling := &Labeling{
+		Rows:   rows,
+		Cols:   cols,
+		Labels: make([]int, rows*cols),
+		Sizes:  make([]int, 0, uf.Count()),
+	}
+	idByRoot := make([]int, rows*cols)
+	for i := range idByRoot {
+		idByRoot[i] = -1
+	}
+
+	for i := range labeling.Labels {
+		root := uf.Find(i)
+		if idByRoot[root] == -1 {
+			idByRoot[root] = len(labeling.Sizes)
+			labeling.Sizes = append(labeling.Sizes, uf.Size(root))
+		}
+		labeling.Labels[i] = idByRoot[root]
+	}
+
+	return labeling
+}
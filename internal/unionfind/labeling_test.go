@@ -0,0 +1,73 @@
+package unionfind
+
+import (
+	"reflect"
+	"testing"
+)
+
+// sameRune returns an equivalence predicate for cells holding equal characters
+func sameRune(lines []string) func(r1, c1, r2, c2 int) bool {
+	return func(r1, c1, r2, c2 int) bool {
+		return lines[r1][c1] == lines[r2][c2]
+	}
+}
+
+func TestLabelGrid(t *testing.T) {
+	lines := []string{
+		"AAAA",
+		"BBCD",
+		"BBCC",
+		"EEEC",
+	}
+	labeling := LabelGrid(4, 4, sameRune(lines))
+
+	wantLabels := []int{
+		0, 0, 0, 0,
+		1, 1, 2, 3,
+		1, 1, 2, 2,
+		4, 4, 4, 2,
+	}
+	if !reflect.DeepEqual(labeling.Labels, wantLabels) {
+		t.Errorf("Labels = %v, want %v", labeling.Labels, wantLabels)
+	}
+
+	wantSizes := []int{4, 4, 4, 1, 3}
+	if !reflect.DeepEqual(labeling.Sizes, wantSizes) {
+		t.Errorf("Sizes = %v, want %v", labeling.Sizes, wantSizes)
+	}
+
+	if labeling.Count() != 5 || labeling.At(3, 3) != 2 {
+		t.Errorf("Count() = %d, At(3, 3) = %d; want 5, 2", labeling.Count(), labeling.At(3, 3))
+	}
+}
+
+func TestLabelGridSeparatesDisconnectedRegions(t *testing.T) {
+	// Same plant type in two places that only touch diagonally
+	lines := []string{
+		"OXO",
+		"XOX",
+	}
+	labeling := LabelGrid(2, 3, sameRune(lines))
+
+	if labeling.Count() != 6 {
+		t.Errorf("Count() = %d, want 6 single-cell components", labeling.Count())
+	}
+}
+
+func TestLabelGridCustomPredicate(t *testing.T) {
+	// Heights connect when they differ by at most one
+	heights := [][]int{
+		{1, 2, 9},
+		{5, 3, 8},
+		{5, 6, 7},
+	}
+	labeling := LabelGrid(3, 3, func(r1, c1, r2, c2 int) bool {
+		diff := heights[r1][c1] - heights[r2][c2]
+		return diff >= -1 && diff <= 1
+	})
+
+	// {1,2,3}, {9,8,7,6,5,5}
+	if want := []int{3, 6}; !reflect.DeepEqual(labeling.Sizes, want) {
+		t.Errorf("Sizes = %v, want %v", labeling.Sizes, want)
+	}
+}
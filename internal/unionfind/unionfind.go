@@ -0,0 +1,85 @@
+package unionfind
+
+// UnionFind is a disjoint-set forest over the elements 0..n-1
+// Uses path compression and union by rank, so every operation runs in
+// amortized O(α(n)) time, which is effectively constant
+type UnionFind struct {
+	parent []int
+	rank   []int
+	size   []int
+	count  int
+}
+
+// New creates a UnionFind with n singleton sets
+func New(n int) *UnionFind {
+	uf := &UnionFind{
+		parent: make([]int, n),
+		rank:   make([]int, n),
+		size:   make([]int, n),
+		count:  n,
+	}
+	for i := range uf.parent {
+		uf.parent[i] = i
+		uf.size[i] = 1
+	}
+	return uf
+}
+
+// Find returns the representative element of the set containing x
+func (uf *UnionFind) Find(x int) int {
+	root := x
+	for uf.parent[root] != root {
+		root = uf.parent[root]
+	}
+
+	// Path compression: point every node on the way directly at the root
+	for uf.parent[x] != root {
+		next := uf.parent[x]
+		uf.parent[x] = root
+		x = next
+	}
+
+	return root
+}
+
+// Union merges the sets containing a and b
+// Returns false if they were already in the same set
+func (uf *UnionFind) Union(a, b int) bool {
+	rootA, rootB := uf.Find(a), uf.Find(b)
+	if rootA == rootB {
+		return false
+	}
+
+	// Union by rank: attach the shallower tree under the deeper one
+	if uf.rank[rootA] < uf.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	uf.parent[rootB] = rootA
+	uf.size[rootA] += uf.size[rootB]
+	if uf.rank[rootA] == uf.rank[rootB] {
+		uf.rank[rootA]++
+	}
+
+	uf.count--
+	return true
+}
+
+// Connected reports whether a and b are in the same set
+func (uf *UnionFind) Connected(a, b int) bool {
+	return uf.Find(a) == uf.Find(b)
+}
+
+// Size returns the number of elements in the set containing x
+func (uf *UnionFind) Size(x int) int {
+	return uf.size[uf.Find(x)]
+}
+
+// Count returns the number of disjoint sets
+func (uf *UnionFind) Count() int {
+	return uf.count
+}
+
+// Len returns the number of elements
+func (uf *UnionFind) Len() int {
+	return len(uf.parent)
+}
@@ -0,0 +1,54 @@
+package unionfind
+
+import "testing"
+
+func TestUnionFind(t *testing.T) {
+	uf := New(6)
+
+	if uf.Count() != 6 {
+		t.Fatalf("Count() = %d, want 6", uf.Count())
+	}
+
+	if !uf.Union(0, 1) || !uf.Union(1, 2) || !uf.Union(3, 4) {
+		t.Fatal("Union() of disjoint sets returned false")
+	}
+	if uf.Union(0, 2) {
+		t.Error("Union(0, 2) = true for elements already in the same set")
+	}
+
+	tests := []struct {
+		a, b      int
+		connected bool
+	}{
+		{0, 2, true},
+		{2, 0, true},
+		{3, 4, true},
+		{0, 3, false},
+		{5, 5, true},
+		{4, 5, false},
+	}
+	for _, tt := range tests {
+		if got := uf.Connected(tt.a, tt.b); got != tt.connected {
+			t.Errorf("Connected(%d, %d) = %v, want %v", tt.a, tt.b, got, tt.connected)
+		}
+	}
+
+	if uf.Count() != 3 {
+		t.Errorf("Count() = %d, want 3", uf.Count())
+	}
+	if uf.Size(1) != 3 || uf.Size(4) != 2 || uf.Size(5) != 1 {
+		t.Errorf("Size() = %d, %d, %d; want 3, 2, 1", uf.Size(1), uf.Size(4), uf.Size(5))
+	}
+}
+
+func TestUnionFindLongChain(t *testing.T) {
+	const n = 100000
+	uf := New(n)
+	for i := 1; i < n; i++ {
+		uf.Union(i-1, i)
+	}
+
+	if uf.Count() != 1 || uf.Size(0) != n || !uf.Connected(0, n-1) {
+		t.Errorf("chain of %d elements: Count() = %d, Size(0) = %d", n, uf.Count(), uf.Size(0))
+	}
+}
@@ -32,6 +32,15 @@ func GCD(a, b int) int {
 	return a
 }
 
+// ExtendedGCD returns the greatest common divisor g of a and b together with x and y such that a*x + b*y = g
+func ExtendedGCD(a, b int) (g, x, y int) {
+	if b == 0 {
+		return a, 1, 0
+	}
+	g, x1, y1 := ExtendedGCD(b, a%b)
+	return g, y1, x1 - (a/b)*y1
+}
+
 // LCM returns the least common multiple
 func LCM(a, b int) int {
 	return a * b / GCD(a, b)
@@ -0,0 +1,25 @@
+package utils
+
+import "testing"
+
+func TestExtendedGCD(t *testing.T) {
+	tests := []struct {
+		a, b  int
+		wantG int
+	}{
+		{240, 46, 2},
+		{46, 240, 2},
+		{17, 5, 1},
+		{12, 0, 12},
+		{0, 7, 7},
+		{94, 22, 2},
+	}
+
+	for _, tt := range tests {
+		g, x, y := ExtendedGCD(tt.a, tt.b)
+		if g != tt.wantG || tt.a*x+tt.b*y != g {
+			t.Errorf("ExtendedGCD(%d, %d) = %d, %d, %d, want gcd %d with %d*x + %d*y = gcd",
+				tt.a, tt.b, g, x, y, tt.wantG, tt.a, tt.b)
+		}
+	}
+}
@@ -1,10 +1,17 @@
 package utils
 
 import (
+	"bufio"
+	"fmt"
+	"io"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
+// MaxLineLength is the longest line ScanLines accepts
+const MaxLineLength = 1 << 20
+
 // AsLines splits input into lines and trims whitespace
 func AsLines(input string) []string {
 	lines := strings.Split(strings.TrimSpace(input), "\n")
@@ -15,6 +22,37 @@ func AsLines(input string) []string {
 	return result
 }
 
+// AsGrid splits input into lines like AsLines and checks that they form a rectangle of ASCII characters
+// Grid puzzles index rows by byte, so ragged lines or multi-byte runes would otherwise cause out of range panics
+func AsGrid(input string) ([]string, error) {
+	lines := AsLines(input)
+	for y, line := range lines {
+		if len(line) != len(lines[0]) {
+			return nil, fmt.Errorf("line %d has %d cells, want %d like the first line", y+1, len(line), len(lines[0]))
+		}
+		for x := 0; x < len(line); x++ {
+			if line[x] >= utf8.RuneSelf {
+				return nil, fmt.Errorf("line %d, column %d: grid cells must be ASCII", y+1, x+1)
+			}
+		}
+	}
+	return lines, nil
+}
+
+// ScanLines calls fn with every line of r, trimmed like AsLines
+// Only the current line is held in memory, so the input can be much larger than the available RAM.
+// Scanning stops at the first error returned by fn, which is then returned.
+func ScanLines(r io.Reader, fn func(line string) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, MaxLineLength)
+	for scanner.Scan() {
+		if err := fn(strings.TrimSpace(scanner.Text())); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
 // ParseInts parses space-separated integers from a string
 func ParseInts(input string) ([]int, error) {
 	fields := strings.Fields(input)
@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -36,6 +38,84 @@ func TestAsLines(t *testing.T) {
 	}
 }
 
+func TestAsGrid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{"rectangle", "ab\ncd\n", []string{"ab", "cd"}, false},
+		{"windows line endings", "ab\r\ncd", []string{"ab", "cd"}, false},
+		{"empty", "", []string{""}, false},
+		{"ragged", "abc\nd", nil, true},
+		{"non-ascii", "é\nbc", nil, true}, // Two bytes, like "bc"
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AsGrid(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AsGrid() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AsGrid() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{"simple lines", "line1\nline2\nline3", []string{"line1", "line2", "line3"}},
+		{"trailing newline", "line1\nline2\n", []string{"line1", "line2"}},
+		{"windows line endings", "line1\r\nline2\r\n", []string{"line1", "line2"}},
+		{"whitespace and empty lines", "  line1  \n\n  line2", []string{"line1", "", "line2"}},
+		{"empty input", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			err := ScanLines(strings.NewReader(tt.input), func(line string) error {
+				got = append(got, line)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("ScanLines() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScanLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanLinesStopsAtError(t *testing.T) {
+	stop := errors.New("stop")
+	calls := 0
+	err := ScanLines(strings.NewReader("a\nb\nc"), func(line string) error {
+		calls++
+		if line == "b" {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Errorf("ScanLines() error = %v, want %v", err, stop)
+	}
+	if calls != 2 {
+		t.Errorf("ScanLines() called fn %d times, want 2", calls)
+	}
+
+	long := strings.Repeat("x", MaxLineLength+1)
+	if err := ScanLines(strings.NewReader(long), func(string) error { return nil }); err == nil {
+		t.Error("ScanLines() error = nil for a line longer than MaxLineLength")
+	}
+}
+
 func TestParseInts(t *testing.T) {
 	tests := []struct {
 		name    string
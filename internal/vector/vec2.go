@@ -0,0 +1,107 @@
+package vector
+
+import (
+	"cmp"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/utils"
+)
+
+// Vec2 is a 2D integer vector, used both for positions and for movements
+// On grids X is the column and Y is the row, so Y grows downwards
+type Vec2 struct {
+	X int
+	Y int
+}
+
+// The four orthogonal unit steps on a grid where Y grows downwards
+var (
+	Up    = Vec2{X: 0, Y: -1}
+	Right = Vec2{X: 1, Y: 0}
+	Down  = Vec2{X: 0, Y: 1}
+	Left  = Vec2{X: -1, Y: 0}
+)
+
+// Directions4 lists the orthogonal unit steps clockwise, starting with Up
+var Directions4 = []Vec2{Up, Right, Down, Left}
+
+// Directions8 lists the orthogonal and diagonal unit steps clockwise, starting with Up
+var Directions8 = []Vec2{
+	Up, Up.Add(Right), Right, Down.Add(Right),
+	Down, Down.Add(Left), Left, Up.Add(Left),
+}
+
+// Add returns v + other
+func (v Vec2) Add(other Vec2) Vec2 {
+	return Vec2{X: v.X + other.X, Y: v.Y + other.Y}
+}
+
+// Sub returns v - other
+func (v Vec2) Sub(other Vec2) Vec2 {
+	return Vec2{X: v.X - other.X, Y: v.Y - other.Y}
+}
+
+// Scale returns v multiplied by the scalar k
+func (v Vec2) Scale(k int) Vec2 {
+	return Vec2{X: v.X * k, Y: v.Y * k}
+}
+
+// Neg returns -v
+func (v Vec2) Neg() Vec2 {
+	return Vec2{X: -v.X, Y: -v.Y}
+}
+
+// Mod wraps v component-wise into [0, size.X) x [0, size.Y)
+// Uses Euclidean modulo, so negative components wrap around to the far side
+func (v Vec2) Mod(size Vec2) Vec2 {
+	return Vec2{X: Mod(v.X, size.X), Y: Mod(v.Y, size.Y)}
+}
+
+// Manhattan returns the Manhattan (taxicab) distance between v and other
+func (v Vec2) Manhattan(other Vec2) int {
+	return utils.Abs(v.X-other.X) + utils.Abs(v.Y-other.Y)
+}
+
+// RotateRight rotates v by 90 degrees clockwise on a grid where Y grows downwards
+// Up becomes Right, Right becomes Down, and so on
+func (v Vec2) RotateRight() Vec2 {
+	return Vec2{X: -v.Y, Y: v.X}
+}
+
+// RotateLeft rotates v by 90 degrees counter-clockwise on a grid where Y grows downwards
+// Up becomes Left, Left becomes Down, and so on
+func (v Vec2) RotateLeft() Vec2 {
+	return Vec2{X: v.Y, Y: -v.X}
+}
+
+// InBounds checks if v lies within [0, width) x [0, height)
+func (v Vec2) InBounds(width, height int) bool {
+	return v.X >= 0 && v.X < width && v.Y >= 0 && v.Y < height
+}
+
+// Neighbors4 returns the orthogonally adjacent positions in Directions4 order
+func (v Vec2) Neighbors4() []Vec2 {
+	neighbors := make([]Vec2, len(Directions4))
+	for i, dir := range Directions4 {
+		neighbors[i] = v.Add(dir)
+	}
+	return neighbors
+}
+
+// Mod returns the Euclidean modulo of a by m, always in [0, |m|)
+// Go's % operator keeps the sign of a, which is wrong for wrap-around arithmetic
+func Mod(a, m int) int {
+	result := a % m
+	if result < 0 {
+		result += utils.Abs(m)
+	}
+	return result
+}
+
+// Compare orders vectors row by row, by Y and then by X, which is the reading order of a grid
+// Returns a negative number if a comes first, zero if they are equal and a positive number otherwise
+func Compare(a, b Vec2) int {
+	if a.Y != b.Y {
+		return cmp.Compare(a.Y, b.Y)
+	}
+	return cmp.Compare(a.X, b.X)
+}
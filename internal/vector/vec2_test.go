@@ -0,0 +1,140 @@
+package vector
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestVec2Arithmetic(t *testing.T) {
+	a := Vec2{X: 3, Y: -2}
+	b := Vec2{X: -1, Y: 5}
+
+	tests := []struct {
+		name string
+		got  Vec2
+		want Vec2
+	}{
+		{"Add", a.Add(b), Vec2{X: 2, Y: 3}},
+		{"Sub", a.Sub(b), Vec2{X: 4, Y: -7}},
+		{"Scale", a.Scale(3), Vec2{X: 9, Y: -6}},
+		{"Neg", a.Neg(), Vec2{X: -3, Y: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVec2Mod(t *testing.T) {
+	size := Vec2{X: 11, Y: 7}
+
+	tests := []struct {
+		v    Vec2
+		want Vec2
+	}{
+		{Vec2{X: 4, Y: 1}, Vec2{X: 4, Y: 1}},
+		{Vec2{X: 12, Y: 14}, Vec2{X: 1, Y: 0}},
+		{Vec2{X: -1, Y: -8}, Vec2{X: 10, Y: 6}},
+		{Vec2{X: -22, Y: -7}, Vec2{X: 0, Y: 0}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.v.Mod(size); got != tt.want {
+			t.Errorf("%v.Mod(%v) = %v, want %v", tt.v, size, got, tt.want)
+		}
+	}
+}
+
+func TestMod(t *testing.T) {
+	tests := []struct {
+		a, m, want int
+	}{
+		{7, 3, 1},
+		{-7, 3, 2},
+		{-3, 3, 0},
+		{0, 5, 0},
+		{-1, -4, 3},
+	}
+
+	for _, tt := range tests {
+		if got := Mod(tt.a, tt.m); got != tt.want {
+			t.Errorf("Mod(%d, %d) = %d, want %d", tt.a, tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestVec2Manhattan(t *testing.T) {
+	if got := (Vec2{X: 1, Y: 5}).Manhattan(Vec2{X: -2, Y: 1}); got != 7 {
+		t.Errorf("Manhattan() = %d, want 7", got)
+	}
+}
+
+func TestVec2Rotation(t *testing.T) {
+	// Rotating right walks the compass clockwise, rotating left undoes it
+	for i, dir := range Directions4 {
+		next := Directions4[(i+1)%4]
+		if got := dir.RotateRight(); got != next {
+			t.Errorf("%v.RotateRight() = %v, want %v", dir, got, next)
+		}
+		if got := next.RotateLeft(); got != dir {
+			t.Errorf("%v.RotateLeft() = %v, want %v", next, got, dir)
+		}
+	}
+
+	if got := (Vec2{X: 2, Y: -3}).RotateRight().RotateRight(); got != (Vec2{X: -2, Y: 3}) {
+		t.Errorf("two right rotations = %v, want the negated vector", got)
+	}
+}
+
+func TestVec2InBounds(t *testing.T) {
+	tests := []struct {
+		v    Vec2
+		want bool
+	}{
+		{Vec2{X: 0, Y: 0}, true},
+		{Vec2{X: 9, Y: 4}, true},
+		{Vec2{X: 10, Y: 4}, false},
+		{Vec2{X: 9, Y: 5}, false},
+		{Vec2{X: -1, Y: 0}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.v.InBounds(10, 5); got != tt.want {
+			t.Errorf("%v.InBounds(10, 5) = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestDirections(t *testing.T) {
+	if len(Directions8) != 8 {
+		t.Fatalf("len(Directions8) = %d, want 8", len(Directions8))
+	}
+	for i, dir := range Directions8 {
+		if dir.Manhattan(Vec2{}) == 0 || dir.X < -1 || dir.X > 1 || dir.Y < -1 || dir.Y > 1 {
+			t.Errorf("Directions8[%d] = %v is not a unit step", i, dir)
+		}
+	}
+
+	want := []Vec2{{X: 2, Y: 1}, {X: 3, Y: 2}, {X: 2, Y: 3}, {X: 1, Y: 2}}
+	if got := (Vec2{X: 2, Y: 2}).Neighbors4(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Neighbors4() = %v, want %v", got, want)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cells := []Vec2{{X: 2, Y: 1}, {X: 0, Y: 2}, {X: 5, Y: 0}, {X: 0, Y: 1}}
+	slices.SortFunc(cells, Compare)
+
+	want := []Vec2{{X: 5, Y: 0}, {X: 0, Y: 1}, {X: 2, Y: 1}, {X: 0, Y: 2}}
+	if !reflect.DeepEqual(cells, want) {
+		t.Errorf("sorted by Compare = %v, want %v", cells, want)
+	}
+	if Compare(Vec2{X: 1, Y: 1}, Vec2{X: 1, Y: 1}) != 0 {
+		t.Errorf("Compare() of equal vectors should be 0")
+	}
+}
@@ -0,0 +1,164 @@
+package vector
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/amoilanen/advent-of-code-2024/internal/utils"
+)
+
+// VecN is an N-dimensional integer vector
+// Operations on vectors of different dimensions panic
+// Slices are not comparable, use Key to store vectors in maps
+type VecN []int
+
+// Zero returns the zero vector with n dimensions
+func Zero(n int) VecN {
+	return make(VecN, n)
+}
+
+// Dim returns the number of dimensions
+func (v VecN) Dim() int {
+	return len(v)
+}
+
+// Add returns v + other
+func (v VecN) Add(other VecN) VecN {
+	mustMatch(v, other)
+	result := make(VecN, len(v))
+	for i := range v {
+		result[i] = v[i] + other[i]
+	}
+	return result
+}
+
+// Sub returns v - other
+func (v VecN) Sub(other VecN) VecN {
+	mustMatch(v, other)
+	result := make(VecN, len(v))
+	for i := range v {
+		result[i] = v[i] - other[i]
+	}
+	return result
+}
+
+// Scale returns v multiplied by the scalar k
+func (v VecN) Scale(k int) VecN {
+	result := make(VecN, len(v))
+	for i := range v {
+		result[i] = v[i] * k
+	}
+	return result
+}
+
+// Mod wraps v component-wise into [0, size[i]) using Euclidean modulo
+func (v VecN) Mod(size VecN) VecN {
+	mustMatch(v, size)
+	result := make(VecN, len(v))
+	for i := range v {
+		result[i] = Mod(v[i], size[i])
+	}
+	return result
+}
+
+// Manhattan returns the Manhattan (taxicab) distance between v and other
+func (v VecN) Manhattan(other VecN) int {
+	mustMatch(v, other)
+	distance := 0
+	for i := range v {
+		distance += utils.Abs(v[i] - other[i])
+	}
+	return distance
+}
+
+// Equal reports whether v and other have the same dimensions and components
+func (v VecN) Equal(other VecN) bool {
+	if len(v) != len(other) {
+		return false
+	}
+	for i := range v {
+		if v[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// InBounds checks if every component lies within [0, size[i])
+func (v VecN) InBounds(size VecN) bool {
+	mustMatch(v, size)
+	for i := range v {
+		if v[i] < 0 || v[i] >= size[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Key returns a comparable representation of v, suitable as a map key
+func (v VecN) Key() string {
+	parts := make([]string, len(v))
+	for i, component := range v {
+		parts[i] = strconv.Itoa(component)
+	}
+	return strings.Join(parts, ",")
+}
+
+// UnitDirections returns the 2*n orthogonal unit steps in n dimensions:
+// +1 then -1 along each axis in turn
+func UnitDirections(n int) []VecN {
+	directions := make([]VecN, 0, 2*n)
+	for axis := 0; axis < n; axis++ {
+		for _, sign := range []int{1, -1} {
+			dir := Zero(n)
+			dir[axis] = sign
+			directions = append(directions, dir)
+		}
+	}
+	return directions
+}
+
+// AllDirections returns the 3^n - 1 steps to every adjacent cell in n dimensions,
+// including diagonals
+func AllDirections(n int) []VecN {
+	var directions []VecN
+	current := Zero(n)
+
+	var build func(axis int)
+	build = func(axis int) {
+		if axis == n {
+			if !current.Equal(Zero(n)) {
+				dir := make(VecN, n)
+				copy(dir, current)
+				directions = append(directions, dir)
+			}
+			return
+		}
+		for _, delta := range []int{-1, 0, 1} {
+			current[axis] = delta
+			build(axis + 1)
+		}
+	}
+	build(0)
+
+	return directions
+}
+
+// ToVec2 converts a 2-dimensional VecN to a Vec2
+func (v VecN) ToVec2() Vec2 {
+	mustMatch(v, Zero(2))
+	return Vec2{X: v[0], Y: v[1]}
+}
+
+// ToVecN converts v to a 2-dimensional VecN
+func (v Vec2) ToVecN() VecN {
+	return VecN{v.X, v.Y}
+}
+
+// mustMatch panics if the vectors have different dimensions
+func mustMatch(a, b VecN) {
+	if len(a) != len(b) {
+		panic(fmt.Sprintf("vector: dimension mismatch %d != %d", len(a), len(b)))
+	}
+}
@@ -0,0 +1,76 @@
+package vector
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVecNArithmetic(t *testing.T) {
+	a := VecN{1, -2, 3}
+	b := VecN{4, 5, -6}
+
+	tests := []struct {
+		name string
+		got  VecN
+		want VecN
+	}{
+		{"Add", a.Add(b), VecN{5, 3, -3}},
+		{"Sub", a.Sub(b), VecN{-3, -7, 9}},
+		{"Scale", a.Scale(-2), VecN{-2, 4, -6}},
+		{"Mod", VecN{-1, 7, 3}.Mod(VecN{4, 4, 4}), VecN{3, 3, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if got := a.Manhattan(b); got != 19 {
+		t.Errorf("Manhattan() = %d, want 19", got)
+	}
+}
+
+func TestVecNDimensionMismatchPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Add() of vectors with different dimensions did not panic")
+		}
+	}()
+	VecN{1, 2}.Add(VecN{1, 2, 3})
+}
+
+func TestVecNBoundsAndKey(t *testing.T) {
+	size := VecN{3, 3, 3}
+	if !(VecN{0, 2, 1}).InBounds(size) || (VecN{0, 3, 1}).InBounds(size) {
+		t.Error("InBounds() gave the wrong answer")
+	}
+
+	seen := map[string]bool{VecN{1, -2, 3}.Key(): true}
+	if !seen[VecN{1, -2, 3}.Key()] || seen[VecN{1, 2, 3}.Key()] {
+		t.Error("Key() does not identify vectors by value")
+	}
+}
+
+func TestDirectionsN(t *testing.T) {
+	want := []VecN{{1, 0, 0}, {-1, 0, 0}, {0, 1, 0}, {0, -1, 0}, {0, 0, 1}, {0, 0, -1}}
+	if got := UnitDirections(3); !reflect.DeepEqual(got, want) {
+		t.Errorf("UnitDirections(3) = %v, want %v", got, want)
+	}
+
+	if got := AllDirections(3); len(got) != 26 {
+		t.Errorf("len(AllDirections(3)) = %d, want 26", len(got))
+	}
+	if got := len(AllDirections(2)); got != len(Directions8) {
+		t.Errorf("len(AllDirections(2)) = %d, want %d", got, len(Directions8))
+	}
+}
+
+func TestVecConversion(t *testing.T) {
+	v := Vec2{X: 4, Y: -1}
+	if got := v.ToVecN().ToVec2(); got != v {
+		t.Errorf("round trip through VecN = %v, want %v", got, v)
+	}
+}